@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"runtime/debug"
 	"time"
@@ -33,15 +34,22 @@ import (
 	fornaxk8sv1 "centaurusinfra.io/fornax-serverless/pkg/apis/k8s/core/v1"
 	"centaurusinfra.io/fornax-serverless/pkg/apis/openapi"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/application"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/event"
 	grpc_server "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc/server"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/leaderelection"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/metrics"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/node"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/nodemonitor"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/pod"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/podscheduler"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/secret"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/session"
 	"centaurusinfra.io/fornax-serverless/pkg/log"
 	"centaurusinfra.io/fornax-serverless/pkg/store"
 	"centaurusinfra.io/fornax-serverless/pkg/store/factory"
+	"centaurusinfra.io/fornax-serverless/pkg/store/inmemory"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
 	// "github.com/pkg/profile"
 )
 
@@ -61,12 +69,43 @@ func main() {
 	}
 	// defer profile.Start().Stop()
 	debug.SetGCPercent(300)
-	klog.Info("Initialize fornax resource memory store")
 	ctx := context.Background()
-	nodeStore := factory.NewFornaxNodeStorage(ctx)
-	podStore := factory.NewFornaxPodStorage(ctx)
-	appStatusStore := factory.NewFornaxApplicationStatusStorage(ctx)
-	appSessionStore := factory.NewFornaxApplicationSessionStorage(ctx)
+	storagePolicy := &factory.FornaxStoragePolicy{
+		SqliteFile: factory.DefaultFornaxStorageSqliteFile,
+		PersistedResources: []string{
+			fornaxv1.ApplicationGrv.GroupResource().String(),
+			fornaxv1.ApplicationSessionGrv.GroupResource().String(),
+			fornaxv1.NodeDaemonGrv.GroupResource().String(),
+		},
+		WalDir:            "",
+		SnapshotInterval:  inmemory.DefaultSnapshotInterval,
+		EncryptionKeyFile: factory.DefaultFornaxStorageEncryptionKeyFile,
+	}
+	nodeLeasePolicy := &node.NodeLeasePolicy{
+		HeartbeatGracePeriod: node.DefaultNodeHeartbeatGracePeriod,
+	}
+	nodeCidrPolicy := &node.NodeCidrPolicy{
+		ClusterCIDRs:         []string{node.DefaultClusterCIDR},
+		NodeCIDRMaskSizeIPv4: node.DefaultNodeCIDRMaskSizeIPv4,
+		NodeCIDRMaskSizeIPv6: node.DefaultNodeCIDRMaskSizeIPv6,
+		AllocationFile:       node.DefaultNodeCidrAllocationFile,
+		ReleaseTimeout:       node.DefaultNodeCidrReleaseTimeout,
+	}
+	leaderElectionPolicy := &leaderelection.LeaderElectionPolicy{
+		LockFile:         "",
+		RetryPeriod:      leaderelection.DefaultLeaderElectionRetryPeriod,
+		AdvertiseAddress: "",
+		Peers:            []string{},
+	}
+	eventPolicy := &event.EventPolicy{
+		MaxEvents: event.DefaultMaxEvents,
+	}
+	// standby fornaxcore serve reads only, elector is used by api server handler chain, it's started after flags are parsed
+	elector := leaderelection.NewLeaderElector(leaderElectionPolicy)
+	tracingConfig := tracing.TracingConfig{
+		Endpoint:               "",
+		SamplingRatePerMillion: tracing.DefaultSamplingRatePerMillion,
+	}
 
 	// build api server, it parse command line flags
 	klog.Info("Build fornaxcore rest api server")
@@ -77,6 +116,10 @@ func main() {
 			optionsGetter := config.RESTOptionsGetter
 			config.RESTOptionsGetter = &factory.FornaxRestOptionsFactory{
 				OptionsGetter: optionsGetter,
+				StoragePolicy: storagePolicy,
+			}
+			config.BuildHandlerChainFunc = func(apiHandler http.Handler, c *server.Config) http.Handler {
+				return server.DefaultBuildHandlerChain(elector.WithStandbyReadonly(apiHandler), c)
 			}
 			return config
 		}).
@@ -91,56 +134,131 @@ func main() {
 			return server
 		}).
 		WithFlagFns(func(flagSet *pflag.FlagSet) *pflag.FlagSet {
+			flagSet.StringVar(&storagePolicy.SqliteFile, "storage-sqlite-file", storagePolicy.SqliteFile, "sqlite database file persisted resources are saved in, all resources only live in memory if it's empty")
+			flagSet.StringVar(&storagePolicy.WalDir, "storage-wal-dir", storagePolicy.WalDir, "directory memory stores of resources not persisted in sqlite save write ahead log and snapshots in, memory stores do not recover after restart if it's empty")
+			flagSet.DurationVar(&storagePolicy.SnapshotInterval, "storage-snapshot-interval", storagePolicy.SnapshotInterval, "how often memory stores take a snapshot and truncate write ahead log")
+			flagSet.StringVar(&storagePolicy.EncryptionKeyFile, "storage-encryption-key-file", storagePolicy.EncryptionKeyFile, "file of aes-256 key secrets are encrypted with before saved in sqlite, a random key is generated if it does not exist")
+			flagSet.StringSliceVar(&storagePolicy.PersistedResources, "storage-persisted-resources", storagePolicy.PersistedResources, "resources which are persisted into sqlite and survive fornaxcore restart, e.g. applicationsessions.core.fornax-serverless.centaurusinfra.io")
+			flagSet.DurationVar(&nodeLeasePolicy.HeartbeatGracePeriod, "node-heartbeat-grace-period", nodeLeasePolicy.HeartbeatGracePeriod, "how long a node can stop reporting before it is marked not ready and its pods and sessions are failed over")
+			flagSet.StringSliceVar(&nodeCidrPolicy.ClusterCIDRs, "cluster-cidr", nodeCidrPolicy.ClusterCIDRs, "cidr ranges node pod cidrs are allocated from, one ipv4 and one ipv6 cidr at most for dual stack, e.g. 192.168.0.0/16,fd00:10::/48")
+			flagSet.IntVar(&nodeCidrPolicy.NodeCIDRMaskSizeIPv4, "node-cidr-mask-size-ipv4", nodeCidrPolicy.NodeCIDRMaskSizeIPv4, "mask size of node ipv4 pod cidr")
+			flagSet.IntVar(&nodeCidrPolicy.NodeCIDRMaskSizeIPv6, "node-cidr-mask-size-ipv6", nodeCidrPolicy.NodeCIDRMaskSizeIPv6, "mask size of node ipv6 pod cidr")
+			flagSet.StringVar(&nodeCidrPolicy.AllocationFile, "node-cidr-allocation-file", nodeCidrPolicy.AllocationFile, "file to save node pod cidr allocations across fornaxcore restarts, allocations are not saved if it's empty")
+			flagSet.DurationVar(&nodeCidrPolicy.ReleaseTimeout, "node-cidr-release-timeout", nodeCidrPolicy.ReleaseTimeout, "how long a node can stay inactive before its pod cidrs are released and can be allocated to other nodes")
+			flagSet.StringVar(&leaderElectionPolicy.LockFile, "leader-elect-lock-file", leaderElectionPolicy.LockFile, "file lock fornaxcores on same host compete for leadership, only leader serve node agents, leader election is disabled if it's empty")
+			flagSet.DurationVar(&leaderElectionPolicy.RetryPeriod, "leader-elect-retry-period", leaderElectionPolicy.RetryPeriod, "how often a standby fornaxcore try to take over leadership")
+			flagSet.StringVar(&leaderElectionPolicy.AdvertiseAddress, "advertise-address", leaderElectionPolicy.AdvertiseAddress, "grpc endpoint node agents use to connect this fornaxcore, format is ip:port")
+			flagSet.StringSliceVar(&leaderElectionPolicy.Peers, "fornaxcore-peers", leaderElectionPolicy.Peers, "grpc endpoints of all fornaxcores, they are sent to node agents as standbys when this fornaxcore become leader, format is ip:port")
+			flagSet.IntVar(&eventPolicy.MaxEvents, "max-events", eventPolicy.MaxEvents, "maximum number of events kept in event store, least recently recorded events are deleted when there are more")
+			flagSet.StringVar(&tracingConfig.Endpoint, "tracing-endpoint", tracingConfig.Endpoint, "otlp grpc collector endpoint session open spans are exported to, format is host:port, spans are not exported if it's empty")
+			flagSet.IntVar(&tracingConfig.SamplingRatePerMillion, "tracing-sampling-rate-per-million", tracingConfig.SamplingRatePerMillion, "number of session open traces sampled per million sessions")
 			return flagSet
 		}).
 		WithResource(&fornaxv1.Application{}).
-		WithResource(&fornaxv1.ApplicationSession{}).
+		WithResourceAndHandler(&fornaxv1.ApplicationSession{}, store.FornaxPrepareForCreateResourceHandler(&fornaxv1.ApplicationSession{}, util.PrepareSessionForCreate)).
+		WithResource(&fornaxv1.NodeDaemon{}).
+		WithResource(&fornaxk8sv1.FornaxSecret{}).
 		WithResourceAndHandler(&fornaxk8sv1.FornaxPod{}, store.FornaxReadonlyResourceHandler(&fornaxk8sv1.FornaxPod{})).
-		WithResourceAndHandler(&fornaxk8sv1.FornaxNode{}, store.FornaxReadonlyResourceHandler(&fornaxk8sv1.FornaxNode{}))
+		WithResourceAndHandler(&fornaxk8sv1.FornaxEvent{}, store.FornaxReadonlySelectableResourceHandler(&fornaxk8sv1.FornaxEvent{}, fornaxk8sv1.EventGetAttrs, fornaxk8sv1.EventFieldLabelConversionFunc)).
+		WithResourceAndHandler(&fornaxk8sv1.FornaxNode{}, store.FornaxSpecUpdatableResourceHandler(&fornaxk8sv1.FornaxNode{}, util.PrepareNodeForUpdate))
 	apiServerCmd, err := apiserver.Build()
 	if err != nil {
 		klog.Fatal(err)
 		os.Exit(-1)
 	}
 
-	// new fornaxcore grpc server which talk with node agent
-	klog.Info("Build Fornaxcore grpc server")
-	nodeAgentServer := grpc_server.NewGrpcServer()
-
-	// start internal managers and pod scheduler
-	podManager := pod.NewPodManager(ctx, podStore, nodeAgentServer)
-	sessionManager := session.NewSessionManager(ctx, appSessionStore, nodeAgentServer)
-	nodeManager := node.NewNodeManager(ctx, nodeStore, nodeAgentServer, podManager, sessionManager)
-	podScheduler := podscheduler.NewPodScheduler(ctx, nodeAgentServer, nodeManager, podManager,
-		&podscheduler.SchedulePolicy{
-			NumOfEvaluatedNodes: 100,
-			BackoffDuration:     10 * time.Second,
-			NodeSortingMethod:   podscheduler.NodeSortingMethodMoreMemory,
+	// stores, managers and grpc server are created after flags are parsed, storage policy decide which stores are persisted,
+	// then elect leader, standby fornaxcore keep node connections and wait,
+	// leader start managers and ask nodes to full sync to rebuild nodes, pods and sessions state
+	apiServerCmd.PreRunE = func(cmd *builder.Command, args []string) error {
+		if err := nodeCidrPolicy.Validate(); err != nil {
+			return err
+		}
+
+		// metrics are served by api server /metrics endpoint
+		metrics.Register()
+		tracing.InitTracing(ctx, "fornaxcore", tracingConfig)
+
+		klog.Info("Initialize fornax resource store")
+		nodeStore, err := factory.NewFornaxNodeStorage(ctx, storagePolicy)
+		if err != nil {
+			return err
+		}
+		podStore, err := factory.NewFornaxPodStorage(ctx, storagePolicy)
+		if err != nil {
+			return err
+		}
+		appStatusStore, err := factory.NewFornaxApplicationStatusStorage(ctx, storagePolicy)
+		if err != nil {
+			return err
+		}
+		appSessionStore, err := factory.NewFornaxApplicationSessionStorage(ctx, storagePolicy)
+		if err != nil {
+			return err
+		}
+		nodeDaemonStore, err := factory.NewFornaxNodeDaemonStorage(ctx, storagePolicy)
+		if err != nil {
+			return err
+		}
+		secretStore, err := factory.NewFornaxSecretStorage(ctx, storagePolicy)
+		if err != nil {
+			return err
+		}
+		eventStore, err := factory.NewFornaxEventStorage(ctx, storagePolicy)
+		if err != nil {
+			return err
+		}
+
+		// new fornaxcore grpc server which talk with node agent
+		klog.Info("Build Fornaxcore grpc server")
+		nodeAgentServer := grpc_server.NewGrpcServer()
+
+		// start internal managers and pod scheduler
+		eventRecorder := event.NewEventRecorder(ctx, eventStore, eventPolicy)
+		podManager := pod.NewPodManager(ctx, podStore, nodeAgentServer, eventRecorder)
+		sessionManager := session.NewSessionManager(ctx, appSessionStore, nodeAgentServer)
+		nodeManager := node.NewNodeManager(ctx, nodeStore, nodeDaemonStore, nodeAgentServer, podManager, sessionManager, nodeLeasePolicy, nodeCidrPolicy, eventRecorder)
+		podScheduler := podscheduler.NewPodScheduler(ctx, nodeAgentServer, nodeManager, podManager, eventRecorder,
+			&podscheduler.SchedulePolicy{
+				NumOfEvaluatedNodes: 100,
+				BackoffDuration:     10 * time.Second,
+				NodeSortingMethod:   podscheduler.NodeSortingMethodMoreMemory,
+			})
+		appManager := application.NewApplicationManager(ctx, podManager, sessionManager, nodeManager, appStatusStore, eventRecorder)
+		nodeAgentServer.SetPodConfigProvider(appManager)
+		nodeAgentServer.SetPodSecretProvider(secret.NewSecretManager(secretStore))
+
+		// start fornaxcore grpc nodeagnet server to listen node agents, it does not handle node messages until it become leader
+		klog.Info("Starting Fornaxcore grpc server")
+		// TODO get certn and keyFile from commandline flags, --tls-cert-file --tls-private-key-file
+		certFile := ""
+		keyFile := ""
+		port := 18001
+		err = nodeAgentServer.RunGrpcServer(ctx, nodemonitor.NewNodeMonitor(nodeManager), port, certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		klog.Info("Fornaxcore grpc server started")
+
+		go elector.Run(ctx, func(ctx context.Context) {
+			klog.Info("Starting event recorder")
+			eventRecorder.Run()
+			klog.Info("Starting pod scheduler")
+			podScheduler.Run()
+			klog.Info("Starting pod manager")
+			podManager.Run(podScheduler)
+			klog.Info("Starting node manager")
+			nodeManager.Run()
+
+			// start application manager at last as it require api server
+			klog.Info("starting application manager")
+			appManager.Run(ctx)
+
+			klog.Info("Taking over node agents")
+			nodeAgentServer.StartLeading(elector.FornaxCoreConfiguration())
 		})
-	klog.Info("Starting pod scheduler")
-	podScheduler.Run()
-	klog.Info("Starting pod manager")
-	podManager.Run(podScheduler)
-	klog.Info("Starting node manager")
-	nodeManager.Run()
-
-	// start application manager at last as it require api server
-	klog.Info("starting application manager")
-	appManager := application.NewApplicationManager(ctx, podManager, sessionManager, appStatusStore)
-	appManager.Run(ctx)
-
-	// start fornaxcore grpc nodeagnet server to listen node agents
-	klog.Info("Starting Fornaxcore grpc server")
-	// TODO get certn and keyFile from commandline flags, --tls-cert-file --tls-private-key-file
-	certFile := ""
-	keyFile := ""
-	port := 18001
-	err = nodeAgentServer.RunGrpcServer(ctx, nodemonitor.NewNodeMonitor(nodeManager), port, certFile, keyFile)
-	if err != nil {
-		klog.Fatal(err)
-		os.Exit(-1)
+		return nil
 	}
-	klog.Info("Fornaxcore grpc server started")
 
 	// start api server to listen to clients
 	err = apiServerCmd.Execute()
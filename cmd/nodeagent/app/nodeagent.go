@@ -24,7 +24,9 @@ import (
 
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/dependency"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/metrics"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/node"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"github.com/coreos/go-systemd/v22/daemon"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -147,6 +149,20 @@ func run(ctx context.Context, nodeConfig config.NodeConfiguration, dependencies
 		klog.ErrorS(err, "Can not initialize node actor")
 	}
 
+	// node agent continue session open traces started by fornaxcore, sampling decision of fornaxcore is respected
+	tracing.InitTracing(ctx, "nodeagent", tracing.TracingConfig{
+		Endpoint:               nodeConfig.TracingEndpoint,
+		SamplingRatePerMillion: tracing.DefaultSamplingRatePerMillion,
+	})
+
+	metrics.Register(node.NewNodeMetricsCollector(nodeActor))
+	if len(nodeConfig.MetricsBindAddress) > 0 {
+		if err := metrics.Serve(ctx, nodeConfig.MetricsBindAddress); err != nil {
+			return err
+		}
+		klog.InfoS("Serving node agent metrics", "address", nodeConfig.MetricsBindAddress)
+	}
+
 	klog.Info("Starting FornaxNode")
 	err = nodeActor.Start()
 	if err != nil {
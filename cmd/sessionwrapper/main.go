@@ -21,20 +21,42 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/url"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	sessiongrpc "centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice/grpc"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"k8s.io/klog/v2"
 )
 
+// otlpEndpointAddress convert standard otel endpoint url like http://collector:4317 to host:port used by otlp grpc exporter,
+// endpoint without a scheme is treated as host:port already, default otlp grpc port is used if url does not have a port
+func otlpEndpointAddress(endpoint string) string {
+	if !strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || len(u.Hostname()) == 0 {
+		klog.ErrorS(err, "Invalid otlp endpoint, spans are not exported", "endpoint", endpoint)
+		return ""
+	}
+	if len(u.Port()) == 0 {
+		return net.JoinHostPort(u.Hostname(), "4317")
+	}
+	return u.Host
+}
+
 func main() {
 	endpoint := os.Getenv(fornaxv1.AnnotationFornaxCoreSessionService)
 	opensession_cmd := os.Getenv("SESSION_WRAPPER_OPEN_SESSION_CMD")
@@ -44,6 +66,12 @@ func main() {
 		openCmd:  opensession_cmd,
 	}
 
+	// spans are exported if a otlp collector is configured using standard otel environment variable
+	shutdownTracing := tracing.InitTracing(context.Background(), "sessionwrapper", tracing.TracingConfig{
+		Endpoint:               otlpEndpointAddress(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")),
+		SamplingRatePerMillion: tracing.DefaultSamplingRatePerMillion,
+	})
+
 	instanceId := os.Getenv(fornaxv1.AnnotationFornaxCorePod)
 	client := NewSessionServiceClient(instanceId, config)
 	sigCh := make(chan os.Signal, 1)
@@ -63,6 +91,7 @@ func main() {
 	// capture signal send to session wrapper, and gracefully stopping all open sessions
 	result := <-done
 	client.GracefullyStopSessions()
+	shutdownTracing()
 	fmt.Printf("exiting %d\n", result)
 	os.Exit(result)
 }
@@ -212,7 +241,7 @@ func (f *sessionServiceClient) recvMessage(ctx context.Context) {
 }
 
 // Stop implements FornaxCore
-func (f *sessionServiceClient) handleSessionCommand(msg *sessiongrpc.SessionMessage) error {
+func (f *sessionServiceClient) handleSessionCommand(msg *sessiongrpc.SessionMessage) (err error) {
 	sessionId := msg.GetSessionIdentifier()
 	switch msg.GetMessageType() {
 	case sessiongrpc.MessageType_PING_SESSION:
@@ -275,6 +304,11 @@ func (f *sessionServiceClient) handleSessionCommand(msg *sessiongrpc.SessionMess
 			return nil
 		}
 	case sessiongrpc.MessageType_OPEN_SESSION:
+		// last hop of session open trace, continue it from trace context sent by node agent
+		ctx := tracing.ExtractTraceContext(context.Background(), msg.GetTraceContext())
+		_, span := tracing.StartSpan(ctx, "SessionWrapper.OpenSession", attribute.String("session", sessionId.GetIdentifier()))
+		defer func() { tracing.EndSpan(span, err) }()
+
 		if f.stopping {
 			return errors.New("instance is terminating")
 		}
@@ -295,6 +329,7 @@ func (f *sessionServiceClient) handleSessionCommand(msg *sessiongrpc.SessionMess
 		if err != nil {
 			session.state = sessiongrpc.SessionState_STATE_CLOSED
 			klog.ErrorS(err, "Failed to start session process")
+			span.RecordError(err)
 		} else {
 			session.pid = proc.Pid
 			session.state = sessiongrpc.SessionState_STATE_OPEN
@@ -98,7 +98,7 @@ func (n *SimulationNodeActor) startStateReport() {
 	go wait.Until(func() {
 		SetNodeStatus(n.node)
 		n.notify(n.fornoxCoreRef, node.BuildFornaxGrpcNodeState(n.node, n.node.Revision))
-	}, 1*time.Minute, n.stopCh)
+	}, n.node.NodeConfig.NodeStateReportInterval, n.stopCh)
 }
 
 func (n *SimulationNodeActor) incrementNodeRevision() int64 {
@@ -141,6 +141,8 @@ func (n *SimulationNodeActor) processFornaxCoreMessage(msg *fornaxgrpc.FornaxCor
 		}()
 	case fornaxgrpc.MessageType_POD_HIBERNATE:
 		err = n.onPodHibernateCommand(msg.GetPodHibernate())
+	case fornaxgrpc.MessageType_POD_CONFIG_UPDATE:
+		err = n.onPodConfigUpdateCommand(msg.GetPodConfigUpdate())
 	case fornaxgrpc.MessageType_SESSION_OPEN:
 		go func() {
 			n.onSessionOpenCommand(msg.GetSessionOpen())
@@ -204,7 +206,7 @@ func (n *SimulationNodeActor) onNodeConfigurationCommand(msg *fornaxgrpc.NodeCon
 					n.notify(n.fornoxCoreRef, node.BuildFornaxGrpcNodeReady(n.node, revision))
 				}()
 				n.state = node.NodeStateReady
-				// n.startStateReport()
+				n.startStateReport()
 			} else {
 				time.Sleep(1 * time.Second)
 			}
@@ -268,6 +270,7 @@ func (n *SimulationNodeActor) buildAFornaxPod(state nodetypes.PodState,
 			return nil, errors.New("ConfigMap spec is invalid")
 		}
 		fornaxPod.ConfigMap = configMap.DeepCopy()
+		pod.SetPodConfigRevision(fornaxPod)
 	}
 	return fornaxPod, nil
 }
@@ -295,11 +298,12 @@ func (n *SimulationNodeActor) onPodCreateCommand(msg *fornaxgrpc.PodCreate) erro
 	}
 	v := n.node.Pods.Get(msg.GetPodIdentifier())
 	if v == nil {
+		_, isDaemon := msg.GetPod().GetLabels()[fornaxv1.LabelFornaxCoreNodeDaemon]
 		fpod, err := n.createPodAndActor(
 			nodetypes.PodStateCreating,
 			msg.GetPod().DeepCopy(),
 			msg.GetConfigMap().DeepCopy(),
-			false,
+			isDaemon,
 		)
 		if err != nil {
 			return err
@@ -377,6 +381,23 @@ func (n *SimulationNodeActor) onPodTerminateCommand(msg *fornaxgrpc.PodTerminate
 	return nil
 }
 
+// simulated pod has no container, just keep new config map and report pod with new config revision
+func (n *SimulationNodeActor) onPodConfigUpdateCommand(msg *fornaxgrpc.PodConfigUpdate) error {
+	fpod := n.node.Pods.Get(msg.GetPodIdentifier())
+	if fpod == nil {
+		return fmt.Errorf("Pod: %s does not exist, fornax core is not in sync", msg.GetPodIdentifier())
+	}
+	n.nodeMutex.Lock()
+	defer n.nodeMutex.Unlock()
+	fpod.ConfigMap = msg.GetConfigMap().DeepCopy()
+	pod.SetPodConfigRevision(fpod)
+	revision := n.incrementNodeRevision()
+	fpod.Pod.ResourceVersion = fmt.Sprint(revision)
+	fpod.Pod.Annotations[fornaxv1.AnnotationFornaxCoreNodeRevision] = fmt.Sprint(revision)
+	n.notify(n.fornoxCoreRef, pod.BuildFornaxcoreGrpcPodState(revision, fpod))
+	return nil
+}
+
 // find pod actor and send a message to it, if pod actor does not exist, return error
 func (n *SimulationNodeActor) onPodHibernateCommand(msg *fornaxgrpc.PodHibernate) error {
 	panic("not implemented")
@@ -19,6 +19,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"path"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -71,6 +72,86 @@ type ApplicationSpec struct {
 
 	// application scaling policy
 	ScalingPolicy ScalingPolicy `json:"scalingPolicy,omitempty" protobuf:"bytes,4,opt,name=scalingPolicy"`
+
+	// how many sessions a application instance can hold at same time, sessions are packed onto partially used instances firstly
+	// +optional, default 1
+	SessionsPerInstance uint32 `json:"sessionsPerInstance,omitempty" protobuf:"varint,5,opt,name=sessionsPerInstance"`
+
+	// how to replace application instances created from old spec when containers changed,
+	// config data changes are pushed to running instances and do not replace them
+	// +optional
+	RollingUpdate RollingUpdatePolicy `json:"rollingUpdate,omitempty" protobuf:"bytes,6,opt,name=rollingUpdate"`
+
+	// node labels which must match for application instances to be scheduled onto a node
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,7,rep,name=nodeSelector"`
+
+	// required and preferred node affinity of application instances, only node affinity is honored by scheduler
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,8,opt,name=nodeAffinity"`
+
+	// taints of node which application instances tolerate
+	// +optional
+	// +listType=atomic
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,9,rep,name=tolerations"`
+
+	// how config data is exposed to application containers,
+	// config data is mounted in DefaultConfigMountPath and exposed as environment variables if it's not set
+	// +optional
+	ConfigProjection *ConfigProjection `json:"configProjection,omitempty" protobuf:"bytes,10,opt,name=configProjection"`
+
+	// volumes which application containers can mount, emptyDir, configMap of application config data, secret and hostPath volumes are supported,
+	// hostPath volume must be allowed by node agent, configMap and secret volumes are mounted read-only
+	// +optional
+	// +listType=atomic
+	Volumes []corev1.Volume `json:"volumes,omitempty" protobuf:"bytes,11,rep,name=volumes"`
+
+	// docker config secrets in application namespace which are used to pull container images from private registries,
+	// secret type must be kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg
+	// +optional
+	// +listType=atomic
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" protobuf:"bytes,12,rep,name=imagePullSecrets"`
+
+	// init containers run one by one to completion before application containers start, instance fails if any of them fails,
+	// they run only once for a instance, hibernating and waking up instance does not run them again
+	// +optional
+	// +listType=atomic
+	InitContainers []corev1.Container `json:"initContainers,omitempty" protobuf:"bytes,13,rep,name=initContainers"`
+
+	// name of runtime handler which application instances run in, for example runc or quark, instances are only scheduled onto nodes which support it,
+	// node default runtime handler is used if it's not set
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty" protobuf:"bytes,14,opt,name=runtimeClassName"`
+
+	// how application containers are restarted when they exit, Always, OnFailure or Never, containers are restarted in place with exponential backoff,
+	// instance is terminated when a container exit if it's Never
+	// +optional, default Never
+	RestartPolicy corev1.RestartPolicy `json:"restartPolicy,omitempty" protobuf:"bytes,15,opt,name=restartPolicy,casttype=k8s.io/api/core/v1.RestartPolicy"`
+}
+
+const DefaultConfigMountPath = "/etc/fornax/config"
+
+// config files in mount path are updated in place when config data changed,
+// environment variables are set when container start, running containers keep old values
+type ConfigProjection struct {
+	// absolute directory in which each config key is mounted read-only as a file, config is not mounted if it's empty
+	// +optional
+	MountPath string `json:"mountPath,omitempty" protobuf:"bytes,1,opt,name=mountPath"`
+
+	// expose each config key as a environment variable
+	// +optional
+	Env bool `json:"env,omitempty" protobuf:"varint,2,opt,name=env"`
+}
+
+// instances which have session on it are drained, they do not accept new session and are replaced after all sessions closed
+type RollingUpdatePolicy struct {
+	// maximum number of unallocated instances which can be unavailable during replacement, including pending and deleting instances
+	// +optional, default 1
+	MaxUnavailable uint32 `json:"maxUnavailable,omitempty" protobuf:"varint,1,opt,name=maxUnavailable"`
+
+	// maximum number of new instances which can be created over desired number of instances during replacement
+	// +optional, default 1
+	MaxSurge uint32 `json:"maxSurge,omitempty" protobuf:"varint,2,opt,name=maxSurge"`
 }
 
 type ScalingPolicyType string
@@ -131,6 +212,9 @@ const (
 
 	// delete instance
 	DeploymentActionDeleteInstance DeploymentAction = "DeleteInstance"
+
+	// replace instances created from old application spec
+	DeploymentActionRollingUpdate DeploymentAction = "RollingUpdate"
 )
 
 type DeploymentStatus string
@@ -147,6 +231,21 @@ const (
 	DeploymentStatusFailure DeploymentStatus = "Failure"
 )
 
+// These are valid condition types of a application.
+const (
+	// enough instances are running to hold sessions of application
+	ApplicationConditionReady = "Ready"
+
+	// instances are being created, deleted or replaced to reach desired state
+	ApplicationConditionProgressing = "Progressing"
+
+	// application failed to sync or its instances keep failing
+	ApplicationConditionDegraded = "Degraded"
+
+	// desired instances can not be created because of maximum instances or scaling burst
+	ApplicationConditionScalingLimited = "ScalingLimited"
+)
+
 type DeploymentHistory struct {
 	// Type of deployment condition.
 	Action DeploymentAction `json:"action,omitempty" protobuf:"bytes,1,opt,name=action,casttype=DeploymentAction"`
@@ -195,11 +294,20 @@ type ApplicationStatus struct {
 	LatestHistory DeploymentHistory `json:"latestHistory,omitempty" protobuf:"bytes,7,opt,name=latestHistory"`
 
 	// Represents the latest available observations of a deployment's current state.
+	// only a limited number of latest histories are kept, older ones are dropped
 	// +optional
 	// +patchMergeKey=updateTime
 	// +patchStrategy=merge
 	// +listType=set
 	History []DeploymentHistory `json:"history,omitempty" patchStrategy:"merge" patchMergeKey:"updateTime" protobuf:"bytes,8,rep,name=history"`
+
+	// Current state of application, condition types are Ready, Progressing, Degraded and ScalingLimited
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,9,rep,name=conditions"`
 }
 
 var _ resource.Object = &Application{}
@@ -315,6 +423,43 @@ func (in *Application) Validate(ctx context.Context) field.ErrorList {
 		errorList = append(errorList, &err)
 	}
 
+	if in.Spec.ConfigProjection != nil && len(in.Spec.ConfigProjection.MountPath) > 0 && !path.IsAbs(in.Spec.ConfigProjection.MountPath) {
+		err := field.Error{
+			Type:   field.ErrorTypeInvalid,
+			Field:  "Spec.ConfigProjection.MountPath",
+			Detail: "Mount path must be a absolute path",
+		}
+		errorList = append(errorList, &err)
+	}
+
+	errorList = append(errorList, validateVolumes(in)...)
+	errorList = append(errorList, validateInitContainers(&in.Spec)...)
+
+	switch in.Spec.RestartPolicy {
+	case "", corev1.RestartPolicyNever, corev1.RestartPolicyOnFailure, corev1.RestartPolicyAlways:
+	default:
+		errorList = append(errorList, field.NotSupported(field.NewPath("Spec", "RestartPolicy"), in.Spec.RestartPolicy, []string{string(corev1.RestartPolicyAlways), string(corev1.RestartPolicyOnFailure), string(corev1.RestartPolicyNever)}))
+	}
+
+	if in.Spec.RuntimeClassName != nil && len(*in.Spec.RuntimeClassName) == 0 {
+		err := field.Error{
+			Type:   field.ErrorTypeInvalid,
+			Field:  "Spec.RuntimeClassName",
+			Detail: "Runtime class name must not be empty if it's set",
+		}
+		errorList = append(errorList, &err)
+	}
+
+	for i, ref := range in.Spec.ImagePullSecrets {
+		if len(ref.Name) == 0 {
+			err := field.Error{
+				Type:  field.ErrorTypeRequired,
+				Field: fmt.Sprintf("Spec.ImagePullSecrets[%d].Name", i),
+			}
+			errorList = append(errorList, &err)
+		}
+	}
+
 	if len(errorList) > 0 {
 		return errorList
 	} else {
@@ -322,6 +467,91 @@ func (in *Application) Validate(ctx context.Context) field.ErrorList {
 	}
 }
 
+// validateVolumes check volume names are unique, container volume mounts reference a defined volume,
+// and each volume is one of supported types, emptyDir, secret, hostPath and configMap of application config data,
+// config map of application has same name as application
+func validateVolumes(app *Application) field.ErrorList {
+	spec := &app.Spec
+	errorList := field.ErrorList{}
+	volumes := map[string]bool{}
+	for i, vol := range spec.Volumes {
+		fldPath := field.NewPath("Spec", "Volumes").Index(i)
+		if len(vol.Name) == 0 {
+			errorList = append(errorList, field.Required(fldPath.Child("Name"), ""))
+		} else if volumes[vol.Name] {
+			errorList = append(errorList, field.Duplicate(fldPath.Child("Name"), vol.Name))
+		}
+		volumes[vol.Name] = true
+		switch {
+		case vol.EmptyDir != nil:
+			if vol.EmptyDir.Medium != corev1.StorageMediumDefault && vol.EmptyDir.Medium != corev1.StorageMediumMemory {
+				errorList = append(errorList, field.NotSupported(fldPath.Child("EmptyDir", "Medium"), vol.EmptyDir.Medium, []string{string(corev1.StorageMediumDefault), string(corev1.StorageMediumMemory)}))
+			}
+			if vol.EmptyDir.SizeLimit != nil && vol.EmptyDir.SizeLimit.Sign() < 0 {
+				errorList = append(errorList, field.Invalid(fldPath.Child("EmptyDir", "SizeLimit"), vol.EmptyDir.SizeLimit.String(), "must not be negative"))
+			}
+		case vol.Secret != nil:
+			if len(vol.Secret.SecretName) == 0 {
+				errorList = append(errorList, field.Required(fldPath.Child("Secret", "SecretName"), ""))
+			}
+		case vol.ConfigMap != nil:
+			if vol.ConfigMap.Name != app.Name {
+				errorList = append(errorList, field.Invalid(fldPath.Child("ConfigMap", "Name"), vol.ConfigMap.Name, "Only config map of application config data is supported, its name is application name"))
+			}
+		case vol.HostPath != nil:
+			if !path.IsAbs(vol.HostPath.Path) {
+				errorList = append(errorList, field.Invalid(fldPath.Child("HostPath", "Path"), vol.HostPath.Path, "must be an absolute path"))
+			}
+		default:
+			errorList = append(errorList, field.Invalid(fldPath, vol.Name, "Only emptyDir, configMap, secret and hostPath volume are supported"))
+		}
+	}
+	for i, cont := range spec.Containers {
+		for j, vm := range cont.VolumeMounts {
+			if !volumes[vm.Name] {
+				errorList = append(errorList, field.NotFound(field.NewPath("Spec", "Containers").Index(i).Child("VolumeMounts").Index(j).Child("Name"), vm.Name))
+			}
+		}
+	}
+	for i, cont := range spec.InitContainers {
+		for j, vm := range cont.VolumeMounts {
+			if !volumes[vm.Name] {
+				errorList = append(errorList, field.NotFound(field.NewPath("Spec", "InitContainers").Index(i).Child("VolumeMounts").Index(j).Child("Name"), vm.Name))
+			}
+		}
+	}
+	return errorList
+}
+
+// validateInitContainers check init container names are unique among all containers,
+// and init containers do not have probes and lifecycle hooks since they are expected to run to completion
+func validateInitContainers(spec *ApplicationSpec) field.ErrorList {
+	errorList := field.ErrorList{}
+	names := map[string]bool{}
+	for _, cont := range spec.Containers {
+		names[cont.Name] = true
+	}
+	for i, cont := range spec.InitContainers {
+		fldPath := field.NewPath("Spec", "InitContainers").Index(i)
+		if len(cont.Name) == 0 {
+			errorList = append(errorList, field.Required(fldPath.Child("Name"), ""))
+		} else if names[cont.Name] {
+			errorList = append(errorList, field.Duplicate(fldPath.Child("Name"), cont.Name))
+		}
+		names[cont.Name] = true
+		if len(cont.Image) == 0 {
+			errorList = append(errorList, field.Required(fldPath.Child("Image"), ""))
+		}
+		if cont.StartupProbe != nil || cont.ReadinessProbe != nil || cont.LivenessProbe != nil {
+			errorList = append(errorList, field.Forbidden(fldPath, "init container must not have probes"))
+		}
+		if cont.Lifecycle != nil {
+			errorList = append(errorList, field.Forbidden(fldPath.Child("Lifecycle"), "init container must not have lifecycle hooks"))
+		}
+	}
+	return errorList
+}
+
 var _ resource.ObjectList = &ApplicationList{}
 
 func (in *ApplicationList) GetListMeta() *metav1.ListMeta {
@@ -101,6 +101,18 @@ const (
 	SessionStatusTimeout SessionStatus = "Timeout"
 )
 
+// These are valid condition types of a session.
+const (
+	// session is sent to a pod selected by fornaxcore
+	SessionConditionScheduled = "Scheduled"
+
+	// pod reported session is started on it
+	SessionConditionAssigned = "Assigned"
+
+	// session is available or in use, client can connect it
+	SessionConditionReady = "Ready"
+)
+
 type AccessEndPoint struct {
 	// TCP/UDP
 	Protocol v1.Protocol `json:"protocol,omitempty" protobuf:"bytes,1,opt,name=protocol,casttype=k8s.io/api/core/v1.Protocol"`
@@ -135,6 +147,22 @@ type ApplicationSessionStatus struct {
 
 	// +optional, for metrics test
 	AvailableTimeMicro int64 `json:"availableTimeMicro,omitempty" protobuf:"varint,6,opt,name=availableTimeMicro"`
+
+	// A brief CamelCase message indicating why session was closed, e.g. Evicted
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,7,opt,name=reason"`
+
+	// A human readable message indicating details about why session was closed
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+
+	// Current state of session, condition types are Scheduled, Assigned and Ready
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,9,rep,name=conditions"`
 }
 
 var _ resource.Object = &ApplicationSession{}
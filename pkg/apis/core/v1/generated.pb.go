@@ -297,10 +297,38 @@ func (m *ApplicationStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 
+func (m *ConfigProjection) Reset()      { *m = ConfigProjection{} }
+func (*ConfigProjection) ProtoMessage() {}
+func (*ConfigProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{9}
+}
+func (m *ConfigProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConfigProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigProjection.Merge(m, src)
+}
+func (m *ConfigProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigProjection proto.InternalMessageInfo
+
 func (m *DeploymentHistory) Reset()      { *m = DeploymentHistory{} }
 func (*DeploymentHistory) ProtoMessage() {}
 func (*DeploymentHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cea0a4ebac5bf7e, []int{9}
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{10}
 }
 func (m *DeploymentHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +356,7 @@ var xxx_messageInfo_DeploymentHistory proto.InternalMessageInfo
 func (m *IdelSessionNumThreshold) Reset()      { *m = IdelSessionNumThreshold{} }
 func (*IdelSessionNumThreshold) ProtoMessage() {}
 func (*IdelSessionNumThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cea0a4ebac5bf7e, []int{10}
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{11}
 }
 func (m *IdelSessionNumThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +384,7 @@ var xxx_messageInfo_IdelSessionNumThreshold proto.InternalMessageInfo
 func (m *IdelSessionPercentThreshold) Reset()      { *m = IdelSessionPercentThreshold{} }
 func (*IdelSessionPercentThreshold) ProtoMessage() {}
 func (*IdelSessionPercentThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cea0a4ebac5bf7e, []int{11}
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{12}
 }
 func (m *IdelSessionPercentThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,10 +409,206 @@ func (m *IdelSessionPercentThreshold) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IdelSessionPercentThreshold proto.InternalMessageInfo
 
+func (m *NodeDaemon) Reset()      { *m = NodeDaemon{} }
+func (*NodeDaemon) ProtoMessage() {}
+func (*NodeDaemon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{13}
+}
+func (m *NodeDaemon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDaemon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeDaemon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDaemon.Merge(m, src)
+}
+func (m *NodeDaemon) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDaemon) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDaemon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDaemon proto.InternalMessageInfo
+
+func (m *NodeDaemonList) Reset()      { *m = NodeDaemonList{} }
+func (*NodeDaemonList) ProtoMessage() {}
+func (*NodeDaemonList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{14}
+}
+func (m *NodeDaemonList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDaemonList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeDaemonList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDaemonList.Merge(m, src)
+}
+func (m *NodeDaemonList) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDaemonList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDaemonList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDaemonList proto.InternalMessageInfo
+
+func (m *NodeDaemonNodeStatus) Reset()      { *m = NodeDaemonNodeStatus{} }
+func (*NodeDaemonNodeStatus) ProtoMessage() {}
+func (*NodeDaemonNodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{15}
+}
+func (m *NodeDaemonNodeStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDaemonNodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeDaemonNodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDaemonNodeStatus.Merge(m, src)
+}
+func (m *NodeDaemonNodeStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDaemonNodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDaemonNodeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDaemonNodeStatus proto.InternalMessageInfo
+
+func (m *NodeDaemonSpec) Reset()      { *m = NodeDaemonSpec{} }
+func (*NodeDaemonSpec) ProtoMessage() {}
+func (*NodeDaemonSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{16}
+}
+func (m *NodeDaemonSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDaemonSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeDaemonSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDaemonSpec.Merge(m, src)
+}
+func (m *NodeDaemonSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDaemonSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDaemonSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDaemonSpec proto.InternalMessageInfo
+
+func (m *NodeDaemonStatus) Reset()      { *m = NodeDaemonStatus{} }
+func (*NodeDaemonStatus) ProtoMessage() {}
+func (*NodeDaemonStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{17}
+}
+func (m *NodeDaemonStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDaemonStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeDaemonStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDaemonStatus.Merge(m, src)
+}
+func (m *NodeDaemonStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDaemonStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDaemonStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDaemonStatus proto.InternalMessageInfo
+
+func (m *NodeDaemonUpdateStrategy) Reset()      { *m = NodeDaemonUpdateStrategy{} }
+func (*NodeDaemonUpdateStrategy) ProtoMessage() {}
+func (*NodeDaemonUpdateStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{18}
+}
+func (m *NodeDaemonUpdateStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDaemonUpdateStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeDaemonUpdateStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDaemonUpdateStrategy.Merge(m, src)
+}
+func (m *NodeDaemonUpdateStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDaemonUpdateStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDaemonUpdateStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDaemonUpdateStrategy proto.InternalMessageInfo
+
+func (m *RollingUpdatePolicy) Reset()      { *m = RollingUpdatePolicy{} }
+func (*RollingUpdatePolicy) ProtoMessage() {}
+func (*RollingUpdatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{19}
+}
+func (m *RollingUpdatePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollingUpdatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RollingUpdatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollingUpdatePolicy.Merge(m, src)
+}
+func (m *RollingUpdatePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollingUpdatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollingUpdatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollingUpdatePolicy proto.InternalMessageInfo
+
 func (m *ScalingPolicy) Reset()      { *m = ScalingPolicy{} }
 func (*ScalingPolicy) ProtoMessage() {}
 func (*ScalingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cea0a4ebac5bf7e, []int{12}
+	return fileDescriptor_2cea0a4ebac5bf7e, []int{20}
 }
 func (m *ScalingPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,10 +643,20 @@ func init() {
 	proto.RegisterType((*ApplicationSessionStatus)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.ApplicationSessionStatus")
 	proto.RegisterType((*ApplicationSpec)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.ApplicationSpec")
 	proto.RegisterMapType((map[string]string)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.ApplicationSpec.ConfigDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.ApplicationSpec.NodeSelectorEntry")
 	proto.RegisterType((*ApplicationStatus)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.ApplicationStatus")
+	proto.RegisterType((*ConfigProjection)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.ConfigProjection")
 	proto.RegisterType((*DeploymentHistory)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.DeploymentHistory")
 	proto.RegisterType((*IdelSessionNumThreshold)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.IdelSessionNumThreshold")
 	proto.RegisterType((*IdelSessionPercentThreshold)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.IdelSessionPercentThreshold")
+	proto.RegisterType((*NodeDaemon)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.NodeDaemon")
+	proto.RegisterType((*NodeDaemonList)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.NodeDaemonList")
+	proto.RegisterType((*NodeDaemonNodeStatus)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.NodeDaemonNodeStatus")
+	proto.RegisterType((*NodeDaemonSpec)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.NodeDaemonSpec")
+	proto.RegisterMapType((map[string]string)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.NodeDaemonSpec.NodeSelectorEntry")
+	proto.RegisterType((*NodeDaemonStatus)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.NodeDaemonStatus")
+	proto.RegisterType((*NodeDaemonUpdateStrategy)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.NodeDaemonUpdateStrategy")
+	proto.RegisterType((*RollingUpdatePolicy)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.RollingUpdatePolicy")
 	proto.RegisterType((*ScalingPolicy)(nil), "centaurusinfra.io.fornax_serverless.pkg.apis.core.v1.ScalingPolicy")
 }
 
@@ -431,105 +665,154 @@ func init() {
 }
 
 var fileDescriptor_2cea0a4ebac5bf7e = []byte{
-	// 1556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0xb3, 0x7e, 0xc9, 0xcb, 0xf8, 0xe7, 0xbc, 0xcc, 0x0f, 0x1a, 0x93, 0x28, 0x4e, 0x64,
-	0x44, 0x15, 0x10, 0x5d, 0x93, 0xa8, 0xa0, 0xaa, 0x08, 0x24, 0x6f, 0x52, 0xb5, 0xa1, 0x49, 0xea,
-	0x4e, 0x12, 0x15, 0xaa, 0xaa, 0x30, 0xd9, 0x9d, 0xd8, 0x43, 0xd6, 0x3b, 0xab, 0x9d, 0xb5, 0xdb,
-	0xdc, 0x7a, 0x40, 0x42, 0x1c, 0x90, 0xf8, 0x1b, 0x10, 0x47, 0x0e, 0x1c, 0xf8, 0x23, 0xca, 0xad,
-	0x37, 0x7a, 0x40, 0x11, 0x35, 0x82, 0x3f, 0x81, 0x43, 0x4e, 0x68, 0x66, 0x67, 0xbd, 0x2f, 0xb6,
-	0xa3, 0x36, 0x89, 0x7a, 0xdb, 0x9d, 0xe7, 0x79, 0x3e, 0xcf, 0x33, 0x33, 0xdf, 0x79, 0x76, 0x6c,
-	0xb0, 0x6e, 0x12, 0xc7, 0xc7, 0x6d, 0xaf, 0xcd, 0xa9, 0x73, 0xe0, 0x61, 0x9d, 0xb2, 0xea, 0x01,
-	0xf3, 0x1c, 0xfc, 0xf8, 0x0a, 0x27, 0x5e, 0x87, 0x78, 0x36, 0xe1, 0xbc, 0xea, 0x1e, 0x36, 0xaa,
-	0xd8, 0xa5, 0xbc, 0x6a, 0x32, 0x8f, 0x54, 0x3b, 0x2b, 0xd5, 0x06, 0x71, 0x88, 0x87, 0x7d, 0x62,
-	0xe9, 0xae, 0xc7, 0x7c, 0x06, 0xaf, 0xf6, 0x51, 0xf4, 0x80, 0xf2, 0x65, 0x44, 0xd1, 0xdd, 0xc3,
-	0x86, 0x2e, 0x28, 0xba, 0xa0, 0xe8, 0x9d, 0x95, 0xb9, 0x2b, 0x0d, 0xea, 0x37, 0xdb, 0xfb, 0xba,
-	0xc9, 0x5a, 0xd5, 0x06, 0x6b, 0xb0, 0xaa, 0x84, 0xed, 0xb7, 0x0f, 0xe4, 0x9b, 0x7c, 0x91, 0x4f,
-	0x41, 0x92, 0xb9, 0xca, 0xe1, 0x35, 0x2e, 0xea, 0xc3, 0x2e, 0x1d, 0x56, 0xc8, 0xdc, 0xd5, 0xc8,
-	0xa7, 0x85, 0xcd, 0x26, 0x75, 0x88, 0x77, 0x14, 0x95, 0xdf, 0x22, 0x3e, 0x1e, 0x14, 0xf5, 0xd1,
-	0xb0, 0x28, 0xaf, 0xed, 0xf8, 0xb4, 0x45, 0xaa, 0xdc, 0x6c, 0x92, 0x16, 0x4e, 0xc7, 0x55, 0x7e,
-	0xd1, 0xc0, 0x64, 0xcd, 0x34, 0x09, 0xe7, 0x37, 0x1c, 0xab, 0xce, 0xa8, 0xe3, 0xc3, 0xdb, 0x60,
-	0x5c, 0xda, 0x4c, 0x66, 0x97, 0xb4, 0x25, 0x6d, 0x79, 0xc2, 0xa8, 0x3e, 0x3d, 0x5e, 0x1c, 0xe9,
-	0x1e, 0x2f, 0x8e, 0xd7, 0xd5, 0xf8, 0xc9, 0xf1, 0xe2, 0x7c, 0xff, 0x54, 0xf4, 0xd0, 0x8c, 0x7a,
-	0x00, 0x58, 0x05, 0x13, 0xd4, 0xad, 0x59, 0x96, 0x47, 0x38, 0x2f, 0x65, 0x24, 0x6d, 0x46, 0xd1,
-	0x26, 0x36, 0xea, 0xca, 0x80, 0x22, 0x1f, 0xb8, 0x04, 0x72, 0x2e, 0xf3, 0xfc, 0x52, 0x76, 0x49,
-	0x5b, 0xce, 0x1b, 0xff, 0x53, 0xbe, 0xb9, 0x3a, 0xf3, 0x7c, 0x24, 0x2d, 0x95, 0xdf, 0x32, 0xa0,
-	0x50, 0x73, 0x5d, 0x9b, 0x9a, 0xd8, 0xa7, 0xcc, 0x81, 0x5f, 0x81, 0x71, 0xb1, 0x2a, 0x16, 0xf6,
-	0xb1, 0xac, 0xb7, 0xb0, 0xfa, 0x81, 0x1e, 0x14, 0xa7, 0xc7, 0x57, 0x23, 0xda, 0x3c, 0xe1, 0xad,
-	0x77, 0x56, 0xf4, 0x3b, 0xfb, 0x5f, 0x13, 0xd3, 0xdf, 0x22, 0x3e, 0x36, 0xa0, 0xca, 0x03, 0xa2,
-	0x31, 0xd4, 0xa3, 0xc2, 0x06, 0xc8, 0x71, 0x97, 0x98, 0xb2, 0xfe, 0xc2, 0xea, 0x0d, 0xfd, 0x2c,
-	0x52, 0xd1, 0x63, 0x25, 0xef, 0xb8, 0xc4, 0x8c, 0xa6, 0x26, 0xde, 0x90, 0x4c, 0x00, 0x19, 0x18,
-	0xe5, 0x3e, 0xf6, 0xdb, 0x5c, 0x4e, 0xbf, 0xb0, 0x7a, 0xf3, 0xfc, 0xa9, 0x24, 0xce, 0x98, 0x54,
-	0xc9, 0x46, 0x83, 0x77, 0xa4, 0xd2, 0x54, 0x7e, 0xd7, 0xc0, 0x54, 0xcc, 0x7b, 0x93, 0x72, 0x1f,
-	0x3e, 0xe8, 0x5b, 0x4f, 0xfd, 0xe5, 0xd6, 0x53, 0x44, 0xcb, 0xd5, 0x9c, 0x0e, 0xf5, 0x12, 0x8e,
-	0xc4, 0xd6, 0xf2, 0x00, 0xe4, 0xa9, 0x4f, 0x5a, 0x42, 0x0c, 0xd9, 0xe5, 0xc2, 0x6a, 0xed, 0xdc,
-	0x33, 0x34, 0x8a, 0x2a, 0x5b, 0x7e, 0x43, 0x70, 0x51, 0x80, 0xaf, 0x1c, 0x67, 0x00, 0x8c, 0xaf,
-	0x03, 0xe1, 0xfc, 0xf5, 0x88, 0xc5, 0x49, 0x88, 0x65, 0xf3, 0xfc, 0x3b, 0x18, 0x54, 0x3e, 0x54,
-	0x33, 0x9d, 0x94, 0x66, 0xb6, 0x2f, 0x2c, 0xe3, 0xe9, 0xd2, 0xf9, 0x5b, 0x03, 0x97, 0xfa, 0x83,
-	0x5e, 0x83, 0x82, 0x5a, 0x49, 0x05, 0xdd, 0xba, 0xa8, 0xf9, 0x0e, 0x11, 0xd2, 0x8f, 0xd9, 0x41,
-	0xf3, 0x14, 0x1b, 0x00, 0x6b, 0x60, 0x0a, 0x47, 0x96, 0x6d, 0xdc, 0x22, 0xaa, 0x61, 0xce, 0x2a,
-	0x52, 0xfc, 0x6c, 0x09, 0x33, 0x4a, 0xfb, 0xc3, 0x0f, 0x41, 0x81, 0x07, 0xc4, 0x75, 0xb1, 0x5a,
-	0x41, 0x87, 0xfc, 0xbf, 0x0a, 0x2f, 0xec, 0x44, 0x26, 0x14, 0xf7, 0x83, 0x87, 0x60, 0xe1, 0x90,
-	0xda, 0xf6, 0x86, 0xc3, 0x7d, 0xec, 0x98, 0xe4, 0x5e, 0x93, 0x84, 0x85, 0xad, 0xd9, 0x8c, 0x13,
-	0x4b, 0x6a, 0x61, 0xdc, 0x78, 0x47, 0x81, 0x16, 0x6e, 0x9f, 0xe6, 0x8c, 0x4e, 0x67, 0xc1, 0x3d,
-	0x30, 0x6b, 0x8a, 0xa7, 0x9b, 0x1e, 0x36, 0x49, 0x9d, 0x78, 0x94, 0x59, 0x3b, 0xc4, 0x64, 0x8e,
-	0xc5, 0x4b, 0xb9, 0x25, 0x6d, 0xb9, 0x68, 0xcc, 0x77, 0x8f, 0x17, 0x67, 0xd7, 0x06, 0xbb, 0xa0,
-	0x61, 0xb1, 0xf0, 0x33, 0x00, 0x99, 0x4b, 0x9c, 0x5d, 0xda, 0x22, 0xac, 0xed, 0x87, 0xc4, 0xbc,
-	0x24, 0xce, 0xa9, 0xc2, 0xe1, 0x9d, 0x3e, 0x0f, 0x34, 0x20, 0xaa, 0xf2, 0x4f, 0x0e, 0x94, 0x86,
-	0x29, 0x18, 0x7e, 0xab, 0x81, 0x29, 0x9c, 0xf8, 0xc6, 0xf1, 0x92, 0x26, 0xb5, 0xb3, 0x7e, 0x46,
-	0xed, 0x24, 0x60, 0xb1, 0xdd, 0x4e, 0x26, 0x41, 0xe9, 0xac, 0x70, 0x13, 0x14, 0x79, 0xbc, 0x34,
-	0xb5, 0xdf, 0x97, 0x15, 0xa0, 0x98, 0xa8, 0xfb, 0x24, 0x3d, 0x80, 0x92, 0xc1, 0xb0, 0x09, 0x26,
-	0x4d, 0x9b, 0x12, 0xc7, 0x57, 0x5e, 0xa2, 0x03, 0x88, 0x59, 0x2d, 0xc7, 0x0e, 0x5b, 0xaf, 0xe6,
-	0x4d, 0x66, 0x62, 0x3b, 0x68, 0x58, 0x88, 0x1c, 0x10, 0x8f, 0x38, 0x26, 0x31, 0x2e, 0xa9, 0xc4,
-	0x93, 0x6b, 0x09, 0x0e, 0x4a, 0x71, 0xa1, 0x09, 0x8a, 0xb8, 0x83, 0xa9, 0x8d, 0xf7, 0x6d, 0x22,
-	0x56, 0x5e, 0xee, 0x7b, 0x61, 0xf5, 0xbd, 0x97, 0x3b, 0xd5, 0x22, 0xc2, 0x98, 0x11, 0xf3, 0xab,
-	0xc5, 0x21, 0x28, 0xc9, 0x84, 0xf7, 0xc0, 0x84, 0x94, 0x8a, 0x4c, 0x90, 0x7f, 0xe5, 0x04, 0x45,
-	0x71, 0xa5, 0x58, 0x0b, 0x01, 0x28, 0x62, 0x09, 0xa1, 0x25, 0x32, 0x6d, 0x51, 0xd3, 0x63, 0xa5,
-	0xd1, 0x25, 0x6d, 0x39, 0x1b, 0x09, 0xad, 0xd6, 0xe7, 0x81, 0x06, 0x44, 0x55, 0xbe, 0xcf, 0x25,
-	0x3e, 0x98, 0xb2, 0x0d, 0xdc, 0x05, 0xc0, 0x64, 0x8e, 0x8f, 0x45, 0x75, 0xa1, 0xb2, 0x16, 0x06,
-	0xed, 0xc1, 0x5a, 0xe8, 0x15, 0x7d, 0x42, 0x7a, 0x43, 0x1c, 0xc5, 0x20, 0xf0, 0x0b, 0x30, 0x2b,
-	0x24, 0xd9, 0xd8, 0x66, 0x16, 0x09, 0x35, 0x40, 0xbc, 0x0e, 0x35, 0x89, 0x94, 0xcc, 0xb8, 0xb1,
-	0xa8, 0x00, 0xb3, 0x7b, 0x83, 0xdd, 0xd0, 0xb0, 0x78, 0xf8, 0x9d, 0x26, 0xcb, 0x3d, 0xa0, 0x0d,
-	0xd9, 0x71, 0x02, 0xc9, 0xec, 0x5d, 0xc8, 0x9d, 0x46, 0x4c, 0x50, 0x71, 0x6f, 0x38, 0xbe, 0x77,
-	0x94, 0x98, 0xa6, 0x32, 0xa0, 0x58, 0x72, 0xf8, 0x44, 0x03, 0x45, 0x6e, 0x62, 0x9b, 0x3a, 0x8d,
-	0x3a, 0xb3, 0xa9, 0x79, 0xa4, 0x84, 0xb5, 0x76, 0xb6, 0x72, 0x76, 0xe2, 0x28, 0xe3, 0xcd, 0xde,
-	0xa9, 0x8a, 0x0f, 0xa3, 0x64, 0xc2, 0xb9, 0x4f, 0xc0, 0x54, 0xaa, 0x6a, 0x38, 0x0d, 0xb2, 0x87,
-	0xe4, 0x28, 0x68, 0xe5, 0x48, 0x3c, 0xc2, 0x37, 0x40, 0xbe, 0x83, 0xed, 0x76, 0xb0, 0xf8, 0x13,
-	0x28, 0x78, 0xb9, 0x9e, 0xb9, 0xa6, 0x55, 0x7e, 0xce, 0x83, 0x99, 0xbe, 0xeb, 0x16, 0x5c, 0x07,
-	0xd3, 0x16, 0xe1, 0xd4, 0x23, 0x56, 0xd8, 0x55, 0xb9, 0xc4, 0xe5, 0x8d, 0x92, 0x2a, 0x6a, 0x7a,
-	0x3d, 0x65, 0x47, 0x7d, 0x11, 0xf0, 0x53, 0x30, 0xe9, 0x33, 0x1f, 0xdb, 0x11, 0x23, 0x23, 0x19,
-	0xbd, 0x53, 0xbb, 0x9b, 0xb0, 0xa2, 0x94, 0xb7, 0xa8, 0xc2, 0x25, 0x8e, 0x45, 0x9d, 0x46, 0x44,
-	0xc8, 0x26, 0xab, 0xa8, 0xa7, 0xec, 0xa8, 0x2f, 0x02, 0xde, 0x04, 0x33, 0x16, 0xb1, 0x89, 0x9f,
-	0xc0, 0xe4, 0x24, 0xe6, 0x2d, 0x85, 0x99, 0x59, 0x4f, 0x3b, 0xa0, 0xfe, 0x18, 0x79, 0x0c, 0x6d,
-	0x9b, 0x99, 0xe2, 0xd7, 0x47, 0x44, 0xca, 0x4b, 0x52, 0x74, 0x0c, 0xfb, 0x3c, 0xd0, 0x80, 0x28,
-	0xf8, 0x31, 0x28, 0x52, 0xcb, 0x26, 0x11, 0x66, 0x54, 0x62, 0x7a, 0x5b, 0xbe, 0x11, 0x37, 0xa2,
-	0xa4, 0x2f, 0xfc, 0x46, 0x03, 0x45, 0x1b, 0xfb, 0x84, 0xfb, 0xb7, 0x28, 0xf7, 0x99, 0x77, 0x54,
-	0x1a, 0x3b, 0xcf, 0x6d, 0x7b, 0x9d, 0xb8, 0x36, 0x3b, 0x6a, 0x11, 0x27, 0xc4, 0x45, 0x65, 0x6c,
-	0xc6, 0xb3, 0xa0, 0x64, 0x52, 0xe8, 0x81, 0xb1, 0xa6, 0xca, 0x3f, 0x2e, 0x0f, 0xe1, 0x85, 0xe5,
-	0x9f, 0x52, 0xf9, 0xc7, 0xc2, 0xcc, 0x61, 0xa2, 0xca, 0x1f, 0x19, 0x30, 0xd3, 0xe7, 0x0f, 0xaf,
-	0x83, 0x51, 0x6c, 0x0a, 0xf9, 0xaa, 0xeb, 0x4b, 0x25, 0xbc, 0xf2, 0xd5, 0xe4, 0xe8, 0x89, 0x94,
-	0x6b, 0x18, 0x14, 0x8c, 0x21, 0x15, 0x01, 0x1f, 0x02, 0xd0, 0x76, 0x2d, 0xec, 0x07, 0x6d, 0x3b,
-	0xf3, 0xca, 0x6d, 0xbb, 0xd7, 0x22, 0xf6, 0x7a, 0x14, 0x14, 0x23, 0xc2, 0xcb, 0x60, 0xd4, 0x23,
-	0x98, 0x33, 0x47, 0x4a, 0x77, 0x22, 0xba, 0x8e, 0x22, 0x39, 0x8a, 0x94, 0x15, 0xbe, 0x0b, 0xc6,
-	0x5a, 0x84, 0x73, 0xdc, 0x08, 0x3e, 0x4e, 0x13, 0xd1, 0x22, 0x6c, 0x05, 0xc3, 0x28, 0xb4, 0xc3,
-	0xcf, 0xc5, 0xe9, 0x0c, 0xa7, 0xa3, 0x3e, 0xc4, 0x79, 0x19, 0xf3, 0x7e, 0x74, 0x3a, 0x93, 0xf6,
-	0x93, 0x01, 0x63, 0xa8, 0x8f, 0x52, 0xb9, 0x0f, 0x66, 0x37, 0x2c, 0x62, 0xab, 0x8e, 0xbb, 0xdd,
-	0x6e, 0xed, 0x36, 0x3d, 0xc2, 0x9b, 0xcc, 0xb6, 0xc4, 0xef, 0xda, 0x26, 0x6d, 0x34, 0xe5, 0x0a,
-	0x17, 0xa3, 0x8b, 0xfc, 0x2d, 0xda, 0x68, 0x22, 0x69, 0x81, 0x0b, 0x20, 0x6b, 0xb3, 0x47, 0x72,
-	0x09, 0x8b, 0x46, 0x41, 0x39, 0x64, 0x37, 0xd9, 0x23, 0x24, 0xc6, 0x2b, 0x0f, 0xc1, 0x7c, 0x8c,
-	0x5d, 0x27, 0x9e, 0x10, 0xcb, 0x05, 0xf2, 0xff, 0xcd, 0x81, 0x64, 0xa7, 0x14, 0xd7, 0xdb, 0x16,
-	0x75, 0x68, 0xab, 0xdd, 0x0a, 0xcf, 0x8e, 0xa2, 0xf7, 0x2e, 0x3c, 0x5b, 0x49, 0x33, 0x4a, 0xfb,
-	0x4b, 0x04, 0x7e, 0x9c, 0x40, 0x64, 0x52, 0x88, 0xa4, 0x19, 0xa5, 0xfd, 0xe1, 0xdb, 0x20, 0xbf,
-	0xdf, 0xf6, 0x78, 0xf0, 0x8f, 0x40, 0x31, 0xba, 0xa4, 0x1b, 0x62, 0x10, 0x05, 0x36, 0xf8, 0x00,
-	0xcc, 0x24, 0xda, 0xfa, 0xee, 0x91, 0x1b, 0xea, 0x40, 0x0f, 0x9b, 0xd4, 0x4e, 0xda, 0xe1, 0x64,
-	0xd0, 0x20, 0xea, 0x07, 0xc1, 0x9f, 0x34, 0x30, 0x2b, 0x5a, 0xc8, 0x80, 0x7d, 0x55, 0x17, 0x95,
-	0xad, 0xb3, 0x1d, 0xdd, 0x21, 0x62, 0x09, 0x2e, 0xd4, 0x1b, 0x83, 0x33, 0xa2, 0x61, 0xa5, 0xc0,
-	0x5f, 0x35, 0x30, 0x1f, 0xb3, 0xa5, 0x25, 0x22, 0x7b, 0x64, 0x61, 0xf5, 0xee, 0xb9, 0x4b, 0x4d,
-	0x83, 0x8d, 0xc5, 0xee, 0xf1, 0xe2, 0xfc, 0xc6, 0xf0, 0xcc, 0xe8, 0xb4, 0xb2, 0x8c, 0xe5, 0xa7,
-	0x2f, 0xca, 0x23, 0xcf, 0x5e, 0x94, 0x47, 0x9e, 0xbf, 0x28, 0x8f, 0x3c, 0xe9, 0x96, 0xb5, 0xa7,
-	0xdd, 0xb2, 0xf6, 0xac, 0x5b, 0xd6, 0x9e, 0x77, 0xcb, 0xda, 0x9f, 0xdd, 0xb2, 0xf6, 0xc3, 0x5f,
-	0xe5, 0x91, 0xfb, 0x99, 0xce, 0xca, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0x4f, 0xf6, 0x9f,
-	0xea, 0x13, 0x00, 0x00,
+	// 2344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xdf, 0x9e, 0xf1, 0xf8, 0xa3, 0x66, 0xc7, 0x1f, 0xb5, 0xcb, 0x7a, 0xb0, 0xb5, 0x63, 0x6b,
+	0x22, 0x22, 0x83, 0x92, 0x19, 0xd6, 0x0a, 0x28, 0x04, 0x01, 0xf1, 0x8c, 0x37, 0x59, 0x27, 0xb6,
+	0x33, 0x29, 0xdb, 0xbb, 0xb0, 0x0a, 0x09, 0xe5, 0xee, 0xf2, 0x4c, 0xe3, 0xee, 0xae, 0x51, 0x57,
+	0xcd, 0x64, 0x7d, 0x8b, 0x44, 0x14, 0xe0, 0x82, 0x92, 0x7f, 0x80, 0x03, 0xe2, 0x8a, 0x04, 0x12,
+	0x7f, 0x03, 0x5a, 0x6e, 0xb9, 0x20, 0x72, 0x40, 0x16, 0x6b, 0xc4, 0xbf, 0xc0, 0xc1, 0x27, 0x54,
+	0xd5, 0xd5, 0x1f, 0xd5, 0xdd, 0xe3, 0xec, 0x7a, 0x2c, 0xdf, 0xa6, 0xdf, 0xc7, 0xef, 0xbd, 0xaa,
+	0x7a, 0xef, 0xd5, 0xab, 0x37, 0x60, 0xd3, 0x24, 0x1e, 0xc7, 0x03, 0x7f, 0xc0, 0x6c, 0xef, 0xc8,
+	0xc7, 0x0d, 0x9b, 0x36, 0x8f, 0xa8, 0xef, 0xe1, 0x27, 0xaf, 0x32, 0xe2, 0x0f, 0x89, 0xef, 0x10,
+	0xc6, 0x9a, 0xfd, 0xe3, 0x6e, 0x13, 0xf7, 0x6d, 0xd6, 0x34, 0xa9, 0x4f, 0x9a, 0xc3, 0x7b, 0xcd,
+	0x2e, 0xf1, 0x88, 0x8f, 0x39, 0xb1, 0x1a, 0x7d, 0x9f, 0x72, 0x0a, 0x5f, 0xcb, 0xa0, 0x34, 0x02,
+	0x94, 0x8f, 0x62, 0x94, 0x46, 0xff, 0xb8, 0xdb, 0x10, 0x28, 0x0d, 0x81, 0xd2, 0x18, 0xde, 0x5b,
+	0x7a, 0xb5, 0x6b, 0xf3, 0xde, 0xe0, 0xb0, 0x61, 0x52, 0xb7, 0xd9, 0xa5, 0x5d, 0xda, 0x94, 0x60,
+	0x87, 0x83, 0x23, 0xf9, 0x25, 0x3f, 0xe4, 0xaf, 0xc0, 0xc8, 0x52, 0xfd, 0xf8, 0x75, 0x26, 0xfc,
+	0xc3, 0x7d, 0x7b, 0x94, 0x23, 0x4b, 0xaf, 0xc5, 0x32, 0x2e, 0x36, 0x7b, 0xb6, 0x47, 0xfc, 0x93,
+	0xd8, 0x7d, 0x97, 0x70, 0x9c, 0xa7, 0xf5, 0xfd, 0x51, 0x5a, 0xfe, 0xc0, 0xe3, 0xb6, 0x4b, 0x9a,
+	0xcc, 0xec, 0x11, 0x17, 0xa7, 0xf5, 0xea, 0x7f, 0x36, 0xc0, 0xec, 0x86, 0x69, 0x12, 0xc6, 0xee,
+	0x7b, 0x56, 0x87, 0xda, 0x1e, 0x87, 0xef, 0x82, 0x69, 0xc9, 0x33, 0xa9, 0x53, 0x35, 0x56, 0x8d,
+	0xb5, 0x99, 0x56, 0xf3, 0xe9, 0xe9, 0xca, 0x8d, 0xb3, 0xd3, 0x95, 0xe9, 0x8e, 0xa2, 0x9f, 0x9f,
+	0xae, 0x2c, 0x67, 0x97, 0xd2, 0x08, 0xd9, 0x28, 0x02, 0x80, 0x4d, 0x30, 0x63, 0xf7, 0x37, 0x2c,
+	0xcb, 0x27, 0x8c, 0x55, 0x0b, 0x12, 0x6d, 0x41, 0xa1, 0xcd, 0x6c, 0x75, 0x14, 0x03, 0xc5, 0x32,
+	0x70, 0x15, 0x4c, 0xf4, 0xa9, 0xcf, 0xab, 0xc5, 0x55, 0x63, 0xad, 0xd4, 0xba, 0xa9, 0x64, 0x27,
+	0x3a, 0xd4, 0xe7, 0x48, 0x72, 0xea, 0x7f, 0x2f, 0x80, 0xf2, 0x46, 0xbf, 0xef, 0xd8, 0x26, 0xe6,
+	0x36, 0xf5, 0xe0, 0x2f, 0xc0, 0xb4, 0xd8, 0x15, 0x0b, 0x73, 0x2c, 0xfd, 0x2d, 0xaf, 0x7f, 0xb7,
+	0x11, 0x38, 0xd7, 0x48, 0xee, 0x46, 0x7c, 0x78, 0x42, 0xba, 0x31, 0xbc, 0xd7, 0x78, 0xef, 0xf0,
+	0x97, 0xc4, 0xe4, 0x3b, 0x84, 0xe3, 0x16, 0x54, 0x76, 0x40, 0x4c, 0x43, 0x11, 0x2a, 0xec, 0x82,
+	0x09, 0xd6, 0x27, 0xa6, 0xf4, 0xbf, 0xbc, 0x7e, 0xbf, 0x71, 0x99, 0x50, 0x69, 0x24, 0x5c, 0xde,
+	0xeb, 0x13, 0x33, 0x5e, 0x9a, 0xf8, 0x42, 0xd2, 0x00, 0xa4, 0x60, 0x92, 0x71, 0xcc, 0x07, 0x4c,
+	0x2e, 0xbf, 0xbc, 0xfe, 0xf6, 0xf8, 0xa6, 0x24, 0x5c, 0x6b, 0x56, 0x19, 0x9b, 0x0c, 0xbe, 0x91,
+	0x32, 0x53, 0xff, 0xa7, 0x01, 0xe6, 0x12, 0xd2, 0xdb, 0x36, 0xe3, 0xf0, 0x83, 0xcc, 0x7e, 0x36,
+	0x9e, 0x6f, 0x3f, 0x85, 0xb6, 0xdc, 0xcd, 0xf9, 0x30, 0x5e, 0x42, 0x4a, 0x62, 0x2f, 0x8f, 0x40,
+	0xc9, 0xe6, 0xc4, 0x15, 0xc1, 0x50, 0x5c, 0x2b, 0xaf, 0x6f, 0x8c, 0xbd, 0xc2, 0x56, 0x45, 0x59,
+	0x2b, 0x6d, 0x09, 0x5c, 0x14, 0xc0, 0xd7, 0x4f, 0x0b, 0x00, 0x26, 0xf7, 0x81, 0x30, 0x76, 0x3d,
+	0xc1, 0xe2, 0x69, 0xc1, 0xb2, 0x3d, 0xfe, 0x09, 0x06, 0x9e, 0x8f, 0x8c, 0x99, 0x61, 0x2a, 0x66,
+	0x76, 0xaf, 0xcc, 0xe2, 0xc5, 0xa1, 0xf3, 0x5f, 0x03, 0xdc, 0xc9, 0x2a, 0x5d, 0x43, 0x04, 0xb9,
+	0x7a, 0x04, 0x3d, 0xb8, 0xaa, 0xf5, 0x8e, 0x08, 0xa4, 0x3f, 0x14, 0xf3, 0xd6, 0x29, 0x0e, 0x00,
+	0x6e, 0x80, 0x39, 0x1c, 0x73, 0x76, 0xb1, 0x4b, 0x54, 0xc1, 0x5c, 0x54, 0x48, 0x73, 0x1b, 0x3a,
+	0x1b, 0xa5, 0xe5, 0xe1, 0xf7, 0x40, 0x99, 0x05, 0x88, 0x9b, 0x62, 0xb7, 0x82, 0x0a, 0x79, 0x4b,
+	0xa9, 0x97, 0xf7, 0x62, 0x16, 0x4a, 0xca, 0xc1, 0x63, 0x70, 0xf7, 0xd8, 0x76, 0x9c, 0x2d, 0x8f,
+	0x71, 0xec, 0x99, 0xe4, 0x51, 0x8f, 0x84, 0x8e, 0xb5, 0x1d, 0xca, 0x88, 0x25, 0x63, 0x61, 0xba,
+	0xf5, 0x2d, 0x05, 0x74, 0xf7, 0xdd, 0x8b, 0x84, 0xd1, 0xc5, 0x58, 0xf0, 0x00, 0x2c, 0x9a, 0xe2,
+	0xd7, 0xdb, 0x3e, 0x36, 0x49, 0x87, 0xf8, 0x36, 0xb5, 0xf6, 0x88, 0x49, 0x3d, 0x8b, 0x55, 0x27,
+	0x56, 0x8d, 0xb5, 0x4a, 0x6b, 0xf9, 0xec, 0x74, 0x65, 0xb1, 0x9d, 0x2f, 0x82, 0x46, 0xe9, 0xc2,
+	0x77, 0x00, 0xa4, 0x7d, 0xe2, 0xed, 0xdb, 0x2e, 0xa1, 0x03, 0x1e, 0x22, 0x96, 0x24, 0xe2, 0x92,
+	0x72, 0x1c, 0xbe, 0x97, 0x91, 0x40, 0x39, 0x5a, 0xf5, 0xdf, 0x4f, 0x82, 0xea, 0xa8, 0x08, 0x86,
+	0xbf, 0x36, 0xc0, 0x1c, 0xd6, 0xee, 0x38, 0x56, 0x35, 0x64, 0xec, 0x6c, 0x5e, 0x32, 0x76, 0x34,
+	0xb0, 0xc4, 0x69, 0xeb, 0x46, 0x50, 0xda, 0x2a, 0xdc, 0x06, 0x15, 0x96, 0x74, 0x4d, 0x9d, 0xf7,
+	0xcb, 0x0a, 0xa0, 0xa2, 0xf9, 0x7d, 0x9e, 0x26, 0x20, 0x5d, 0x19, 0xf6, 0xc0, 0xac, 0xe9, 0xd8,
+	0xc4, 0xe3, 0x4a, 0x4a, 0x54, 0x00, 0xb1, 0xaa, 0xb5, 0x44, 0xb2, 0x45, 0x3e, 0x6f, 0x53, 0x13,
+	0x3b, 0x41, 0xc1, 0x42, 0xe4, 0x88, 0xf8, 0xc4, 0x33, 0x49, 0xeb, 0x8e, 0x32, 0x3c, 0xdb, 0xd6,
+	0x70, 0x50, 0x0a, 0x17, 0x9a, 0xa0, 0x82, 0x87, 0xd8, 0x76, 0xf0, 0xa1, 0x43, 0xc4, 0xce, 0xcb,
+	0x73, 0x2f, 0xaf, 0x7f, 0xe7, 0xf9, 0xb2, 0x5a, 0x68, 0xb4, 0x16, 0xc4, 0xfa, 0x36, 0x92, 0x20,
+	0x48, 0xc7, 0x84, 0x8f, 0xc0, 0x8c, 0x0c, 0x15, 0x69, 0xa0, 0xf4, 0xc2, 0x06, 0x2a, 0xa2, 0xa5,
+	0x68, 0x87, 0x00, 0x28, 0xc6, 0x12, 0x81, 0xa6, 0x59, 0xda, 0xb1, 0x4d, 0x9f, 0x56, 0x27, 0x57,
+	0x8d, 0xb5, 0x62, 0x1c, 0x68, 0x1b, 0x19, 0x09, 0x94, 0xa3, 0x05, 0x5f, 0x06, 0x93, 0x3e, 0xc1,
+	0x8c, 0x7a, 0xd5, 0x29, 0x79, 0x74, 0x51, 0x75, 0x44, 0x92, 0x8a, 0x14, 0x17, 0x7e, 0x1b, 0x4c,
+	0xb9, 0x84, 0x31, 0xdc, 0x25, 0xd5, 0x69, 0x29, 0x38, 0xa7, 0x04, 0xa7, 0x76, 0x02, 0x32, 0x0a,
+	0xf9, 0xd0, 0x04, 0x40, 0x04, 0xb1, 0xcd, 0xe5, 0x11, 0xce, 0xc8, 0x23, 0x6c, 0x3e, 0xdf, 0xc2,
+	0xdb, 0xa1, 0x5e, 0x7c, 0x27, 0x45, 0x24, 0x86, 0x12, 0xb0, 0xf5, 0x3f, 0x55, 0xb4, 0x8b, 0x5e,
+	0x96, 0xaf, 0xf7, 0xa5, 0x61, 0x8e, 0x05, 0x78, 0x98, 0x11, 0x77, 0xf3, 0x62, 0xa7, 0x1d, 0x4a,
+	0x69, 0x66, 0x94, 0x22, 0x4a, 0x80, 0xc0, 0x9f, 0x81, 0x45, 0x91, 0x4a, 0xdd, 0x5d, 0x6a, 0x91,
+	0x30, 0x76, 0x89, 0x3f, 0xb4, 0x4d, 0x22, 0x43, 0x7d, 0xba, 0xb5, 0xa2, 0x00, 0x16, 0x0f, 0xf2,
+	0xc5, 0xd0, 0x28, 0x7d, 0xf8, 0x5b, 0x43, 0xba, 0x7b, 0x64, 0x77, 0x65, 0xa5, 0x0c, 0x42, 0xfd,
+	0xe0, 0x4a, 0x7a, 0xb1, 0x46, 0x3b, 0xc2, 0xbd, 0xef, 0x71, 0xff, 0x44, 0x5b, 0xa6, 0x62, 0xa0,
+	0x84, 0x71, 0xf8, 0x89, 0x01, 0x2a, 0xcc, 0xc4, 0x8e, 0xed, 0x75, 0x3b, 0xd4, 0xb1, 0xcd, 0x13,
+	0x95, 0x10, 0xed, 0xcb, 0xb9, 0xb3, 0x97, 0x84, 0x6a, 0x7d, 0x23, 0xaa, 0x06, 0x49, 0x32, 0xd2,
+	0x0d, 0xc2, 0x1d, 0x70, 0x4b, 0x55, 0x03, 0xd6, 0x21, 0x7e, 0x58, 0xbc, 0x55, 0xf9, 0x5c, 0x56,
+	0x10, 0xb7, 0xf6, 0xb2, 0x22, 0x28, 0x4f, 0x0f, 0x7e, 0x66, 0x80, 0x8a, 0x4f, 0x1d, 0x61, 0xe0,
+	0xa0, 0x6f, 0x61, 0x4e, 0x64, 0x7e, 0x94, 0xd7, 0xb7, 0x2e, 0xb7, 0x22, 0x94, 0x84, 0x4a, 0xaf,
+	0x4b, 0x63, 0x22, 0xdd, 0x2c, 0xfc, 0xc2, 0x00, 0x37, 0x3d, 0x79, 0xfa, 0x0e, 0x31, 0x39, 0xf5,
+	0xab, 0x53, 0xf2, 0xa0, 0x1f, 0x5d, 0xcd, 0x41, 0xef, 0x26, 0x90, 0x83, 0xa3, 0xbe, 0xad, 0xbc,
+	0xba, 0x99, 0x64, 0x21, 0xcd, 0x05, 0xf8, 0x30, 0x70, 0x69, 0xe3, 0xe8, 0xc8, 0xf6, 0x6c, 0x7e,
+	0x22, 0x33, 0xba, 0xbc, 0xbe, 0x9a, 0x97, 0x2a, 0xbb, 0x09, 0xb9, 0xd6, 0x7c, 0x88, 0x1b, 0x52,
+	0x90, 0x86, 0x03, 0x0f, 0x40, 0x99, 0x53, 0x47, 0xbc, 0xc7, 0x12, 0xa9, 0x5f, 0xcb, 0x83, 0xdd,
+	0x8f, 0xc4, 0xe2, 0xe6, 0x20, 0xa6, 0x31, 0x94, 0xc4, 0x81, 0xbf, 0x31, 0xc0, 0x7c, 0x10, 0xac,
+	0x1d, 0x9f, 0x8a, 0x82, 0x6f, 0x53, 0xaf, 0x0a, 0xa4, 0xcf, 0x6f, 0x5d, 0x6e, 0x1b, 0xdb, 0x29,
+	0xb4, 0xd6, 0xed, 0xb3, 0xd3, 0x95, 0xf9, 0x34, 0x15, 0x65, 0xac, 0xc2, 0xfb, 0x60, 0x6a, 0x48,
+	0x9d, 0x81, 0x4b, 0x58, 0xb5, 0x2c, 0x57, 0xb7, 0x94, 0xb7, 0xba, 0x87, 0x52, 0x24, 0x2e, 0x91,
+	0xc1, 0x37, 0x43, 0xa1, 0x2e, 0xf4, 0xc0, 0xbc, 0xed, 0xe2, 0x2e, 0xe9, 0x0c, 0x1c, 0x67, 0x8f,
+	0x98, 0x3e, 0xe1, 0xac, 0x7a, 0xf3, 0x05, 0xef, 0xba, 0xaa, 0x42, 0x9f, 0xdf, 0x4a, 0x21, 0xa1,
+	0x0c, 0x36, 0xfc, 0x39, 0x98, 0x15, 0x27, 0x14, 0x17, 0xb9, 0x6a, 0xe5, 0x79, 0xaa, 0x63, 0x74,
+	0x9d, 0x6e, 0x69, 0xca, 0x28, 0x05, 0x06, 0xdf, 0x04, 0xf3, 0xea, 0x59, 0xde, 0x76, 0x30, 0x63,
+	0xb2, 0x71, 0x9c, 0x95, 0xb7, 0x84, 0xdc, 0x57, 0x94, 0xe2, 0xa1, 0x8c, 0x34, 0xfc, 0x08, 0x54,
+	0x7c, 0xc2, 0x38, 0xf6, 0xb9, 0xaa, 0x3f, 0x73, 0x52, 0xfd, 0x07, 0x51, 0x8a, 0x25, 0x99, 0xe7,
+	0xa7, 0x2b, 0xab, 0x39, 0xaf, 0x75, 0x4d, 0x06, 0xe9, 0x78, 0x4b, 0x3f, 0x02, 0x73, 0xa9, 0xa2,
+	0x08, 0xe7, 0x41, 0xf1, 0x98, 0x9c, 0x04, 0x1d, 0x2e, 0x12, 0x3f, 0xe1, 0x6d, 0x50, 0x1a, 0x62,
+	0x67, 0x10, 0xd4, 0xf6, 0x19, 0x14, 0x7c, 0xbc, 0x51, 0x78, 0xdd, 0x58, 0xfa, 0x09, 0x58, 0xc8,
+	0xa4, 0xda, 0x8b, 0x00, 0xd4, 0xbf, 0x98, 0x04, 0x0b, 0x99, 0x67, 0x2c, 0xdc, 0x04, 0xf3, 0x16,
+	0x61, 0xb6, 0x4f, 0xac, 0xb0, 0x70, 0x31, 0x09, 0x57, 0x8a, 0x4f, 0x77, 0x33, 0xc5, 0x47, 0x19,
+	0x0d, 0xf8, 0x63, 0x30, 0xcb, 0x29, 0xc7, 0x4e, 0x8c, 0x51, 0x90, 0x18, 0xd1, 0xf1, 0xed, 0x6b,
+	0x5c, 0x94, 0x92, 0x16, 0x5e, 0xf4, 0x89, 0x67, 0xd9, 0x5e, 0x37, 0x46, 0x28, 0xea, 0x5e, 0x74,
+	0x52, 0x7c, 0x94, 0xd1, 0x80, 0x6f, 0x83, 0x05, 0x8b, 0x38, 0x84, 0x6b, 0x30, 0x13, 0x12, 0xe6,
+	0x9b, 0x0a, 0x66, 0x61, 0x33, 0x2d, 0x80, 0xb2, 0x3a, 0xb2, 0xbd, 0x71, 0x1c, 0x6a, 0x8a, 0xa9,
+	0x4e, 0x8c, 0x54, 0x92, 0x48, 0x71, 0x7b, 0x93, 0x91, 0x40, 0x39, 0x5a, 0xf0, 0x87, 0xa0, 0x62,
+	0x5b, 0x0e, 0x89, 0x61, 0x26, 0x25, 0x4c, 0x54, 0xba, 0xb7, 0x92, 0x4c, 0xa4, 0xcb, 0xc2, 0x4f,
+	0x0d, 0x50, 0x71, 0x30, 0x27, 0x8c, 0x3f, 0xb0, 0x19, 0xa7, 0xfe, 0x49, 0x75, 0x6a, 0x9c, 0x29,
+	0xc6, 0x26, 0xe9, 0x3b, 0xf4, 0xc4, 0x25, 0x5e, 0x08, 0x17, 0xbb, 0xb1, 0x9d, 0xb4, 0x82, 0x74,
+	0xa3, 0xd0, 0x07, 0x53, 0x3d, 0x65, 0x7f, 0x7a, 0xb5, 0x78, 0x95, 0xf6, 0xa3, 0x02, 0x15, 0x5a,
+	0x0e, 0x0d, 0x5d, 0x4f, 0x0f, 0x77, 0x08, 0x32, 0x25, 0x57, 0xcc, 0xd7, 0x5c, 0x3a, 0xf0, 0x78,
+	0x07, 0xf3, 0x5e, 0xd5, 0xd0, 0xe7, 0x6b, 0x3b, 0x21, 0x03, 0xc5, 0x32, 0xf0, 0x2e, 0x28, 0x12,
+	0x6f, 0xa8, 0xba, 0xb1, 0xb2, 0x12, 0x2d, 0xde, 0xf7, 0x86, 0x48, 0xd0, 0xeb, 0xff, 0x2a, 0x80,
+	0x85, 0xcc, 0xc2, 0xe1, 0x1b, 0x60, 0x12, 0x07, 0xd7, 0x48, 0x60, 0xa2, 0x1e, 0x76, 0xbd, 0x1b,
+	0x92, 0x7a, 0x2e, 0xf3, 0x2e, 0x54, 0x0a, 0x68, 0x48, 0x69, 0xc0, 0x0f, 0x01, 0x18, 0xc8, 0xab,
+	0x5d, 0xf6, 0xf5, 0x85, 0x17, 0xee, 0xeb, 0xa3, 0x5d, 0x39, 0x88, 0x50, 0x50, 0x02, 0x31, 0xd1,
+	0x91, 0x17, 0x9f, 0xb7, 0x23, 0x9f, 0xf8, 0x9a, 0x8e, 0xfc, 0xa7, 0xa2, 0xcc, 0x84, 0xcb, 0x51,
+	0x2f, 0xb5, 0x92, 0xd4, 0x79, 0x25, 0x2e, 0x33, 0x3a, 0xff, 0x3c, 0x87, 0x86, 0x32, 0x28, 0xf5,
+	0xc7, 0x60, 0x71, 0xcb, 0x22, 0x8e, 0x6a, 0xcb, 0x76, 0x07, 0xee, 0x7e, 0xcf, 0x27, 0xac, 0x47,
+	0x1d, 0x4b, 0x0c, 0x3e, 0x7b, 0x76, 0x37, 0x38, 0xc4, 0x4a, 0x3c, 0xe9, 0x79, 0x60, 0x77, 0x7b,
+	0x48, 0x72, 0xc4, 0xd1, 0x39, 0xf4, 0x63, 0xb9, 0x85, 0x95, 0xf8, 0xe8, 0xb6, 0xe9, 0xc7, 0x48,
+	0xd0, 0xeb, 0x1f, 0x82, 0xe5, 0x04, 0x76, 0x87, 0xf8, 0x22, 0xea, 0xaf, 0x10, 0xff, 0x6f, 0x05,
+	0x00, 0x44, 0x51, 0xdf, 0xc4, 0xc4, 0xbd, 0x96, 0x49, 0xda, 0x91, 0x36, 0x49, 0xbb, 0xe4, 0x5b,
+	0x3d, 0xf6, 0x78, 0xe4, 0x04, 0xcd, 0x4b, 0x4d, 0xd0, 0xde, 0x1a, 0xdb, 0xd2, 0xc5, 0x93, 0xb3,
+	0x7f, 0x18, 0x60, 0x36, 0x16, 0xbe, 0x86, 0x89, 0x19, 0xd1, 0x27, 0x66, 0x6f, 0x8e, 0xbb, 0xbe,
+	0x11, 0x93, 0xb2, 0xbf, 0x14, 0xc0, 0xed, 0x58, 0x48, 0xfc, 0x52, 0xd7, 0xf6, 0x2b, 0x60, 0x5a,
+	0xf4, 0xbd, 0x89, 0x01, 0x59, 0xe4, 0xed, 0xae, 0xa2, 0xa3, 0x48, 0x42, 0x24, 0x6a, 0x9f, 0x5a,
+	0x52, 0xb8, 0xa0, 0x27, 0x6a, 0x27, 0x20, 0xa3, 0x90, 0x2f, 0x80, 0x7d, 0x32, 0xb4, 0x45, 0xbc,
+	0x57, 0x8b, 0x3a, 0x30, 0x52, 0x74, 0x14, 0x49, 0xc0, 0x16, 0x28, 0xf5, 0x7b, 0x98, 0x85, 0xf9,
+	0x1f, 0xe6, 0x72, 0xa9, 0x23, 0x88, 0xa3, 0xfe, 0xd2, 0xa0, 0x96, 0x64, 0xa3, 0x40, 0x15, 0xbe,
+	0x04, 0x4a, 0x3e, 0xc1, 0xd6, 0x89, 0xac, 0x07, 0xd3, 0xf1, 0x46, 0x20, 0x41, 0x44, 0x01, 0x2f,
+	0x59, 0x6a, 0x26, 0x2f, 0x2e, 0x35, 0xf5, 0xa7, 0xc5, 0x64, 0x2c, 0xa8, 0x67, 0xf9, 0x34, 0x27,
+	0x6e, 0x5f, 0x5c, 0x6a, 0x2a, 0x16, 0x5e, 0xca, 0x6b, 0x3b, 0x3b, 0xd4, 0xda, 0x57, 0x62, 0x32,
+	0xb2, 0xa3, 0x95, 0x87, 0x54, 0x14, 0xc1, 0xc0, 0xcf, 0xd3, 0x8f, 0xaa, 0x20, 0x10, 0x1e, 0x5e,
+	0x45, 0x4a, 0x5d, 0xf2, 0x4d, 0xf5, 0x3b, 0x03, 0xcc, 0x06, 0x55, 0x7c, 0x8f, 0xfb, 0x98, 0x93,
+	0xee, 0xc9, 0x78, 0xf3, 0xeb, 0xd8, 0xa9, 0x03, 0x0d, 0x35, 0xee, 0xea, 0x74, 0x3a, 0x4a, 0x59,
+	0x1f, 0xbf, 0x65, 0xfd, 0x6c, 0x02, 0xcc, 0xa7, 0x6b, 0x00, 0x7c, 0x08, 0xee, 0xa8, 0xfe, 0x73,
+	0x77, 0xe0, 0x1e, 0x12, 0x7f, 0xcf, 0xec, 0x11, 0x6b, 0xe0, 0x10, 0x4b, 0xf5, 0xad, 0x35, 0xe5,
+	0xdd, 0x9d, 0xcd, 0x5c, 0x29, 0x34, 0x42, 0x5b, 0xe0, 0x9a, 0x03, 0xdf, 0x27, 0x1e, 0x4f, 0xe3,
+	0x16, 0x74, 0xdc, 0x76, 0xae, 0x14, 0x1a, 0xa1, 0x2d, 0x70, 0x83, 0x7d, 0xc9, 0xf8, 0x5b, 0xd4,
+	0x71, 0x0f, 0x72, 0xa5, 0xd0, 0x08, 0x6d, 0x31, 0xe7, 0xf6, 0x24, 0x49, 0x26, 0x8a, 0xea, 0x73,
+	0xa3, 0xa7, 0xec, 0x6e, 0xcc, 0x42, 0x49, 0x39, 0x2d, 0xc1, 0x4b, 0x5f, 0x9b, 0xe0, 0x9f, 0x86,
+	0x61, 0x2e, 0xf7, 0x5e, 0x76, 0xaf, 0x22, 0xcc, 0xdf, 0x19, 0x37, 0xa2, 0xe2, 0x52, 0x96, 0x0a,
+	0x6d, 0x65, 0x07, 0x69, 0x56, 0xeb, 0x8f, 0x41, 0x75, 0x54, 0x34, 0x8a, 0xb7, 0x87, 0x8b, 0x9f,
+	0x1c, 0x78, 0xd1, 0x68, 0x51, 0xdd, 0xc7, 0x51, 0x94, 0xee, 0x68, 0x5c, 0x94, 0x92, 0xae, 0xff,
+	0xca, 0x00, 0xb7, 0x72, 0x86, 0x2b, 0xe3, 0xe2, 0x8a, 0x8d, 0x76, 0xf1, 0x93, 0xbd, 0x81, 0xdf,
+	0x25, 0xaa, 0x01, 0x88, 0x36, 0x7a, 0x47, 0xd1, 0x51, 0x24, 0x51, 0xff, 0xdf, 0x04, 0xd0, 0xa7,
+	0x53, 0xe2, 0xaf, 0x10, 0xd7, 0xf6, 0x6c, 0x77, 0xe0, 0x46, 0xa3, 0xa8, 0xc0, 0x81, 0x68, 0x38,
+	0xbe, 0xa3, 0xb3, 0x51, 0x5a, 0x5e, 0x42, 0xe0, 0x27, 0x1a, 0x44, 0x21, 0x05, 0xa1, 0xb3, 0x51,
+	0x5a, 0x5e, 0x54, 0xe7, 0xc3, 0x81, 0xcf, 0x82, 0x7f, 0x8f, 0x2b, 0x71, 0x75, 0x6e, 0x09, 0x22,
+	0x0a, 0x78, 0xf0, 0x03, 0xb0, 0xa0, 0x8d, 0xd2, 0xf6, 0x4f, 0xfa, 0xe1, 0x95, 0xd0, 0x08, 0x1f,
+	0x5e, 0x7b, 0x69, 0x81, 0xf3, 0x3c, 0x22, 0xca, 0x02, 0xc1, 0x3f, 0x1a, 0x60, 0x51, 0x3c, 0x8b,
+	0x72, 0x5a, 0x3c, 0x35, 0xd4, 0xde, 0xb9, 0x5c, 0x38, 0x8e, 0xe8, 0x1b, 0x83, 0x3f, 0x5f, 0xb6,
+	0xf2, 0x2d, 0xa2, 0x51, 0xae, 0xc0, 0xbf, 0x1a, 0x60, 0x39, 0xc1, 0x4b, 0x77, 0x8b, 0x6a, 0xfa,
+	0xf7, 0xfe, 0xd8, 0xae, 0xa6, 0x81, 0x5b, 0x2b, 0x67, 0xa7, 0x2b, 0xcb, 0x5b, 0xa3, 0x2d, 0xa3,
+	0x8b, 0xdc, 0x6a, 0xad, 0x3d, 0x7d, 0x56, 0xbb, 0xf1, 0xe5, 0xb3, 0xda, 0x8d, 0xaf, 0x9e, 0xd5,
+	0x6e, 0x7c, 0x72, 0x56, 0x33, 0x9e, 0x9e, 0xd5, 0x8c, 0x2f, 0xcf, 0x6a, 0xc6, 0x57, 0x67, 0x35,
+	0xe3, 0xdf, 0x67, 0x35, 0xe3, 0xf3, 0xff, 0xd4, 0x6e, 0x3c, 0x2e, 0x0c, 0xef, 0xfd, 0x7f, 0x00,
+	0xc9, 0x46, 0x13, 0x61, 0x16, 0x22, 0x00, 0x00,
 }
 
 func (m *AccessEndPoint) Marshal() (dAtA []byte, err error) {
@@ -837,6 +1120,30 @@ func (m *ApplicationSessionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x3a
 	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableTimeMicro))
 	i--
 	dAtA[i] = 0x30
@@ -920,6 +1227,135 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RestartPolicy)
+	copy(dAtA[i:], m.RestartPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RestartPolicy)))
+	i--
+	dAtA[i] = 0x7a
+	if m.RuntimeClassName != nil {
+		i -= len(*m.RuntimeClassName)
+		copy(dAtA[i:], *m.RuntimeClassName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RuntimeClassName)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.InitContainers) > 0 {
+		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ConfigProjection != nil {
+		{
+			size, err := m.ConfigProjection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NodeAffinity != nil {
+		{
+			size, err := m.NodeAffinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.RollingUpdate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SessionsPerInstance))
+	i--
+	dAtA[i] = 0x28
 	{
 		size, err := m.ScalingPolicy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -999,6 +1435,20 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.History) > 0 {
 		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1044,7 +1494,7 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DeploymentHistory) Marshal() (dAtA []byte, err error) {
+func (m *ConfigProjection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1054,21 +1504,57 @@ func (m *DeploymentHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeploymentHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigProjection) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeploymentHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.DeploymentStatus)
-	copy(dAtA[i:], m.DeploymentStatus)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeploymentStatus)))
 	i--
-	dAtA[i] = 0x2a
+	if m.Env {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.MountPath)
+	copy(dAtA[i:], m.MountPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MountPath)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeploymentHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.DeploymentStatus)
+	copy(dAtA[i:], m.DeploymentStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeploymentStatus)))
+	i--
+	dAtA[i] = 0x2a
 	i -= len(m.Message)
 	copy(dAtA[i:], m.Message)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
@@ -1155,7 +1641,7 @@ func (m *IdelSessionPercentThreshold) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ScalingPolicy) Marshal() (dAtA []byte, err error) {
+func (m *NodeDaemon) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1165,515 +1651,1211 @@ func (m *ScalingPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScalingPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeDaemon) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScalingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeDaemon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IdleSessionPercentThreshold != nil {
-		{
-			size, err := m.IdleSessionPercentThreshold.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x32
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.IdleSessionNumThreshold != nil {
-		{
-			size, err := m.IdleSessionNumThreshold.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.ScalingPolicyType)
-	copy(dAtA[i:], m.ScalingPolicyType)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ScalingPolicyType)))
-	i--
-	dAtA[i] = 0x22
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
-	i--
-	dAtA[i] = 0x18
-	i = encodeVarintGenerated(dAtA, i, uint64(m.MaximumInstance))
 	i--
-	dAtA[i] = 0x10
-	i = encodeVarintGenerated(dAtA, i, uint64(m.MinimumInstance))
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *AccessEndPoint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NodeDaemonList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Protocol)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.IPAddress)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Port))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Application) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *NodeDaemonList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NodeDaemonList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSession) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NodeDaemonNodeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationSessionList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *NodeDaemonNodeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSessionSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NodeDaemonNodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ApplicationName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SessionData)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.CloseGracePeriodSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.CloseGracePeriodSeconds))
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.Ready {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	n += 1 + sovGenerated(uint64(m.OpenTimeoutSeconds))
-	return n
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.PodName)
+	copy(dAtA[i:], m.PodName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.NodeName)
+	copy(dAtA[i:], m.NodeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSessionStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NodeDaemonSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AccessEndPoints) > 0 {
-		for _, e := range m.AccessEndPoints {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *NodeDaemonSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeDaemonSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpdateStrategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = len(m.SessionStatus)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.ClientSessions) > 0 {
-		for _, e := range m.ClientSessions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.AvailableTime != nil {
-		l = m.AvailableTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.CloseTime != nil {
-		l = m.CloseTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeDaemonStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += 1 + sovGenerated(uint64(m.AvailableTimeMicro))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NodeDaemonStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeDaemonStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeStatuses) > 0 {
+		for iNdEx := len(m.NodeStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.NumberReady))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedNumberScheduled))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentNumberScheduled))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DesiredNumberScheduled))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeDaemonUpdateStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeDaemonUpdateStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeDaemonUpdateStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Containers) > 0 {
-		for _, e := range m.Containers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxUnavailable))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RollingUpdatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollingUpdatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollingUpdatePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxSurge))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxUnavailable))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ScalingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IdleSessionPercentThreshold != nil {
+		{
+			size, err := m.IdleSessionPercentThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	n += 2
-	if len(m.ConfigData) > 0 {
-		for k, v := range m.ConfigData {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if m.IdleSessionNumThreshold != nil {
+		{
+			size, err := m.IdleSessionNumThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.ScalingPolicy.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.ScalingPolicyType)
+	copy(dAtA[i:], m.ScalingPolicyType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ScalingPolicyType)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaximumInstance))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinimumInstance))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationStatus) Size() (n int) {
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessEndPoint) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.DesiredInstances))
-	n += 1 + sovGenerated(uint64(m.TotalInstances))
-	n += 1 + sovGenerated(uint64(m.PendingInstances))
-	n += 1 + sovGenerated(uint64(m.DeletingInstances))
-	n += 1 + sovGenerated(uint64(m.AllocatedInstances))
-	n += 1 + sovGenerated(uint64(m.IdleInstances))
-	l = m.LatestHistory.Size()
+	l = len(m.Protocol)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.History) > 0 {
-		for _, e := range m.History {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = len(m.IPAddress)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Port))
 	return n
 }
 
-func (m *DeploymentHistory) Size() (n int) {
+func (m *Application) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Action)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.UpdateTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DeploymentStatus)
+	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *IdelSessionNumThreshold) Size() (n int) {
+func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.High))
-	n += 1 + sovGenerated(uint64(m.Low))
-	return n
-}
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
 
-func (m *IdelSessionPercentThreshold) Size() (n int) {
+func (m *ApplicationSession) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.High))
-	n += 1 + sovGenerated(uint64(m.Low))
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ScalingPolicy) Size() (n int) {
+func (m *ApplicationSessionList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.MinimumInstance))
-	n += 1 + sovGenerated(uint64(m.MaximumInstance))
-	n += 1 + sovGenerated(uint64(m.Burst))
-	l = len(m.ScalingPolicyType)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.IdleSessionNumThreshold != nil {
-		l = m.IdleSessionNumThreshold.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.IdleSessionPercentThreshold != nil {
-		l = m.IdleSessionPercentThreshold.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AccessEndPoint) String() string {
-	if this == nil {
-		return "nil"
+func (m *ApplicationSessionSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AccessEndPoint{`,
-		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
-		`IPAddress:` + fmt.Sprintf("%v", this.IPAddress) + `,`,
-		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Application) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.ApplicationName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SessionData)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.CloseGracePeriodSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.CloseGracePeriodSeconds))
 	}
-	s := strings.Join([]string{`&Application{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSpec", "ApplicationSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationStatus", "ApplicationStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 1 + sovGenerated(uint64(m.OpenTimeoutSeconds))
+	return n
 }
-func (this *ApplicationList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationSessionStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Application{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Application", "Application", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.AccessEndPoints) > 0 {
+		for _, e := range m.AccessEndPoints {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ApplicationList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationSession) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.SessionStatus)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ClientSessions) > 0 {
+		for _, e := range m.ClientSessions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ApplicationSession{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSessionSpec", "ApplicationSessionSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationSessionStatus", "ApplicationSessionStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationSessionList) String() string {
-	if this == nil {
-		return "nil"
+	if m.AvailableTime != nil {
+		l = m.AvailableTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems := "[]ApplicationSession{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ApplicationSession", "ApplicationSession", 1), `&`, ``, 1) + ","
+	if m.CloseTime != nil {
+		l = m.CloseTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ApplicationSessionList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationSessionSpec) String() string {
-	if this == nil {
-		return "nil"
+	n += 1 + sovGenerated(uint64(m.AvailableTimeMicro))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ApplicationSessionSpec{`,
-		`ApplicationName:` + fmt.Sprintf("%v", this.ApplicationName) + `,`,
-		`SessionData:` + fmt.Sprintf("%v", this.SessionData) + `,`,
-		`KillInstanceWhenSessionClosed:` + fmt.Sprintf("%v", this.KillInstanceWhenSessionClosed) + `,`,
-		`CloseGracePeriodSeconds:` + valueToStringGenerated(this.CloseGracePeriodSeconds) + `,`,
-		`OpenTimeoutSeconds:` + fmt.Sprintf("%v", this.OpenTimeoutSeconds) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ApplicationSessionStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForAccessEndPoints := "[]AccessEndPoint{"
-	for _, f := range this.AccessEndPoints {
-		repeatedStringForAccessEndPoints += strings.Replace(strings.Replace(f.String(), "AccessEndPoint", "AccessEndPoint", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForAccessEndPoints += "}"
-	repeatedStringForClientSessions := "[]LocalObjectReference{"
-	for _, f := range this.ClientSessions {
-		repeatedStringForClientSessions += fmt.Sprintf("%v", f) + ","
+	n += 2
+	if len(m.ConfigData) > 0 {
+		for k, v := range m.ConfigData {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForClientSessions += "}"
-	s := strings.Join([]string{`&ApplicationSessionStatus{`,
-		`AccessEndPoints:` + repeatedStringForAccessEndPoints + `,`,
-		`SessionStatus:` + fmt.Sprintf("%v", this.SessionStatus) + `,`,
-		`ClientSessions:` + repeatedStringForClientSessions + `,`,
-		`AvailableTime:` + strings.Replace(fmt.Sprintf("%v", this.AvailableTime), "Time", "v1.Time", 1) + `,`,
-		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Time", "v1.Time", 1) + `,`,
-		`AvailableTimeMicro:` + fmt.Sprintf("%v", this.AvailableTimeMicro) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationSpec) String() string {
-	if this == nil {
-		return "nil"
+	l = m.ScalingPolicy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SessionsPerInstance))
+	l = m.RollingUpdate.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForContainers := "[]Container{"
-	for _, f := range this.Containers {
-		repeatedStringForContainers += fmt.Sprintf("%v", f) + ","
+	if m.NodeAffinity != nil {
+		l = m.NodeAffinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForContainers += "}"
-	keysForConfigData := make([]string, 0, len(this.ConfigData))
-	for k := range this.ConfigData {
-		keysForConfigData = append(keysForConfigData, k)
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForConfigData)
-	mapStringForConfigData := "map[string]string{"
-	for _, k := range keysForConfigData {
-		mapStringForConfigData += fmt.Sprintf("%v: %v,", k, this.ConfigData[k])
+	if m.ConfigProjection != nil {
+		l = m.ConfigProjection.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForConfigData += "}"
-	s := strings.Join([]string{`&ApplicationSpec{`,
-		`Containers:` + repeatedStringForContainers + `,`,
-		`UsingNodeSessionService:` + fmt.Sprintf("%v", this.UsingNodeSessionService) + `,`,
-		`ConfigData:` + mapStringForConfigData + `,`,
-		`ScalingPolicy:` + strings.Replace(strings.Replace(this.ScalingPolicy.String(), "ScalingPolicy", "ScalingPolicy", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationStatus) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForHistory := "[]DeploymentHistory{"
-	for _, f := range this.History {
-		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "DeploymentHistory", "DeploymentHistory", 1), `&`, ``, 1) + ","
+	if len(m.ImagePullSecrets) > 0 {
+		for _, e := range m.ImagePullSecrets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForHistory += "}"
-	s := strings.Join([]string{`&ApplicationStatus{`,
-		`DesiredInstances:` + fmt.Sprintf("%v", this.DesiredInstances) + `,`,
-		`TotalInstances:` + fmt.Sprintf("%v", this.TotalInstances) + `,`,
-		`PendingInstances:` + fmt.Sprintf("%v", this.PendingInstances) + `,`,
-		`DeletingInstances:` + fmt.Sprintf("%v", this.DeletingInstances) + `,`,
-		`AllocatedInstances:` + fmt.Sprintf("%v", this.AllocatedInstances) + `,`,
-		`IdleInstances:` + fmt.Sprintf("%v", this.IdleInstances) + `,`,
-		`LatestHistory:` + strings.Replace(strings.Replace(this.LatestHistory.String(), "DeploymentHistory", "DeploymentHistory", 1), `&`, ``, 1) + `,`,
-		`History:` + repeatedStringForHistory + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.InitContainers) > 0 {
+		for _, e := range m.InitContainers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RuntimeClassName != nil {
+		l = len(*m.RuntimeClassName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RestartPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *DeploymentHistory) String() string {
+
+func (m *ApplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.DesiredInstances))
+	n += 1 + sovGenerated(uint64(m.TotalInstances))
+	n += 1 + sovGenerated(uint64(m.PendingInstances))
+	n += 1 + sovGenerated(uint64(m.DeletingInstances))
+	n += 1 + sovGenerated(uint64(m.AllocatedInstances))
+	n += 1 + sovGenerated(uint64(m.IdleInstances))
+	l = m.LatestHistory.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MountPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *DeploymentHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.UpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DeploymentStatus)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *IdelSessionNumThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.High))
+	n += 1 + sovGenerated(uint64(m.Low))
+	return n
+}
+
+func (m *IdelSessionPercentThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.High))
+	n += 1 + sovGenerated(uint64(m.Low))
+	return n
+}
+
+func (m *NodeDaemon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NodeDaemonList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeDaemonNodeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NodeDaemonSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = m.UpdateStrategy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NodeDaemonStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.DesiredNumberScheduled))
+	n += 1 + sovGenerated(uint64(m.CurrentNumberScheduled))
+	n += 1 + sovGenerated(uint64(m.UpdatedNumberScheduled))
+	n += 1 + sovGenerated(uint64(m.NumberReady))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeStatuses) > 0 {
+		for _, e := range m.NodeStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeDaemonUpdateStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxUnavailable))
+	return n
+}
+
+func (m *RollingUpdatePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxUnavailable))
+	n += 1 + sovGenerated(uint64(m.MaxSurge))
+	return n
+}
+
+func (m *ScalingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MinimumInstance))
+	n += 1 + sovGenerated(uint64(m.MaximumInstance))
+	n += 1 + sovGenerated(uint64(m.Burst))
+	l = len(m.ScalingPolicyType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.IdleSessionNumThreshold != nil {
+		l = m.IdleSessionNumThreshold.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.IdleSessionPercentThreshold != nil {
+		l = m.IdleSessionPercentThreshold.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccessEndPoint) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&DeploymentHistory{`,
-		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
-		`UpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`DeploymentStatus:` + fmt.Sprintf("%v", this.DeploymentStatus) + `,`,
+	s := strings.Join([]string{`&AccessEndPoint{`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`IPAddress:` + fmt.Sprintf("%v", this.IPAddress) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *IdelSessionNumThreshold) String() string {
+func (this *Application) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&IdelSessionNumThreshold{`,
-		`High:` + fmt.Sprintf("%v", this.High) + `,`,
-		`Low:` + fmt.Sprintf("%v", this.Low) + `,`,
+	s := strings.Join([]string{`&Application{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSpec", "ApplicationSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationStatus", "ApplicationStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *IdelSessionPercentThreshold) String() string {
+func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&IdelSessionPercentThreshold{`,
-		`High:` + fmt.Sprintf("%v", this.High) + `,`,
-		`Low:` + fmt.Sprintf("%v", this.Low) + `,`,
+	repeatedStringForItems := "[]Application{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Application", "Application", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ApplicationList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ScalingPolicy) String() string {
+func (this *ApplicationSession) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ScalingPolicy{`,
-		`MinimumInstance:` + fmt.Sprintf("%v", this.MinimumInstance) + `,`,
-		`MaximumInstance:` + fmt.Sprintf("%v", this.MaximumInstance) + `,`,
-		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+	s := strings.Join([]string{`&ApplicationSession{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSessionSpec", "ApplicationSessionSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationSessionStatus", "ApplicationSessionStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSessionList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ApplicationSession{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ApplicationSession", "ApplicationSession", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ApplicationSessionList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSessionSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSessionSpec{`,
+		`ApplicationName:` + fmt.Sprintf("%v", this.ApplicationName) + `,`,
+		`SessionData:` + fmt.Sprintf("%v", this.SessionData) + `,`,
+		`KillInstanceWhenSessionClosed:` + fmt.Sprintf("%v", this.KillInstanceWhenSessionClosed) + `,`,
+		`CloseGracePeriodSeconds:` + valueToStringGenerated(this.CloseGracePeriodSeconds) + `,`,
+		`OpenTimeoutSeconds:` + fmt.Sprintf("%v", this.OpenTimeoutSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSessionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAccessEndPoints := "[]AccessEndPoint{"
+	for _, f := range this.AccessEndPoints {
+		repeatedStringForAccessEndPoints += strings.Replace(strings.Replace(f.String(), "AccessEndPoint", "AccessEndPoint", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAccessEndPoints += "}"
+	repeatedStringForClientSessions := "[]LocalObjectReference{"
+	for _, f := range this.ClientSessions {
+		repeatedStringForClientSessions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForClientSessions += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&ApplicationSessionStatus{`,
+		`AccessEndPoints:` + repeatedStringForAccessEndPoints + `,`,
+		`SessionStatus:` + fmt.Sprintf("%v", this.SessionStatus) + `,`,
+		`ClientSessions:` + repeatedStringForClientSessions + `,`,
+		`AvailableTime:` + strings.Replace(fmt.Sprintf("%v", this.AvailableTime), "Time", "v1.Time", 1) + `,`,
+		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Time", "v1.Time", 1) + `,`,
+		`AvailableTimeMicro:` + fmt.Sprintf("%v", this.AvailableTimeMicro) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]Container{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForContainers += "}"
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
+	for _, f := range this.ImagePullSecrets {
+		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForImagePullSecrets += "}"
+	repeatedStringForInitContainers := "[]Container{"
+	for _, f := range this.InitContainers {
+		repeatedStringForInitContainers += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForInitContainers += "}"
+	keysForConfigData := make([]string, 0, len(this.ConfigData))
+	for k := range this.ConfigData {
+		keysForConfigData = append(keysForConfigData, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForConfigData)
+	mapStringForConfigData := "map[string]string{"
+	for _, k := range keysForConfigData {
+		mapStringForConfigData += fmt.Sprintf("%v: %v,", k, this.ConfigData[k])
+	}
+	mapStringForConfigData += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&ApplicationSpec{`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`UsingNodeSessionService:` + fmt.Sprintf("%v", this.UsingNodeSessionService) + `,`,
+		`ConfigData:` + mapStringForConfigData + `,`,
+		`ScalingPolicy:` + strings.Replace(strings.Replace(this.ScalingPolicy.String(), "ScalingPolicy", "ScalingPolicy", 1), `&`, ``, 1) + `,`,
+		`SessionsPerInstance:` + fmt.Sprintf("%v", this.SessionsPerInstance) + `,`,
+		`RollingUpdate:` + strings.Replace(strings.Replace(this.RollingUpdate.String(), "RollingUpdatePolicy", "RollingUpdatePolicy", 1), `&`, ``, 1) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`NodeAffinity:` + strings.Replace(fmt.Sprintf("%v", this.NodeAffinity), "NodeAffinity", "v11.NodeAffinity", 1) + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`ConfigProjection:` + strings.Replace(this.ConfigProjection.String(), "ConfigProjection", "ConfigProjection", 1) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
+		`InitContainers:` + repeatedStringForInitContainers + `,`,
+		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
+		`RestartPolicy:` + fmt.Sprintf("%v", this.RestartPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistory := "[]DeploymentHistory{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "DeploymentHistory", "DeploymentHistory", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHistory += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&ApplicationStatus{`,
+		`DesiredInstances:` + fmt.Sprintf("%v", this.DesiredInstances) + `,`,
+		`TotalInstances:` + fmt.Sprintf("%v", this.TotalInstances) + `,`,
+		`PendingInstances:` + fmt.Sprintf("%v", this.PendingInstances) + `,`,
+		`DeletingInstances:` + fmt.Sprintf("%v", this.DeletingInstances) + `,`,
+		`AllocatedInstances:` + fmt.Sprintf("%v", this.AllocatedInstances) + `,`,
+		`IdleInstances:` + fmt.Sprintf("%v", this.IdleInstances) + `,`,
+		`LatestHistory:` + strings.Replace(strings.Replace(this.LatestHistory.String(), "DeploymentHistory", "DeploymentHistory", 1), `&`, ``, 1) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigProjection) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigProjection{`,
+		`MountPath:` + fmt.Sprintf("%v", this.MountPath) + `,`,
+		`Env:` + fmt.Sprintf("%v", this.Env) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeploymentHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeploymentHistory{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`UpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`DeploymentStatus:` + fmt.Sprintf("%v", this.DeploymentStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IdelSessionNumThreshold) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IdelSessionNumThreshold{`,
+		`High:` + fmt.Sprintf("%v", this.High) + `,`,
+		`Low:` + fmt.Sprintf("%v", this.Low) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IdelSessionPercentThreshold) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IdelSessionPercentThreshold{`,
+		`High:` + fmt.Sprintf("%v", this.High) + `,`,
+		`Low:` + fmt.Sprintf("%v", this.Low) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeDaemon) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeDaemon{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NodeDaemonSpec", "NodeDaemonSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NodeDaemonStatus", "NodeDaemonStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeDaemonList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]NodeDaemon{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NodeDaemon", "NodeDaemon", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NodeDaemonList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeDaemonNodeStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeDaemonNodeStatus{`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Ready:` + fmt.Sprintf("%v", this.Ready) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeDaemonSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&NodeDaemonSpec{`,
+		`Template:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Template), "PodTemplateSpec", "v11.PodTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`UpdateStrategy:` + strings.Replace(strings.Replace(this.UpdateStrategy.String(), "NodeDaemonUpdateStrategy", "NodeDaemonUpdateStrategy", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeDaemonStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNodeStatuses := "[]NodeDaemonNodeStatus{"
+	for _, f := range this.NodeStatuses {
+		repeatedStringForNodeStatuses += strings.Replace(strings.Replace(f.String(), "NodeDaemonNodeStatus", "NodeDaemonNodeStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNodeStatuses += "}"
+	s := strings.Join([]string{`&NodeDaemonStatus{`,
+		`DesiredNumberScheduled:` + fmt.Sprintf("%v", this.DesiredNumberScheduled) + `,`,
+		`CurrentNumberScheduled:` + fmt.Sprintf("%v", this.CurrentNumberScheduled) + `,`,
+		`UpdatedNumberScheduled:` + fmt.Sprintf("%v", this.UpdatedNumberScheduled) + `,`,
+		`NumberReady:` + fmt.Sprintf("%v", this.NumberReady) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`NodeStatuses:` + repeatedStringForNodeStatuses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeDaemonUpdateStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeDaemonUpdateStrategy{`,
+		`MaxUnavailable:` + fmt.Sprintf("%v", this.MaxUnavailable) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollingUpdatePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollingUpdatePolicy{`,
+		`MaxUnavailable:` + fmt.Sprintf("%v", this.MaxUnavailable) + `,`,
+		`MaxSurge:` + fmt.Sprintf("%v", this.MaxSurge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScalingPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScalingPolicy{`,
+		`MinimumInstance:` + fmt.Sprintf("%v", this.MinimumInstance) + `,`,
+		`MaximumInstance:` + fmt.Sprintf("%v", this.MaximumInstance) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
 		`ScalingPolicyType:` + fmt.Sprintf("%v", this.ScalingPolicyType) + `,`,
 		`IdleSessionNumThreshold:` + strings.Replace(this.IdleSessionNumThreshold.String(), "IdelSessionNumThreshold", "IdelSessionNumThreshold", 1) + `,`,
 		`IdleSessionPercentThreshold:` + strings.Replace(this.IdleSessionPercentThreshold.String(), "IdelSessionPercentThreshold", "IdelSessionPercentThreshold", 1) + `,`,
@@ -1699,30 +2881,1740 @@ func (m *AccessEndPoint) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessEndPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessEndPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = k8s_io_api_core_v1.Protocol(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Application) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSessionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSessionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSessionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ApplicationSession{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSessionSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSessionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillInstanceWhenSessionClosed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KillInstanceWhenSessionClosed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseGracePeriodSeconds", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CloseGracePeriodSeconds = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenTimeoutSeconds", wireType)
+			}
+			m.OpenTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenTimeoutSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSessionStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSessionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessEndPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessEndPoints = append(m.AccessEndPoints, AccessEndPoint{})
+			if err := m.AccessEndPoints[len(m.AccessEndPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionStatus = SessionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSessions = append(m.ClientSessions, v11.LocalObjectReference{})
+			if err := m.ClientSessions[len(m.ClientSessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AvailableTime == nil {
+				m.AvailableTime = &v1.Time{}
+			}
+			if err := m.AvailableTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = &v1.Time{}
+			}
+			if err := m.CloseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableTimeMicro", wireType)
+			}
+			m.AvailableTimeMicro = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableTimeMicro |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, v11.Container{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsingNodeSessionService", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UsingNodeSessionService = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigData == nil {
+				m.ConfigData = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConfigData[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScalingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionsPerInstance", wireType)
+			}
+			m.SessionsPerInstance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionsPerInstance |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RollingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeAffinity == nil {
+				m.NodeAffinity = &v11.NodeAffinity{}
+			}
+			if err := m.NodeAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigProjection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigProjection == nil {
+				m.ConfigProjection = &ConfigProjection{}
+			}
+			if err := m.ConfigProjection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Volumes = append(m.Volumes, v11.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessEndPoint: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessEndPoint: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1732,27 +4624,63 @@ func (m *AccessEndPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = k8s_io_api_core_v1.Protocol(dAtA[iNdEx:postIndex])
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v11.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitContainers = append(m.InitContainers, v11.Container{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1780,13 +4708,14 @@ func (m *AccessEndPoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IPAddress = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.RuntimeClassName = &s
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
 			}
-			m.Port = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1796,11 +4725,24 @@ func (m *AccessEndPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Port |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartPolicy = k8s_io_api_core_v1.RestartPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1822,7 +4764,7 @@ func (m *AccessEndPoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Application) Unmarshal(dAtA []byte) error {
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1845,17 +4787,17 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredInstances", wireType)
 			}
-			var msglen int
+			m.DesiredInstances = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1865,30 +4807,54 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DesiredInstances |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInstances", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.TotalInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingInstances", wireType)
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PendingInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletingInstances", wireType)
 			}
-			var msglen int
+			m.DeletingInstances = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1898,28 +4864,52 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DeletingInstances |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedInstances", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.AllocatedInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocatedInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleInstances", wireType)
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IdleInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1943,66 +4933,16 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LatestHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2029,13 +4969,14 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, DeploymentHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2062,8 +5003,8 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2088,7 +5029,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSession) Unmarshal(dAtA []byte) error {
+func (m *ConfigProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2111,17 +5052,17 @@ func (m *ApplicationSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2131,63 +5072,29 @@ func (m *ApplicationSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2197,25 +5104,12 @@ func (m *ApplicationSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Env = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2237,7 +5131,7 @@ func (m *ApplicationSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSessionList) Unmarshal(dAtA []byte) error {
+func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2260,17 +5154,17 @@ func (m *ApplicationSessionList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSessionList: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeploymentHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSessionList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeploymentHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2280,28 +5174,27 @@ func (m *ApplicationSessionList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = DeploymentAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2328,64 +5221,13 @@ func (m *ApplicationSessionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ApplicationSession{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSessionSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSessionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2413,11 +5255,11 @@ func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationName = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2445,13 +5287,13 @@ func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionData = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KillInstanceWhenSessionClosed", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentStatus", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2461,17 +5303,79 @@ func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KillInstanceWhenSessionClosed = bool(v != 0)
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentStatus = DeploymentStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdelSessionNumThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdelSessionNumThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdelSessionNumThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloseGracePeriodSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			var v uint32
+			m.High = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2481,17 +5385,16 @@ func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				m.High |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CloseGracePeriodSeconds = &v
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenTimeoutSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			m.OpenTimeoutSeconds = 0
+			m.Low = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2501,7 +5404,7 @@ func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpenTimeoutSeconds |= uint32(b&0x7F) << shift
+				m.Low |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2527,7 +5430,7 @@ func (m *ApplicationSessionSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
+func (m *IdelSessionPercentThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2550,17 +5453,17 @@ func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSessionStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdelSessionPercentThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSessionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdelSessionPercentThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessEndPoints", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			var msglen int
+			m.High = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2570,31 +5473,16 @@ func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.High |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessEndPoints = append(m.AccessEndPoints, AccessEndPoint{})
-			if err := m.AccessEndPoints[len(m.AccessEndPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionStatus", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			var stringLen uint64
+			m.Low = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2604,27 +5492,64 @@ func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Low |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionStatus = SessionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeDaemon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeDaemon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeDaemon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2651,14 +5576,13 @@ func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientSessions = append(m.ClientSessions, v11.LocalObjectReference{})
-			if err := m.ClientSessions[len(m.ClientSessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2685,16 +5609,13 @@ func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AvailableTime == nil {
-				m.AvailableTime = &v1.Time{}
-			}
-			if err := m.AvailableTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2721,32 +5642,10 @@ func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CloseTime == nil {
-				m.CloseTime = &v1.Time{}
-			}
-			if err := m.CloseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableTimeMicro", wireType)
-			}
-			m.AvailableTimeMicro = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AvailableTimeMicro |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2768,7 +5667,7 @@ func (m *ApplicationSessionStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+func (m *NodeDaemonList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2791,196 +5690,48 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDaemonList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDaemonList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Containers = append(m.Containers, v11.Container{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsingNodeSessionService", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UsingNodeSessionService = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConfigData == nil {
-				m.ConfigData = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ConfigData[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScalingPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3007,7 +5758,8 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScalingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, NodeDaemon{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3032,7 +5784,7 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *NodeDaemonNodeStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3055,17 +5807,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDaemonNodeStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDaemonNodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredInstances", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			m.DesiredInstances = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3075,16 +5827,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DesiredInstances |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalInstances", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
-			m.TotalInstances = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3094,16 +5859,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalInstances |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingInstances", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			m.PendingInstances = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3113,16 +5891,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PendingInstances |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletingInstances", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			m.DeletingInstances = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3132,16 +5923,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeletingInstances |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = k8s_io_api_core_v1.PodPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedInstances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
 			}
-			m.AllocatedInstances = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3151,16 +5955,99 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AllocatedInstances |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdleInstances", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeDaemonSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeDaemonSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeDaemonSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			m.IdleInstances = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3170,14 +6057,28 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IdleInstances |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3204,13 +6105,107 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,8 +6232,7 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, DeploymentHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdateStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3263,7 +6257,7 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
+func (m *NodeDaemonStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3286,17 +6280,17 @@ func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeploymentHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDaemonStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeploymentHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDaemonStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredNumberScheduled", wireType)
 			}
-			var stringLen uint64
+			m.DesiredNumberScheduled = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3306,29 +6300,16 @@ func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DesiredNumberScheduled |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Action = DeploymentAction(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentNumberScheduled", wireType)
 			}
-			var msglen int
+			m.CurrentNumberScheduled = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3338,30 +6319,16 @@ func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CurrentNumberScheduled |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedNumberScheduled", wireType)
 			}
-			var stringLen uint64
+			m.UpdatedNumberScheduled = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3371,27 +6338,33 @@ func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdatedNumberScheduled |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberReady", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NumberReady = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberReady |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3419,13 +6392,13 @@ func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeStatuses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3435,23 +6408,25 @@ func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeploymentStatus = DeploymentStatus(dAtA[iNdEx:postIndex])
+			m.NodeStatuses = append(m.NodeStatuses, NodeDaemonNodeStatus{})
+			if err := m.NodeStatuses[len(m.NodeStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3474,7 +6449,7 @@ func (m *DeploymentHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdelSessionNumThreshold) Unmarshal(dAtA []byte) error {
+func (m *NodeDaemonUpdateStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,36 +6472,17 @@ func (m *IdelSessionNumThreshold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdelSessionNumThreshold: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDaemonUpdateStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdelSessionNumThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDaemonUpdateStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
-			}
-			m.High = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.High |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
-			m.Low = 0
+			m.MaxUnavailable = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3536,7 +6492,7 @@ func (m *IdelSessionNumThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Low |= uint32(b&0x7F) << shift
+				m.MaxUnavailable |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3562,7 +6518,7 @@ func (m *IdelSessionNumThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdelSessionPercentThreshold) Unmarshal(dAtA []byte) error {
+func (m *RollingUpdatePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3585,17 +6541,17 @@ func (m *IdelSessionPercentThreshold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdelSessionPercentThreshold: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollingUpdatePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdelSessionPercentThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollingUpdatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
-			m.High = 0
+			m.MaxUnavailable = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3605,16 +6561,16 @@ func (m *IdelSessionPercentThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.High |= uint32(b&0x7F) << shift
+				m.MaxUnavailable |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
 			}
-			m.Low = 0
+			m.MaxSurge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3624,7 +6580,7 @@ func (m *IdelSessionPercentThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Low |= uint32(b&0x7F) << shift
+				m.MaxSurge |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17,14 +17,34 @@ limitations under the License.
 package v1
 
 const (
-	LabelFornaxCoreNodeDaemon              = "daemon.fornax-serverless.centaurusinfra.io"
-	LabelFornaxCoreApplication             = "application.core.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCoreNode               = "node.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCorePod                = "pod.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCoreCreationUnixMicro  = "create.unixmicro.core.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCoreSessionService     = "sessionservice.core.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCoreApplicationSession = "applicationsession.core.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCoreNodeRevision       = "noderevision.core.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCoreHibernatePod       = "hibernatepod.core.fornax-serverless.centaurusinfra.io"
-	AnnotationFornaxCoreSessionServicePod  = "sessionservicepod.core.fornax-serverless.centaurusinfra.io"
+	LabelFornaxCoreNodeDaemon               = "daemon.fornax-serverless.centaurusinfra.io"
+	LabelFornaxCoreNodeDaemonRevision       = "daemonrevision.core.fornax-serverless.centaurusinfra.io"
+	LabelFornaxCoreApplication              = "application.core.fornax-serverless.centaurusinfra.io"
+	LabelFornaxCoreApplicationRevision      = "applicationrevision.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreNode                = "node.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCorePod                 = "pod.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreCreationUnixMicro   = "create.unixmicro.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreSessionService      = "sessionservice.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreApplicationSession  = "applicationsession.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreNodeRevision        = "noderevision.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreHibernatePod        = "hibernatepod.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreSessionServicePod   = "sessionservicepod.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreNodeDrain           = "drain.node.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreNodeDrainState      = "drainstate.node.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreConfigRevision      = "configrevision.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreNodeRuntimeHandlers = "runtimehandlers.node.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreNodeAgentLabels     = "agentlabels.node.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreTraceId             = "traceid.core.fornax-serverless.centaurusinfra.io"
+	AnnotationFornaxCoreTraceParent         = "traceparent.core.fornax-serverless.centaurusinfra.io"
 )
+
+// drain state of a node, set by fornaxcore in node annotation AnnotationFornaxCoreNodeDrainState
+// after client request a drain using node annotation AnnotationFornaxCoreNodeDrain
+const (
+	NodeDrainStateDraining = "Draining"
+	NodeDrainStateDrained  = "Drained"
+)
+
+// node extended resource, it's number of cpus which node can pin to pods exclusively,
+// pods with integer cpu requests equal to their limits consume it
+const ResourceFornaxCoreExclusiveCPU = "core.fornax-serverless.centaurusinfra.io/exclusive-cpu"
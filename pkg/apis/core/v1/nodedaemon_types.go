@@ -0,0 +1,208 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/apiserver-runtime/pkg/builder/resource"
+	"sigs.k8s.io/apiserver-runtime/pkg/builder/resource/resourcestrategy"
+)
+
+// NodeDaemon
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type NodeDaemon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   NodeDaemonSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status NodeDaemonStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// NodeDaemonList
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type NodeDaemonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []NodeDaemon `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// NodeDaemonSpec defines the desired state of NodeDaemon
+type NodeDaemonSpec struct {
+	// pod template of daemon pod, daemon pod must use host network and have exactly one container
+	Template corev1.PodTemplateSpec `json:"template" protobuf:"bytes,1,opt,name=template"`
+
+	// node labels which must match for daemon pod to run on a node, daemon pod run on every node if it's empty
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,2,rep,name=nodeSelector"`
+
+	// how to replace daemon pods created from old template
+	// +optional
+	UpdateStrategy NodeDaemonUpdateStrategy `json:"updateStrategy,omitempty" protobuf:"bytes,3,opt,name=updateStrategy"`
+}
+
+// old daemon pod on a node is terminated before new daemon pod is created on same node
+type NodeDaemonUpdateStrategy struct {
+	// maximum number of nodes which can have no ready daemon pod during update
+	// +optional, default 1
+	MaxUnavailable uint32 `json:"maxUnavailable,omitempty" protobuf:"varint,1,opt,name=maxUnavailable"`
+}
+
+// NodeDaemonStatus defines the observed state of NodeDaemon
+type NodeDaemonStatus struct {
+	// number of nodes which should run daemon pod
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled,omitempty" protobuf:"varint,1,opt,name=desiredNumberScheduled"`
+
+	// number of nodes which are running daemon pod
+	CurrentNumberScheduled int32 `json:"currentNumberScheduled,omitempty" protobuf:"varint,2,opt,name=currentNumberScheduled"`
+
+	// number of nodes which are running daemon pod of current template
+	UpdatedNumberScheduled int32 `json:"updatedNumberScheduled,omitempty" protobuf:"varint,3,opt,name=updatedNumberScheduled"`
+
+	// number of nodes which have ready daemon pod
+	NumberReady int32 `json:"numberReady,omitempty" protobuf:"varint,4,opt,name=numberReady"`
+
+	// revision of current template
+	Revision string `json:"revision,omitempty" protobuf:"bytes,5,opt,name=revision"`
+
+	// daemon pod status of each node
+	// +optional
+	// +listType=atomic
+	NodeStatuses []NodeDaemonNodeStatus `json:"nodeStatuses,omitempty" protobuf:"bytes,6,rep,name=nodeStatuses"`
+}
+
+// NodeDaemonNodeStatus is daemon pod health on a node
+type NodeDaemonNodeStatus struct {
+	// node name
+	NodeName string `json:"nodeName" protobuf:"bytes,1,opt,name=nodeName"`
+
+	// daemon pod name on node, it's empty if daemon pod is not created yet
+	// +optional
+	PodName string `json:"podName,omitempty" protobuf:"bytes,2,opt,name=podName"`
+
+	// template revision of daemon pod
+	// +optional
+	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+
+	// pod phase reported by node
+	// +optional
+	Phase corev1.PodPhase `json:"phase,omitempty" protobuf:"bytes,4,opt,name=phase,casttype=k8s.io/api/core/v1.PodPhase"`
+
+	// if daemon pod is ready
+	Ready bool `json:"ready,omitempty" protobuf:"varint,5,opt,name=ready"`
+
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
+var _ resource.Object = &NodeDaemon{}
+var _ resourcestrategy.Validater = &NodeDaemon{}
+
+func (in *NodeDaemon) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *NodeDaemon) NamespaceScoped() bool {
+	return true
+}
+
+func (in *NodeDaemon) New() runtime.Object {
+	return &NodeDaemon{}
+}
+
+func (in *NodeDaemon) NewList() runtime.Object {
+	return &NodeDaemonList{}
+}
+
+var NodeDaemonGrv = schema.GroupVersionResource{
+	Group:    "core.fornax-serverless.centaurusinfra.io",
+	Version:  "v1",
+	Resource: "nodedaemons",
+}
+
+var NodeDaemonKind = SchemeGroupVersion.WithKind("NodeDaemon")
+var NodeDaemonGrvKey = fmt.Sprintf("/%s/%s", NodeDaemonGrv.Group, NodeDaemonGrv.Resource)
+
+func (in *NodeDaemon) GetGroupVersionResource() schema.GroupVersionResource {
+	return NodeDaemonGrv
+}
+
+func (in *NodeDaemon) IsStorageVersion() bool {
+	return true
+}
+
+func (in *NodeDaemon) Validate(ctx context.Context) field.ErrorList {
+	errorList := make(field.ErrorList, 0)
+
+	if len(in.Spec.Template.Spec.Containers) != 1 {
+		err := field.Error{
+			Type:   field.ErrorTypeInvalid,
+			Field:  "Spec.Template.Spec.Containers",
+			Detail: "Daemon pod must have exactly one container",
+		}
+		errorList = append(errorList, &err)
+	}
+
+	if !in.Spec.Template.Spec.HostNetwork {
+		err := field.Error{
+			Type:   field.ErrorTypeInvalid,
+			Field:  "Spec.Template.Spec.HostNetwork",
+			Detail: "Daemon pod must use host network",
+		}
+		errorList = append(errorList, &err)
+	}
+
+	if len(errorList) > 0 {
+		return errorList
+	} else {
+		return nil
+	}
+}
+
+var _ resource.ObjectList = &NodeDaemonList{}
+
+func (in *NodeDaemonList) GetListMeta() *metav1.ListMeta {
+	return &in.ListMeta
+}
+
+func (in NodeDaemonStatus) SubResourceName() string {
+	return "status"
+}
+
+// NodeDaemon implements ObjectWithStatusSubResource interface.
+var _ resource.ObjectWithStatusSubResource = &NodeDaemon{}
+
+func (in *NodeDaemon) GetStatus() resource.StatusSubResource {
+	return in.Status
+}
+
+// NodeDaemonStatus{} implements StatusSubResource interface.
+var _ resource.StatusSubResource = &NodeDaemonStatus{}
+
+func (in NodeDaemonStatus) CopyTo(parent resource.ObjectWithStatusSubResource) {
+	parent.(*NodeDaemon).Status = in
+}
@@ -46,5 +46,10 @@ var AddToScheme = func(scheme *runtime.Scheme) error {
 		Version: "v1",
 	}, &ApplicationSession{}, &ApplicationSessionList{})
 
+	scheme.AddKnownTypes(schema.GroupVersion{
+		Group:   "core.fornax-serverless.centaurusinfra.io",
+		Version: "v1",
+	}, &NodeDaemon{}, &NodeDaemonList{})
+
 	return nil
 }
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type FornaxEvent struct {
+	corev1.Event
+}
+
+func (in *FornaxEvent) NamespaceScoped() bool {
+	return true
+}
+
+func (in *FornaxEvent) New() runtime.Object {
+	return &corev1.Event{}
+}
+
+func (in *FornaxEvent) NewList() runtime.Object {
+	return &corev1.EventList{}
+}
+
+func (in *FornaxEvent) GetGroupVersionResource() schema.GroupVersionResource {
+	return FornaxEventGrv
+}
+
+func (in *FornaxEvent) IsStorageVersion() bool {
+	return true
+}
+
+func (in *FornaxEvent) GetObjectMeta() *metav1.ObjectMeta {
+	return &(in.ObjectMeta)
+}
+
+var FornaxEventGrv = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "events",
+}
+
+var FornaxEventKind = K8sSchemeGroupVersion.WithKind("Event")
+var FornaxEventGrvKey = fmt.Sprintf("/%s", FornaxEventGrv.Resource)
+
+// EventGetAttrs return labels and selectable fields of a event, kubectl describe find events of a object by involved object fields
+func EventGetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	event, ok := obj.(*corev1.Event)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a event")
+	}
+	return labels.Set(event.Labels), fields.Set{
+		"metadata.name":                  event.Name,
+		"metadata.namespace":             event.Namespace,
+		"involvedObject.kind":            event.InvolvedObject.Kind,
+		"involvedObject.namespace":       event.InvolvedObject.Namespace,
+		"involvedObject.name":            event.InvolvedObject.Name,
+		"involvedObject.uid":             string(event.InvolvedObject.UID),
+		"involvedObject.apiVersion":      event.InvolvedObject.APIVersion,
+		"involvedObject.resourceVersion": event.InvolvedObject.ResourceVersion,
+		"involvedObject.fieldPath":       event.InvolvedObject.FieldPath,
+		"reason":                         event.Reason,
+		"reportingComponent":             event.ReportingController,
+		"source":                         event.Source.Component,
+		"type":                           event.Type,
+	}, nil
+}
+
+// EventFieldLabelConversionFunc accept field selectors of fields returned by EventGetAttrs
+func EventFieldLabelConversionFunc(label, value string) (string, string, error) {
+	switch label {
+	case "metadata.name",
+		"metadata.namespace",
+		"involvedObject.kind",
+		"involvedObject.namespace",
+		"involvedObject.name",
+		"involvedObject.uid",
+		"involvedObject.apiVersion",
+		"involvedObject.resourceVersion",
+		"involvedObject.fieldPath",
+		"reason",
+		"reportingComponent",
+		"source",
+		"type":
+		return label, value, nil
+	default:
+		return "", "", fmt.Errorf("field label not supported: %s", label)
+	}
+}
@@ -34,5 +34,7 @@ var AddToScheme = func(scheme *runtime.Scheme) error {
 	metav1.AddToGroupVersion(scheme, K8sSchemeGroupVersion)
 	scheme.AddKnownTypes(K8sSchemeGroupVersion, &FornaxNode{})
 	scheme.AddKnownTypes(K8sSchemeGroupVersion, &FornaxPod{})
+	scheme.AddKnownTypes(K8sSchemeGroupVersion, &FornaxSecret{})
+	scheme.AddKnownTypes(K8sSchemeGroupVersion, &FornaxEvent{})
 	return nil
 }
@@ -38,9 +38,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ApplicationSessionStatus":    schema_pkg_apis_core_v1_ApplicationSessionStatus(ref),
 		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ApplicationSpec":             schema_pkg_apis_core_v1_ApplicationSpec(ref),
 		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ApplicationStatus":           schema_pkg_apis_core_v1_ApplicationStatus(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ConfigProjection":            schema_pkg_apis_core_v1_ConfigProjection(ref),
 		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.DeploymentHistory":           schema_pkg_apis_core_v1_DeploymentHistory(ref),
 		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.IdelSessionNumThreshold":     schema_pkg_apis_core_v1_IdelSessionNumThreshold(ref),
 		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.IdelSessionPercentThreshold": schema_pkg_apis_core_v1_IdelSessionPercentThreshold(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemon":                  schema_pkg_apis_core_v1_NodeDaemon(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonList":              schema_pkg_apis_core_v1_NodeDaemonList(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonNodeStatus":        schema_pkg_apis_core_v1_NodeDaemonNodeStatus(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonSpec":              schema_pkg_apis_core_v1_NodeDaemonSpec(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonStatus":            schema_pkg_apis_core_v1_NodeDaemonStatus(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonUpdateStrategy":    schema_pkg_apis_core_v1_NodeDaemonUpdateStrategy(ref),
+		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.RollingUpdatePolicy":         schema_pkg_apis_core_v1_RollingUpdatePolicy(ref),
 		"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ScalingPolicy":               schema_pkg_apis_core_v1_ScalingPolicy(ref),
 	}
 }
@@ -385,11 +393,49 @@ func schema_pkg_apis_core_v1_ApplicationSessionStatus(ref common.ReferenceCallba
 							Format: "int64",
 						},
 					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A brief CamelCase message indicating why session was closed, e.g. Evicted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about why session was closed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Current state of session, condition types are Scheduled, Assigned and Ready",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.AccessEndPoint", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.AccessEndPoint", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -449,11 +495,143 @@ func schema_pkg_apis_core_v1_ApplicationSpec(ref common.ReferenceCallback) commo
 							Ref:         ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ScalingPolicy"),
 						},
 					},
+					"sessionsPerInstance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "how many sessions a application instance can hold at same time, sessions are packed onto partially used instances firstly",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "how to replace application instances created from old spec when containers changed, config data changes are pushed to running instances and do not replace them",
+							Default:     map[string]interface{}{},
+							Ref:         ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.RollingUpdatePolicy"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "node labels which must match for application instances to be scheduled onto a node",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "required and preferred node affinity of application instances, only node affinity is honored by scheduler",
+							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "taints of node which application instances tolerate",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"configProjection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "how config data is exposed to application containers, config data is mounted in DefaultConfigMountPath and exposed as environment variables if it's not set",
+							Ref:         ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ConfigProjection"),
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "volumes which application containers can mount, emptyDir, configMap of application config data, secret and hostPath volumes are supported, hostPath volume must be allowed by node agent, configMap and secret volumes are mounted read-only",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"imagePullSecrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "docker config secrets in application namespace which are used to pull container images from private registries, secret type must be kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"initContainers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "init containers run one by one to completion before application containers start, instance fails if any of them fails, they run only once for a instance, hibernating and waking up instance does not run them again",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of runtime handler which application instances run in, for example runc or quark, instances are only scheduled onto nodes which support it, node default runtime handler is used if it's not set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restartPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "how application containers are restarted when they exit, Always, OnFailure or Never, containers are restarted in place with exponential backoff, instance is terminated when a container exit if it's Never",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ScalingPolicy", "k8s.io/api/core/v1.Container"},
+			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ConfigProjection", "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.RollingUpdatePolicy", "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.ScalingPolicy", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -522,7 +700,7 @@ func schema_pkg_apis_core_v1_ApplicationStatus(ref common.ReferenceCallback) com
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents the latest available observations of a deployment's current state.",
+							Description: "Represents the latest available observations of a deployment's current state. only a limited number of latest histories are kept, older ones are dropped",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -534,11 +712,62 @@ func schema_pkg_apis_core_v1_ApplicationStatus(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Current state of application, condition types are Ready, Progressing, Degraded and ScalingLimited",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.DeploymentHistory"},
+			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.DeploymentHistory", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_pkg_apis_core_v1_ConfigProjection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "config files in mount path are updated in place when config data changed, environment variables are set when container start, running containers keep old values",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "absolute directory in which each config key is mounted read-only as a file, config is not mounted if it's empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "expose each config key as a environment variable",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -644,6 +873,320 @@ func schema_pkg_apis_core_v1_IdelSessionPercentThreshold(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_core_v1_NodeDaemon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeDaemon",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonSpec", "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1_NodeDaemonList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeDaemonList",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemon"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemon", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1_NodeDaemonNodeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeDaemonNodeStatus is daemon pod health on a node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "node name",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "daemon pod name on node, it's empty if daemon pod is not created yet",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "template revision of daemon pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "pod phase reported by node",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "if daemon pod is ready",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"nodeName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1_NodeDaemonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeDaemonSpec defines the desired state of NodeDaemon",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "pod template of daemon pod, daemon pod must use host network and have exactly one container",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.PodTemplateSpec"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "node labels which must match for daemon pod to run on a node, daemon pod run on every node if it's empty",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "how to replace daemon pods created from old template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonUpdateStrategy"),
+						},
+					},
+				},
+				Required: []string{"template"},
+			},
+		},
+		Dependencies: []string{
+			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonUpdateStrategy", "k8s.io/api/core/v1.PodTemplateSpec"},
+	}
+}
+
+func schema_pkg_apis_core_v1_NodeDaemonStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeDaemonStatus defines the observed state of NodeDaemon",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"desiredNumberScheduled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "number of nodes which should run daemon pod",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentNumberScheduled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "number of nodes which are running daemon pod",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedNumberScheduled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "number of nodes which are running daemon pod of current template",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"numberReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "number of nodes which have ready daemon pod",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "revision of current template",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeStatuses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "daemon pod status of each node",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonNodeStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"centaurusinfra.io/fornax-serverless/pkg/apis/core/v1.NodeDaemonNodeStatus"},
+	}
+}
+
+func schema_pkg_apis_core_v1_NodeDaemonUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "old daemon pod on a node is terminated before new daemon pod is created on same node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maximum number of nodes which can have no ready daemon pod during update",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1_RollingUpdatePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "instances which have session on it are drained, they do not accept new session and are replaced after all sessions closed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maximum number of unallocated instances which can be unavailable during replacement, including pending and deleting instances",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maximum number of new instances which can be created over desired number of instances during replacement",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1_ScalingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
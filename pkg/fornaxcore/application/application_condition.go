@@ -0,0 +1,107 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"fmt"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reasons of application conditions
+const (
+	ConditionReasonInstancesAvailable   = "InstancesAvailable"
+	ConditionReasonInstancesUnavailable = "InstancesUnavailable"
+	ConditionReasonApplicationDeleting  = "ApplicationDeleting"
+	ConditionReasonScaling              = "Scaling"
+	ConditionReasonRollingUpdate        = "RollingUpdate"
+	ConditionReasonDesiredStateReached  = "DesiredStateReached"
+	ConditionReasonSyncFailed           = "SyncFailed"
+	ConditionReasonHealthy              = "Healthy"
+	ConditionReasonMaximumInstances     = "MaximumInstancesReached"
+	ConditionReasonScalingBurst         = "ScalingBurstLimited"
+	ConditionReasonNotLimited           = "NotLimited"
+)
+
+// setApplicationConditions calculate Ready, Progressing, Degraded and ScalingLimited conditions from pool summary and sync result,
+// meta.SetStatusCondition only change lastTransitionTime of a condition when its status flipped
+func setApplicationConditions(status *fornaxv1.ApplicationStatus, application *fornaxv1.Application, sessionSummary ApplicationSessionSummary, podSummary ApplicationPodSummary, desiredCount int, podFailure *fornaxv1.DeploymentHistory, deploymentErr error) {
+	generation := application.Generation
+	runningCount := podSummary.idleCount + podSummary.occupiedCount
+	counts := fmt.Sprintf("desired: %d, running: %d, pending: %d, deleting: %d", desiredCount, runningCount, podSummary.pendingCount, podSummary.deletingCount)
+
+	// Ready
+	ready := metav1.Condition{Type: fornaxv1.ApplicationConditionReady, ObservedGeneration: generation}
+	switch {
+	case application.DeletionTimestamp != nil:
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, ConditionReasonApplicationDeleting, "application is being deleted"
+	case podSummary.pendingCount == 0 && runningCount >= desiredCount:
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionTrue, ConditionReasonInstancesAvailable, counts
+	default:
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, ConditionReasonInstancesUnavailable, counts
+	}
+	meta.SetStatusCondition(&status.Conditions, ready)
+
+	// Progressing
+	progressing := metav1.Condition{Type: fornaxv1.ApplicationConditionProgressing, ObservedGeneration: generation}
+	if rollout := getLastRollingUpdateHistory(status); rollout != nil && rollout.DeploymentStatus != fornaxv1.DeploymentStatusSuccess {
+		progressing.Status, progressing.Reason, progressing.Message = metav1.ConditionTrue, ConditionReasonRollingUpdate, rollout.Message
+	} else if podSummary.pendingCount > 0 || podSummary.deletingCount > 0 || podSummary.totalCount != desiredCount {
+		progressing.Status, progressing.Reason, progressing.Message = metav1.ConditionTrue, ConditionReasonScaling, counts
+	} else {
+		progressing.Status, progressing.Reason, progressing.Message = metav1.ConditionFalse, ConditionReasonDesiredStateReached, counts
+	}
+	meta.SetStatusCondition(&status.Conditions, progressing)
+
+	// Degraded, a pod failure is kept until application become ready again
+	degraded := metav1.Condition{Type: fornaxv1.ApplicationConditionDegraded, ObservedGeneration: generation}
+	switch {
+	case deploymentErr != nil:
+		degraded.Status, degraded.Reason, degraded.Message = metav1.ConditionTrue, ConditionReasonSyncFailed, deploymentErr.Error()
+	case podFailure != nil:
+		degraded.Status, degraded.Reason, degraded.Message = metav1.ConditionTrue, podFailure.Reason, podFailure.Message
+	case ready.Status == metav1.ConditionTrue:
+		degraded.Status, degraded.Reason, degraded.Message = metav1.ConditionFalse, ConditionReasonHealthy, ""
+	default:
+		if existing := meta.FindStatusCondition(status.Conditions, fornaxv1.ApplicationConditionDegraded); existing != nil {
+			degraded.Status, degraded.Reason, degraded.Message = existing.Status, existing.Reason, existing.Message
+		} else {
+			degraded.Status, degraded.Reason, degraded.Message = metav1.ConditionFalse, ConditionReasonHealthy, ""
+		}
+	}
+	meta.SetStatusCondition(&status.Conditions, degraded)
+
+	// ScalingLimited
+	limited := metav1.Condition{Type: fornaxv1.ApplicationConditionScalingLimited, ObservedGeneration: generation}
+	maximum := int(application.Spec.ScalingPolicy.MaximumInstance)
+	burst := util.ApplicationScalingBurst(application)
+	switch {
+	case sessionSummary.pendingCount > 0 && podSummary.totalCount >= maximum:
+		limited.Status, limited.Reason = metav1.ConditionTrue, ConditionReasonMaximumInstances
+		limited.Message = fmt.Sprintf("%d sessions are pending, instances reached maximum %d", sessionSummary.pendingCount, maximum)
+	case desiredCount-podSummary.totalCount > burst:
+		limited.Status, limited.Reason = metav1.ConditionTrue, ConditionReasonScalingBurst
+		limited.Message = fmt.Sprintf("%d more instances are desired, at most %d instances are created in a sync", desiredCount-podSummary.totalCount, burst)
+	default:
+		limited.Status, limited.Reason, limited.Message = metav1.ConditionFalse, ConditionReasonNotLimited, ""
+	}
+	meta.SetStatusCondition(&status.Conditions, limited)
+}
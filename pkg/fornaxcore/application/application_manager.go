@@ -25,6 +25,7 @@ import (
 	"time"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	"centaurusinfra.io/fornax-serverless/pkg/collection"
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
 	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
 	storefactory "centaurusinfra.io/fornax-serverless/pkg/store/factory"
@@ -36,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 )
@@ -46,6 +48,9 @@ const (
 
 	// The number of application workers
 	DefaultNumOfApplicationWorkers = 4
+
+	// The number of latest deployment histories kept in application status
+	DefaultApplicationHistoryLength = 20
 )
 
 // ApplicationManager is responsible for synchronizing Application objects stored
@@ -68,22 +73,33 @@ type ApplicationManager struct {
 	sessionManager       ie.SessionManagerInterface
 	sessionUpdateChannel <-chan fornaxstore.WatchEventWithOldObj
 
+	nodeUpdateChannel chan *ie.NodeEvent
+	drainingNodes     *collection.ConcurrentStringSet
+
 	applicationStatusManager *ApplicationStatusManager
+
+	eventRecorder record.EventRecorder
 }
 
+var _ ie.PodConfigProvider = &ApplicationManager{}
+
 // NewApplicationManager init ApplicationInformer and ApplicationSessionInformer,
 // and start to listen to pod event from node
-func NewApplicationManager(ctx context.Context, podManager ie.PodManagerInterface, sessionManager ie.SessionManagerInterface, appStore fornaxstore.ApiStorageInterface) *ApplicationManager {
+func NewApplicationManager(ctx context.Context, podManager ie.PodManagerInterface, sessionManager ie.SessionManagerInterface, nodeInfoLW ie.NodeInfoLWInterface, appStore fornaxstore.ApiStorageInterface, eventRecorder record.EventRecorder) *ApplicationManager {
 	am := &ApplicationManager{
-		ctx:              ctx,
-		applicationQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "fornaxv1.Application"),
-		applicationPools: map[string]*ApplicationPool{},
-		podUpdateChannel: make(chan *ie.PodEvent, 1000),
-		podManager:       podManager,
-		sessionManager:   sessionManager,
-		applicationStore: appStore,
+		ctx:               ctx,
+		applicationQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "fornaxv1.Application"),
+		applicationPools:  map[string]*ApplicationPool{},
+		podUpdateChannel:  make(chan *ie.PodEvent, 1000),
+		podManager:        podManager,
+		sessionManager:    sessionManager,
+		applicationStore:  appStore,
+		nodeUpdateChannel: make(chan *ie.NodeEvent, 100),
+		drainingNodes:     collection.NewConcurrentSet(),
+		eventRecorder:     eventRecorder,
 	}
 	am.podManager.Watch(am.podUpdateChannel)
+	nodeInfoLW.Watch(am.nodeUpdateChannel)
 
 	return am
 }
@@ -92,6 +108,7 @@ func (am *ApplicationManager) deleteApplicationPool(applicationKey string) {
 	am.mu.Lock()
 	delete(am.applicationPools, applicationKey)
 	am.mu.Unlock()
+	forgetApplicationMetrics(applicationKey)
 }
 
 func (am *ApplicationManager) applicationList() map[string]*ApplicationPool {
@@ -191,13 +208,25 @@ func (am *ApplicationManager) Run(ctx context.Context) {
 		for {
 			select {
 			case <-ctx.Done():
-				break
+				return
 			case update := <-am.podUpdateChannel:
 				am.onPodEventFromNode(update)
 			}
 		}
 	}()
 
+	go func() {
+		defer klog.Info("Shutting down fornaxv1 application node event handler")
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update := <-am.nodeUpdateChannel:
+				am.onNodeEventFromNodeManager(update)
+			}
+		}
+	}()
+
 	for i := 0; i < DefaultNumOfApplicationWorkers; i++ {
 		go wait.UntilWithContext(ctx, am.worker, time.Second)
 	}
@@ -312,6 +341,7 @@ func (am *ApplicationManager) syncApplication(ctx context.Context, applicationKe
 	}
 
 	var numOfDesiredPod, addition int
+	var rolloutHistory *fornaxv1.DeploymentHistory
 	application, syncErr := storefactory.GetApplicationCache(am.applicationStore, applicationKey)
 	if syncErr == nil {
 		if application != nil {
@@ -319,10 +349,25 @@ func (am *ApplicationManager) syncApplication(ctx context.Context, applicationKe
 				// 1, assign pending session to idle pods firstly and cleanup timedout and deleting sessions
 				syncErr = am.deployApplicationSessions(pool, application)
 
+				// close sessions and delete pods on draining nodes before calculating desired pods
+				if syncErr == nil {
+					syncErr = am.drainApplicationPods(pool)
+				}
+
 				// 2, find how many more pods required for remaining pending sessions
 				if syncErr == nil {
 					numOfDesiredPod, addition, syncErr = am.deployApplicationPods(pool, application)
 				}
+
+				// 3, replace pods of old application revision
+				if syncErr == nil {
+					rolloutHistory, syncErr = am.rollingUpdateApplicationPods(pool, application, numOfDesiredPod)
+				}
+
+				// 4, push config data to running pods when it changed
+				if syncErr == nil {
+					syncErr = am.updateApplicationPodConfig(pool, application)
+				}
 			} else {
 				numOfDesiredPod = 0
 				addition, syncErr = am.cleanupDeletedApplication(pool)
@@ -330,7 +375,7 @@ func (am *ApplicationManager) syncApplication(ctx context.Context, applicationKe
 			}
 			// take care of timeout and deleting pods
 			am.pruneDeadPods(pool)
-			newStatus := am.calculateStatus(pool, application, numOfDesiredPod, addition, syncErr)
+			newStatus := am.calculateStatus(pool, application, numOfDesiredPod, addition, rolloutHistory, syncErr)
 			am.applicationStatusManager.UpdateApplicationStatus(application, newStatus)
 		} else {
 			_, syncErr = am.cleanupDeletedApplication(pool)
@@ -344,6 +389,11 @@ func (am *ApplicationManager) syncApplication(ctx context.Context, applicationKe
 		am.applicationQueue.AddAfter(applicationKey, DefaultApplicationSyncErrorRecycleDuration)
 	}
 
+	if am.getApplicationPool(applicationKey) != nil {
+		sessionSummary, podSummary := pool.summarySessionAndPods()
+		recordApplicationMetrics(applicationKey, sessionSummary, podSummary)
+	}
+
 	et := time.Now().UnixMicro()
 	klog.V(5).InfoS("Done syncing application", "application", applicationKey, "took-micro", et-st)
 	return syncErr
@@ -380,37 +430,49 @@ func (am *ApplicationManager) pruneDeadPods(pool *ApplicationPool) {
 	}
 }
 
-func (am *ApplicationManager) calculateDesiredIdlePods(application *fornaxv1.Application, occupiedPodNum, idlePodNum int, sessionNum int) int {
+// calculateDesiredIdlePods return how many unallocated pods application should have,
+// idle session thresholds are measured by free session slots, a unallocated pod provides SessionsPerInstance slots,
+// and occupied pods provide their remaining slots, pending sessions consume free slots
+func (am *ApplicationManager) calculateDesiredIdlePods(application *fornaxv1.Application, occupiedPodNum, occupiedSessionNum, idlePodNum int, sessionNum int) int {
+	sessionsPerInstance := util.ApplicationSessionsPerInstance(application)
 	desiredCount := idlePodNum
-	sessionSupported := idlePodNum
-	idleSessionNum := int(sessionSupported) - sessionNum
+	occupiedFreeSlots := occupiedPodNum*sessionsPerInstance - occupiedSessionNum
+	if occupiedFreeSlots < 0 {
+		occupiedFreeSlots = 0
+	}
+	sessionSupported := (occupiedPodNum + idlePodNum) * sessionsPerInstance
+	idleSessionNum := idlePodNum*sessionsPerInstance + occupiedFreeSlots - sessionNum
 
 	if application.Spec.ScalingPolicy.ScalingPolicyType == fornaxv1.ScalingPolicyTypeIdleSessionNum {
 		lowThresholdNum := int(application.Spec.ScalingPolicy.IdleSessionNumThreshold.Low)
 		if idleSessionNum < lowThresholdNum {
-			desiredCount = idlePodNum + int(math.Ceil(float64(lowThresholdNum-idleSessionNum)))
+			desiredCount = idlePodNum + int(math.Ceil(float64(lowThresholdNum-idleSessionNum)/float64(sessionsPerInstance)))
 		}
 
 		highThresholdNum := int(application.Spec.ScalingPolicy.IdleSessionNumThreshold.High)
 		if idleSessionNum > highThresholdNum {
-			desiredCount = idlePodNum - int(math.Floor(float64(idleSessionNum-highThresholdNum)))
+			desiredCount = idlePodNum - int(math.Floor(float64(idleSessionNum-highThresholdNum)/float64(sessionsPerInstance)))
 		}
 	}
 
 	if application.Spec.ScalingPolicy.ScalingPolicyType == fornaxv1.ScalingPolicyTypeIdleSessionPercent {
 		lowThreshold := int(application.Spec.ScalingPolicy.IdleSessionPercentThreshold.Low)
 		lowThresholdNum := sessionSupported * lowThreshold / 100
-		if idleSessionNum < lowThreshold {
-			desiredCount = idlePodNum + int(math.Ceil(float64(lowThresholdNum-idleSessionNum)))
+		if idleSessionNum < lowThresholdNum {
+			desiredCount = idlePodNum + int(math.Ceil(float64(lowThresholdNum-idleSessionNum)/float64(sessionsPerInstance)))
 		}
 
 		highThreshold := int(application.Spec.ScalingPolicy.IdleSessionPercentThreshold.High)
 		highThresholdNum := sessionSupported * highThreshold / 100
-		if idleSessionNum > highThreshold {
-			desiredCount = idlePodNum - int(math.Floor(float64(idleSessionNum-highThresholdNum)))
+		if idleSessionNum > highThresholdNum {
+			desiredCount = idlePodNum - int(math.Floor(float64(idleSessionNum-highThresholdNum)/float64(sessionsPerInstance)))
 		}
 	}
 
+	if desiredCount < 0 {
+		desiredCount = 0
+	}
+
 	numOfDesiredPod := desiredCount + occupiedPodNum
 	// total number must between maximum and minmum instances
 	if numOfDesiredPod <= int(application.Spec.ScalingPolicy.MinimumInstance) {
@@ -425,9 +487,36 @@ func (am *ApplicationManager) calculateDesiredIdlePods(application *fornaxv1.App
 	return desiredCount
 }
 
-func (am *ApplicationManager) calculateStatus(pool *ApplicationPool, application *fornaxv1.Application, desiredCount, addition int, deploymentErr error) *fornaxv1.ApplicationStatus {
+func (am *ApplicationManager) calculateStatus(pool *ApplicationPool, application *fornaxv1.Application, desiredCount, addition int, rolloutHistory *fornaxv1.DeploymentHistory, deploymentErr error) *fornaxv1.ApplicationStatus {
 	newStatus := application.Status.DeepCopy()
-	_, podSummary := pool.summarySessionAndPods()
+	sessionSummary, podSummary := pool.summarySessionAndPods()
+
+	if rolloutHistory != nil {
+		newStatus.LatestHistory = *rolloutHistory
+		// a rolling update make progress in many syncs, only keep latest progress of an ongoing rollout in history
+		if last := len(newStatus.History) - 1; last >= 0 && rolloutHistory.DeploymentStatus == fornaxv1.DeploymentStatusPartialSuccess &&
+			newStatus.History[last].Action == fornaxv1.DeploymentActionRollingUpdate &&
+			newStatus.History[last].DeploymentStatus == fornaxv1.DeploymentStatusPartialSuccess {
+			newStatus.History[last] = *rolloutHistory
+		} else {
+			newStatus.History = append(newStatus.History, *rolloutHistory)
+		}
+	}
+
+	// pods keep failing for same reason until user fix application, only record a failure reason when it changed
+	podFailure := pool.takePodFailure()
+	if podFailure != nil {
+		if newStatus.LatestHistory.Reason != podFailure.Reason {
+			newStatus.History = append(newStatus.History, *podFailure)
+		}
+		newStatus.LatestHistory = *podFailure
+	}
+
+	if len(newStatus.History) > DefaultApplicationHistoryLength {
+		newStatus.History = newStatus.History[len(newStatus.History)-DefaultApplicationHistoryLength:]
+	}
+
+	setApplicationConditions(newStatus, application, sessionSummary, podSummary, desiredCount, podFailure, deploymentErr)
 
 	if application.Status.DesiredInstances == int32(desiredCount) &&
 		application.Status.TotalInstances == int32(podSummary.totalCount) &&
@@ -489,6 +578,7 @@ func (am *ApplicationManager) houseKeeping() error {
 	for appKey, pool := range appPools {
 		sessionSummary, podSummary := pool.summarySessionAndPods()
 		klog.InfoS("Application summary", "app", appKey, "pod", podSummary, "session", sessionSummary)
+		recordApplicationMetrics(appKey, sessionSummary, podSummary)
 
 		// starting and pending session could timeout
 		if sessionSummary.pendingCount > 0 || sessionSummary.startingCount > 0 || sessionSummary.deletingCount > 0 {
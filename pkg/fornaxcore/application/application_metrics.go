@@ -0,0 +1,57 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"time"
+
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/metrics"
+)
+
+// observeSessionOpenDuration record how long a session stayed in previous state when it move forward to starting or running state,
+// total latency is measured from the time session is added into application pool
+func observeSessionOpenDuration(s *ApplicationSession, newState ApplicationSessionState, now time.Time) {
+	switch {
+	case s.state == SessionStatePending && newState == SessionStateStarting:
+		metrics.SessionOpenDuration.WithLabelValues(metrics.SessionPhasePending).Observe(now.Sub(s.stateTime).Seconds())
+	case s.state == SessionStateStarting && newState == SessionStateRunning:
+		metrics.SessionOpenDuration.WithLabelValues(metrics.SessionPhaseStarting).Observe(now.Sub(s.stateTime).Seconds())
+		metrics.SessionOpenDuration.WithLabelValues(metrics.SessionPhaseTotal).Observe(now.Sub(s.addTime).Seconds())
+	}
+}
+
+// recordApplicationMetrics set pod and session gauges of a application from its pool summary
+func recordApplicationMetrics(applicationKey string, sessionSummary ApplicationSessionSummary, podSummary ApplicationPodSummary) {
+	metrics.ApplicationPods.WithLabelValues(applicationKey, "pending").Set(float64(podSummary.pendingCount))
+	metrics.ApplicationPods.WithLabelValues(applicationKey, "idle").Set(float64(podSummary.idleCount))
+	metrics.ApplicationPods.WithLabelValues(applicationKey, "occupied").Set(float64(podSummary.occupiedCount))
+	metrics.ApplicationPods.WithLabelValues(applicationKey, "deleting").Set(float64(podSummary.deletingCount))
+	metrics.ApplicationSessions.WithLabelValues(applicationKey, "pending").Set(float64(sessionSummary.pendingCount))
+	metrics.ApplicationSessions.WithLabelValues(applicationKey, "starting").Set(float64(sessionSummary.startingCount))
+	metrics.ApplicationSessions.WithLabelValues(applicationKey, "running").Set(float64(sessionSummary.runningCount))
+	metrics.ApplicationSessions.WithLabelValues(applicationKey, "deleting").Set(float64(sessionSummary.deletingCount))
+}
+
+// forgetApplicationMetrics remove gauges of a application which is removed from application manager
+func forgetApplicationMetrics(applicationKey string) {
+	for _, state := range []string{"pending", "idle", "occupied", "deleting"} {
+		metrics.ApplicationPods.DeleteLabelValues(applicationKey, state)
+	}
+	for _, state := range []string{"pending", "starting", "running", "deleting"} {
+		metrics.ApplicationSessions.DeleteLabelValues(applicationKey, state)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"fmt"
+
+	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+
+	"k8s.io/klog/v2"
+)
+
+// onNodeEventFromNodeManager track nodes which are requested to drain,
+// when a node begin to drain, all applications are synced to move their pods away from this node
+func (am *ApplicationManager) onNodeEventFromNodeManager(nodeEvent *ie.NodeEvent) {
+	nodeName := util.Name(nodeEvent.Node)
+	draining := nodeEvent.Type != ie.NodeEventTypeDelete && util.IsNodeDrainRequested(nodeEvent.Node)
+	if draining == am.drainingNodes.Has(nodeName) {
+		return
+	}
+
+	if draining {
+		klog.InfoS("Node is draining, move application pods away from node", "node", nodeName)
+		am.drainingNodes.Add(nodeName)
+		for applicationKey := range am.applicationList() {
+			am.enqueueApplication(applicationKey)
+		}
+	} else {
+		am.drainingNodes.Delete(nodeName)
+	}
+}
+
+func (am *ApplicationManager) isPodOnDrainingNode(podName string) bool {
+	if am.drainingNodes.Len() == 0 {
+		return false
+	}
+	pod := am.podManager.FindPod(podName)
+	if pod == nil {
+		return false
+	}
+	return am.drainingNodes.Has(util.GetPodFornaxNodeIdAnnotation(pod))
+}
+
+// drainApplicationPods move application pods away from draining nodes,
+// pending and idle pods are deleted directly, sessions on allocated pods are closed,
+// node close a session using session's CloseGracePeriodSeconds, pod is deleted after all of its sessions are closed,
+// application will create new pods on other nodes to replace deleted pods
+func (am *ApplicationManager) drainApplicationPods(pool *ApplicationPool) error {
+	drainErrors := []error{}
+	for _, ap := range pool.podList() {
+		if !am.isPodOnDrainingNode(ap.podName) {
+			continue
+		}
+		switch ap.state {
+		case PodStatePending, PodStateIdle:
+			klog.InfoS("Delete application pod on draining node", "application", pool.appName, "pod", ap.podName)
+			if err := am.deleteApplicationPod(pool, ap.podName); err != nil {
+				drainErrors = append(drainErrors, err)
+			}
+		case PodStateAllocated:
+			for _, s := range pool.getPodSessions(ap.podName) {
+				if util.SessionIsClosing(s.session) {
+					continue
+				}
+				klog.InfoS("Close application session on draining node", "application", pool.appName, "pod", ap.podName, "session", util.Name(s.session))
+				if err := am.deleteApplicationSession(pool, s); err != nil {
+					drainErrors = append(drainErrors, err)
+				}
+			}
+		}
+	}
+
+	if len(drainErrors) > 0 {
+		return fmt.Errorf("Some pods failed to be drained, errors=%v", drainErrors)
+	}
+	return nil
+}
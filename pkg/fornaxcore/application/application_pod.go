@@ -22,6 +22,7 @@ import (
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	default_config "centaurusinfra.io/fornax-serverless/pkg/config"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/event"
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
 	fornaxpod "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/pod"
 	"centaurusinfra.io/fornax-serverless/pkg/store/factory"
@@ -40,6 +41,9 @@ import (
 
 type ApplicationPodState uint8
 
+// DefaultPodConfigPushTimeoutDuration is how long to wait node report a pushed config revision back before pushing it again
+const DefaultPodConfigPushTimeoutDuration = 30 * time.Second
+
 const (
 	DefaultPodDeletingTimeoutDuration                     = 30 * time.Second
 	DefaultPodPendingTimeoutDuration                      = 30 * time.Second
@@ -53,6 +57,11 @@ type ApplicationPod struct {
 	podName  string
 	state    ApplicationPodState
 	sessions map[string]bool
+	// config revision node reported pod is using
+	configRevision string
+	// config revision pushed to pod and when, avoid pushing it again before node report pod back or push timeout
+	pushedConfigRevision string
+	configPushTime       time.Time
 }
 
 func NewApplicationPod(podName string, state ApplicationPodState) *ApplicationPod {
@@ -157,8 +166,15 @@ func (am *ApplicationManager) handlePodDeleteFromNode(pod *v1.Pod) {
 		if pool == nil {
 			return
 		}
-		am.cleanupSessionOnDeletedPod(pool, podName)
+		am.cleanupSessionOnDeletedPod(pool, pod)
 		pool.deletePod(podName)
+		switch reason := pod.Status.Reason; reason {
+		case util.PodReasonImagePullAuthError, util.PodReasonImagePullError, util.PodReasonInitContainerError:
+			klog.InfoS("Application pod failed on node", "application", applicationKey, "pod", podName, "reason", reason, "message", pod.Status.Message)
+			pool.setPodFailure(reason, pod.Status.Message)
+		case util.PodReasonEvicted:
+			klog.InfoS("Application pod evicted by node", "application", applicationKey, "pod", podName, "message", pod.Status.Message)
+		}
 	}
 	am.enqueueApplication(applicationKey)
 }
@@ -219,6 +235,33 @@ func (am *ApplicationManager) getPodApplicationPodTemplate(uid uuid.UUID, name s
 	mountServiceAccount := false
 	shareProcessNamespace := false
 	preemptionPolicy := v1.PreemptNever
+	nodeSelector := map[string]string{}
+	for k, v := range application.Spec.NodeSelector {
+		nodeSelector[k] = v
+	}
+	tolerations := []v1.Toleration{}
+	for _, v := range application.Spec.Tolerations {
+		tolerations = append(tolerations, *v.DeepCopy())
+	}
+	volumes := []v1.Volume{}
+	for _, v := range application.Spec.Volumes {
+		volumes = append(volumes, *v.DeepCopy())
+	}
+	imagePullSecrets := []v1.LocalObjectReference{}
+	imagePullSecrets = append(imagePullSecrets, application.Spec.ImagePullSecrets...)
+	initContainers := []v1.Container{}
+	for _, v := range application.Spec.InitContainers {
+		initContainers = append(initContainers, *v.DeepCopy())
+	}
+	restartPolicy := v1.RestartPolicyNever
+	if len(application.Spec.RestartPolicy) > 0 {
+		restartPolicy = application.Spec.RestartPolicy
+	}
+	var runtimeClassName *string
+	if application.Spec.RuntimeClassName != nil {
+		name := *application.Spec.RuntimeClassName
+		runtimeClassName = &name
+	}
 	pod := &v1.Pod{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Pod",
@@ -237,7 +280,8 @@ func (am *ApplicationManager) getPodApplicationPodTemplate(uid uuid.UUID, name s
 			DeletionTimestamp:          nil,
 			DeletionGracePeriodSeconds: application.DeletionGracePeriodSeconds,
 			Labels: map[string]string{
-				fornaxv1.LabelFornaxCoreApplication: util.Name(application),
+				fornaxv1.LabelFornaxCoreApplication:         util.Name(application),
+				fornaxv1.LabelFornaxCoreApplicationRevision: util.ApplicationRevision(application),
 			},
 			Annotations: map[string]string{},
 			OwnerReferences: []metav1.OwnerReference{
@@ -253,14 +297,14 @@ func (am *ApplicationManager) getPodApplicationPodTemplate(uid uuid.UUID, name s
 			Finalizers: []string{},
 		},
 		Spec: v1.PodSpec{
-			Volumes:                       []v1.Volume{},
-			InitContainers:                []v1.Container{},
+			Volumes:                       volumes,
+			InitContainers:                initContainers,
 			EphemeralContainers:           []v1.EphemeralContainer{},
-			RestartPolicy:                 v1.RestartPolicyNever,
+			RestartPolicy:                 restartPolicy,
 			TerminationGracePeriodSeconds: nil,
 			ActiveDeadlineSeconds:         nil,
 			DNSPolicy:                     v1.DNSNone,
-			NodeSelector:                  map[string]string{},
+			NodeSelector:                  nodeSelector,
 			ServiceAccountName:            "",
 			DeprecatedServiceAccount:      "",
 			AutomountServiceAccountToken:  &mountServiceAccount,
@@ -270,11 +314,11 @@ func (am *ApplicationManager) getPodApplicationPodTemplate(uid uuid.UUID, name s
 			HostIPC:                       false,
 			ShareProcessNamespace:         &shareProcessNamespace,
 			SecurityContext:               &v1.PodSecurityContext{},
-			ImagePullSecrets:              []v1.LocalObjectReference{},
+			ImagePullSecrets:              imagePullSecrets,
 			Hostname:                      "",
 			Subdomain:                     default_config.DefaultDomainName,
-			Affinity:                      &v1.Affinity{},
-			Tolerations:                   []v1.Toleration{},
+			Affinity:                      &v1.Affinity{NodeAffinity: application.Spec.NodeAffinity.DeepCopy()},
+			Tolerations:                   tolerations,
 			HostAliases:                   []v1.HostAlias{},
 			// PriorityClassName:             "",
 			// Priority:                      nil,
@@ -284,7 +328,7 @@ func (am *ApplicationManager) getPodApplicationPodTemplate(uid uuid.UUID, name s
 					ConditionType: v1.ContainersReady,
 				},
 			},
-			// RuntimeClassName:          nil,
+			RuntimeClassName:          runtimeClassName,
 			EnableServiceLinks:        &enableServiceLinks,
 			PreemptionPolicy:          &preemptionPolicy,
 			Overhead:                  map[v1.ResourceName]resource.Quantity{},
@@ -319,6 +363,9 @@ func (am *ApplicationManager) getPodApplicationPodTemplate(uid uuid.UUID, name s
 		containers = append(containers, *cont)
 	}
 	pod.Spec.Containers = containers
+	if configMap := util.ApplicationConfigMap(application); configMap != nil {
+		projectApplicationConfig(pod, configMap, util.ApplicationConfigProjection(application))
+	}
 	if standby {
 		pod.Annotations[fornaxv1.AnnotationFornaxCoreHibernatePod] = "hibernate"
 	}
@@ -330,20 +377,122 @@ func (am *ApplicationManager) getPodApplicationPodTemplate(uid uuid.UUID, name s
 	return pod
 }
 
+// projectApplicationConfig add a config map volume into pod and mount it read-only in each container,
+// and/or let containers load config map keys as environment variables, init containers get config in same way
+func projectApplicationConfig(pod *v1.Pod, configMap *v1.ConfigMap, projection fornaxv1.ConfigProjection) {
+	if len(projection.MountPath) > 0 {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name: util.ApplicationConfigVolumeName,
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: v1.LocalObjectReference{Name: configMap.Name},
+				},
+			},
+		})
+	}
+	containers := []*v1.Container{}
+	for i := range pod.Spec.InitContainers {
+		containers = append(containers, &pod.Spec.InitContainers[i])
+	}
+	for i := range pod.Spec.Containers {
+		containers = append(containers, &pod.Spec.Containers[i])
+	}
+	for _, cont := range containers {
+		if len(projection.MountPath) > 0 {
+			cont.VolumeMounts = append(cont.VolumeMounts, v1.VolumeMount{
+				Name:      util.ApplicationConfigVolumeName,
+				ReadOnly:  true,
+				MountPath: projection.MountPath,
+			})
+		}
+		if projection.Env {
+			cont.EnvFrom = append(cont.EnvFrom, v1.EnvFromSource{
+				ConfigMapRef: &v1.ConfigMapEnvSource{
+					LocalObjectReference: v1.LocalObjectReference{Name: configMap.Name},
+				},
+			})
+		}
+	}
+}
+
+// updateApplicationPodConfig push config map to running pods whose config revision reported by node is different from application config revision,
+// pending pods are skipped, they get latest config when they are bound to node, or are pushed when they report back,
+// a pushed config which node does not report back in DefaultPodConfigPushTimeoutDuration is pushed again
+func (am *ApplicationManager) updateApplicationPodConfig(pool *ApplicationPool, application *fornaxv1.Application) error {
+	configMap := util.ApplicationConfigMap(application)
+	if configMap == nil {
+		return nil
+	}
+	revision := util.ApplicationConfigRevision(application)
+	errs := []error{}
+	for _, ap := range append(pool.podListOfState(PodStateIdle), pool.podListOfState(PodStateAllocated)...) {
+		if ap.configRevision == revision {
+			continue
+		}
+		pod := am.podManager.FindPod(ap.podName)
+		if pod == nil || !util.PodIsRunning(pod) {
+			continue
+		}
+		if util.GetPodConfigRevision(pod) == revision {
+			ap.configRevision = revision
+			continue
+		}
+		if ap.pushedConfigRevision == revision && time.Since(ap.configPushTime) < DefaultPodConfigPushTimeoutDuration {
+			continue
+		}
+		klog.InfoS("Push application config to pod", "application", pool.appName, "pod", ap.podName, "revision", revision)
+		if err := am.podManager.UpdatePodConfig(ap.podName, configMap); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ap.pushedConfigRevision = revision
+		ap.configPushTime = time.Now()
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("Failed to push config to some pods, errors=%v", errs)
+	}
+	return nil
+}
+
+// GetPodConfigMap return config map of pod's application, it return nil if pod is not a application pod or application has no config data
+func (am *ApplicationManager) GetPodConfigMap(pod *v1.Pod) (*v1.ConfigMap, error) {
+	applicationKey, found := pod.GetLabels()[fornaxv1.LabelFornaxCoreApplication]
+	if !found {
+		return nil, nil
+	}
+	application, err := factory.GetApplicationCache(am.applicationStore, applicationKey)
+	if err != nil || application == nil {
+		return nil, err
+	}
+	return util.ApplicationConfigMap(application), nil
+}
+
 // given a list pods, pick up which can be deleted with less cost, priority is
-// 1, pods not find in podManager
-// 2, pods still in pending state
-// 3, idle pods
-func (am *ApplicationManager) getPodsToBeDelete(pool *ApplicationPool, numOfDesiredDelete int) []*ApplicationPod {
+// 1, pods created from old application revision
+// 2, pods not find in podManager
+// 3, pods still in pending state
+// 4, idle pods
+func (am *ApplicationManager) getPodsToBeDelete(pool *ApplicationPool, revision string, numOfDesiredDelete int) []*ApplicationPod {
 	podsToDelete := []*ApplicationPod{}
 	candidates := 0
 
+	picked := map[string]bool{}
+	for _, p := range am.getOldRevisionUnallocatedPods(pool, revision) {
+		if candidates == numOfDesiredDelete {
+			return podsToDelete
+		}
+		podsToDelete = append(podsToDelete, p)
+		picked[p.podName] = true
+		candidates += 1
+	}
+
 	pendingPods := pool.podListOfState(PodStatePending)
 	// add pod not yet scheduled
 	for _, p := range pendingPods {
 		pod := am.podManager.FindPod(p.podName)
-		if pod == nil || len(pod.Status.HostIP) == 0 {
+		if !picked[p.podName] && (pod == nil || len(pod.Status.HostIP) == 0) {
 			podsToDelete = append(podsToDelete, p)
+			picked[p.podName] = true
 			candidates += 1
 			if candidates == numOfDesiredDelete {
 				return podsToDelete
@@ -354,8 +503,9 @@ func (am *ApplicationManager) getPodsToBeDelete(pool *ApplicationPool, numOfDesi
 	// add pod not yet scheduled
 	for _, p := range pendingPods {
 		pod := am.podManager.FindPod(p.podName)
-		if pod == nil || len(pod.Status.HostIP) == 0 {
+		if !picked[p.podName] && (pod == nil || len(pod.Status.HostIP) == 0) {
 			podsToDelete = append(podsToDelete, p)
+			picked[p.podName] = true
 			candidates += 1
 			if candidates == numOfDesiredDelete {
 				return podsToDelete
@@ -366,8 +516,9 @@ func (am *ApplicationManager) getPodsToBeDelete(pool *ApplicationPool, numOfDesi
 	// add pod still pending node agent return status
 	for _, p := range pendingPods {
 		pod := am.podManager.FindPod(p.podName)
-		if pod == nil || (len(pod.Status.HostIP) >= 0) {
+		if !picked[p.podName] && (pod == nil || len(pod.Status.HostIP) >= 0) {
 			podsToDelete = append(podsToDelete, p)
+			picked[p.podName] = true
 			candidates += 1
 			if candidates == numOfDesiredDelete {
 				return podsToDelete
@@ -379,8 +530,9 @@ func (am *ApplicationManager) getPodsToBeDelete(pool *ApplicationPool, numOfDesi
 	idlePods := pool.podListOfState(PodStateIdle)
 	for _, p := range idlePods {
 		pod := am.podManager.FindPod(p.podName)
-		if pod == nil || pod.Status.Phase == v1.PodUnknown {
+		if !picked[p.podName] && (pod == nil || pod.Status.Phase == v1.PodUnknown) {
 			podsToDelete = append(podsToDelete, p)
+			picked[p.podName] = true
 			candidates += 1
 			if candidates == numOfDesiredDelete {
 				return podsToDelete
@@ -391,8 +543,9 @@ func (am *ApplicationManager) getPodsToBeDelete(pool *ApplicationPool, numOfDesi
 	// pick any running idle pod
 	for _, p := range idlePods {
 		pod := am.podManager.FindPod(p.podName)
-		if pod == nil || pod.Status.Phase == v1.PodRunning {
+		if !picked[p.podName] && (pod == nil || pod.Status.Phase == v1.PodRunning) {
 			podsToDelete = append(podsToDelete, p)
+			picked[p.podName] = true
 			candidates += 1
 			if candidates == numOfDesiredDelete {
 				return podsToDelete
@@ -413,7 +566,7 @@ func (am *ApplicationManager) deployApplicationPods(pool *ApplicationPool, appli
 	numOfIdlePod := podSummary.idleCount
 	numOfUnAllocatedPod := numOfPendingPod + numOfIdlePod
 	numOfPendingSession := sessionSummary.pendingCount
-	numOfDesiredUnAllocatedPod := am.calculateDesiredIdlePods(application, numOfAllocatedPod, numOfUnAllocatedPod, numOfPendingSession)
+	numOfDesiredUnAllocatedPod := am.calculateDesiredIdlePods(application, numOfAllocatedPod, podSummary.occupiedSessionCount, numOfUnAllocatedPod, numOfPendingSession)
 	numOfDesiredPod = numOfAllocatedPod + numOfDesiredUnAllocatedPod
 
 	// pending session will need pods immediately, the rest of pods can be created as a standby pod
@@ -437,6 +590,9 @@ func (am *ApplicationManager) deployApplicationPods(pool *ApplicationPool, appli
 			pool.addOrUpdatePod(util.Name(pod), PodStatePending, []string{})
 			createdPods += 1
 		}
+		if createdPods > 0 {
+			am.eventRecorder.Eventf(application, v1.EventTypeNormal, event.ReasonApplicationScaleUp, "Created %d pods, desired pods %d, pending sessions %d", createdPods, numOfDesiredPod, numOfPendingSession)
+		}
 		if addition != createdPods {
 			klog.ErrorS(err, "Application failed to create all needed pods", "application", pool.appName, "want", addition, "got", createdPods)
 			err = errors.NewAggregate(createErrors)
@@ -450,12 +606,15 @@ func (am *ApplicationManager) deployApplicationPods(pool *ApplicationPool, appli
 		}
 
 		deleteErrors := []error{}
-		podsToDelete := am.getPodsToBeDelete(pool, desiredSubstraction)
+		podsToDelete := am.getPodsToBeDelete(pool, util.ApplicationRevision(application), desiredSubstraction)
 		for _, ap := range podsToDelete {
 			if err := am.deleteApplicationPod(pool, ap.podName); err != nil {
 				deleteErrors = append(deleteErrors, err)
 			}
 		}
+		if deleted := len(podsToDelete) - len(deleteErrors); deleted > 0 {
+			am.eventRecorder.Eventf(application, v1.EventTypeNormal, event.ReasonApplicationScaleDown, "Deleted %d pods, desired pods %d, pending sessions %d", deleted, numOfDesiredPod, numOfPendingSession)
+		}
 		if len(deleteErrors) > 0 {
 			klog.ErrorS(err, "Application failed to delete some pods", "application", pool.appName, "desiredDelete", desiredSubstraction, "failed", len(deleteErrors))
 			err = errors.NewAggregate(deleteErrors)
@@ -465,6 +624,127 @@ func (am *ApplicationManager) deployApplicationPods(pool *ApplicationPool, appli
 	return numOfDesiredPod, addition, err
 }
 
+// isOldRevisionPod tell if pod was created from a application revision other than given one,
+// pods created before revision label was introduced have no revision, they are adopted as current revision instead of being replaced all at once
+func isOldRevisionPod(pod *v1.Pod, revision string) bool {
+	podRevision := util.GetPodApplicationRevision(pod)
+	return len(podRevision) > 0 && podRevision != revision
+}
+
+// getOldRevisionUnallocatedPods return pending and idle pods which are not created from given application revision,
+// pending pods are returned firstly as they are cheaper to replace
+func (am *ApplicationManager) getOldRevisionUnallocatedPods(pool *ApplicationPool, revision string) []*ApplicationPod {
+	oldPods := []*ApplicationPod{}
+	for _, state := range []ApplicationPodState{PodStatePending, PodStateIdle} {
+		for _, ap := range pool.podListOfState(state) {
+			pod := am.podManager.FindPod(ap.podName)
+			if pod != nil && isOldRevisionPod(pod, revision) {
+				oldPods = append(oldPods, ap)
+			}
+		}
+	}
+	return oldPods
+}
+
+// rollingUpdateApplicationPods replace pods created from old application revision gradually after application spec changed,
+// 1, create new revision pods when unallocated pods are less than desired number plus MaxSurge,
+// 2, delete old revision pods when pending and deleting pods are less than MaxUnavailable,
+// deployApplicationPods prefer old revision pods when it delete surplus pods, so, surge pods eventually replace old ones,
+// allocated pods of old revision are drained, they do not get new session and are replaced when all sessions closed.
+// it return a deployment history when rolling update made progress or finished
+func (am *ApplicationManager) rollingUpdateApplicationPods(pool *ApplicationPool, application *fornaxv1.Application, numOfDesiredPod int) (*fornaxv1.DeploymentHistory, error) {
+	revision := util.ApplicationRevision(application)
+	oldPods := am.getOldRevisionUnallocatedPods(pool, revision)
+	numOfOldAllocatedPod := 0
+	for _, ap := range pool.podListOfState(PodStateAllocated) {
+		pod := am.podManager.FindPod(ap.podName)
+		if pod != nil && isOldRevisionPod(pod, revision) {
+			numOfOldAllocatedPod += 1
+		}
+	}
+
+	if len(oldPods) == 0 && numOfOldAllocatedPod == 0 {
+		if inProgress := getLastRollingUpdateHistory(&application.Status); inProgress != nil && inProgress.DeploymentStatus != fornaxv1.DeploymentStatusSuccess {
+			return &fornaxv1.DeploymentHistory{
+				Action:           fornaxv1.DeploymentActionRollingUpdate,
+				UpdateTime:       *util.NewCurrentMetaTime(),
+				Reason:           "application spec changed",
+				Message:          fmt.Sprintf("all instances are replaced, revision: %s", revision),
+				DeploymentStatus: fornaxv1.DeploymentStatusSuccess,
+			}, nil
+		}
+		return nil, nil
+	}
+
+	_, podSummary := pool.summarySessionAndPods()
+	numOfUnAllocatedPod := podSummary.pendingCount + podSummary.idleCount
+	numOfDesiredUnAllocatedPod := numOfDesiredPod - podSummary.occupiedCount
+	numOfActivePod := podSummary.totalCount - podSummary.deletingCount
+
+	// 1, create surge pods, total pods should not exceed maximum instance
+	surge := numOfDesiredUnAllocatedPod + util.ApplicationRollingUpdateMaxSurge(application) - numOfUnAllocatedPod
+	if surge > len(oldPods) {
+		surge = len(oldPods)
+	}
+	if surge > int(application.Spec.ScalingPolicy.MaximumInstance)-numOfActivePod {
+		surge = int(application.Spec.ScalingPolicy.MaximumInstance) - numOfActivePod
+	}
+	createdPods := 0
+	rolloutErrors := []error{}
+	for i := 0; i < surge; i++ {
+		pod, err := am.createApplicationPod(application)
+		if err != nil {
+			klog.ErrorS(err, "Create pod failed", "application", pool.appName)
+			rolloutErrors = append(rolloutErrors, err)
+			continue
+		}
+		pool.addOrUpdatePod(util.Name(pod), PodStatePending, []string{})
+		createdPods += 1
+	}
+
+	// 2, delete old pods, pending and deleting pods are unavailable
+	unavailable := podSummary.pendingCount + podSummary.deletingCount + createdPods
+	deletion := util.ApplicationRollingUpdateMaxUnavailable(application) - unavailable
+	if deletion > len(oldPods) {
+		deletion = len(oldPods)
+	}
+	deletedPods := 0
+	for i := 0; i < deletion; i++ {
+		if err := am.deleteApplicationPod(pool, oldPods[i].podName); err != nil {
+			rolloutErrors = append(rolloutErrors, err)
+			continue
+		}
+		deletedPods += 1
+	}
+
+	if createdPods == 0 && deletedPods == 0 && len(rolloutErrors) == 0 {
+		return nil, nil
+	}
+
+	klog.InfoS("Rolling update application pods", "application", pool.appName, "revision", revision, "old-unallocated-pods", len(oldPods), "old-allocated-pods", numOfOldAllocatedPod, "created", createdPods, "deleted", deletedPods)
+	history := &fornaxv1.DeploymentHistory{
+		Action:           fornaxv1.DeploymentActionRollingUpdate,
+		UpdateTime:       *util.NewCurrentMetaTime(),
+		Reason:           "application spec changed",
+		Message:          fmt.Sprintf("replace instances of old revision, revision: %s, old unallocated: %d, old allocated: %d, created: %d, deleted: %d", revision, len(oldPods), numOfOldAllocatedPod, createdPods, deletedPods),
+		DeploymentStatus: fornaxv1.DeploymentStatusPartialSuccess,
+	}
+	if len(rolloutErrors) > 0 {
+		history.DeploymentStatus = fornaxv1.DeploymentStatusFailure
+		return history, errors.NewAggregate(rolloutErrors)
+	}
+	return history, nil
+}
+
+func getLastRollingUpdateHistory(status *fornaxv1.ApplicationStatus) *fornaxv1.DeploymentHistory {
+	for i := len(status.History) - 1; i >= 0; i-- {
+		if status.History[i].Action == fornaxv1.DeploymentActionRollingUpdate {
+			return &status.History[i]
+		}
+	}
+	return nil
+}
+
 // getPodApplicationKey returns Application Key of pod using LabelFornaxCoreApplication
 func (am *ApplicationManager) getPodApplicationKey(pod *v1.Pod) (string, error) {
 	if applicationLabel, found := pod.GetLabels()[fornaxv1.LabelFornaxCoreApplication]; !found {
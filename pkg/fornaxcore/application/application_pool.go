@@ -18,6 +18,7 @@ package application
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -30,6 +31,8 @@ type ApplicationPool struct {
 	mu          sync.RWMutex
 	podsByState map[ApplicationPodState]map[string]*ApplicationPod
 	sessions    map[ApplicationSessionState]map[string]*ApplicationSession
+	// latest pod failure reported by node which is not yet recorded in application status
+	podFailure *fornaxv1.DeploymentHistory
 }
 
 func NewApplicationPool(appName string) *ApplicationPool {
@@ -51,12 +54,36 @@ func NewApplicationPool(appName string) *ApplicationPool {
 	}
 }
 
+// setPodFailure remember why a pod failed on node, it's recorded in application status in next sync
+func (pool *ApplicationPool) setPodFailure(reason, message string) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.podFailure = &fornaxv1.DeploymentHistory{
+		Action:           fornaxv1.DeploymentActionCreateInstance,
+		UpdateTime:       *util.NewCurrentMetaTime(),
+		Reason:           reason,
+		Message:          message,
+		DeploymentStatus: fornaxv1.DeploymentStatusFailure,
+	}
+}
+
+// takePodFailure return pod failure not recorded yet and clear it
+func (pool *ApplicationPool) takePodFailure() *fornaxv1.DeploymentHistory {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	failure := pool.podFailure
+	pool.podFailure = nil
+	return failure
+}
+
 type ApplicationPodSummary struct {
 	totalCount    int
 	pendingCount  int
 	deletingCount int
 	idleCount     int
 	occupiedCount int
+	// number of sessions on occupied pods
+	occupiedSessionCount int
 }
 
 func (pool *ApplicationPool) getPodSessions(podName string) []*ApplicationSession {
@@ -156,17 +183,53 @@ func (pool *ApplicationPool) deletePod(podName string) {
 	pool.mu.Unlock()
 }
 
-func (pool *ApplicationPool) getSomeIdlePods(num int) []*ApplicationPod {
+// getSomeAvailablePods return pods accepted by filter which still have free session slots, total free slots of returned pods is at least num if there are enough pods,
+// allocated pods are returned firstly and sorted by number of session on it, then idle pods, so sessions are packed onto partially used pods
+func (pool *ApplicationPool) getSomeAvailablePods(sessionsPerInstance, num int, filter func(*ApplicationPod) bool) []*ApplicationPod {
 	pool.mu.RLock()
 	pods := []*ApplicationPod{}
+	for _, v := range pool.podsByState[PodStateAllocated] {
+		if len(v.sessions) < sessionsPerInstance && filter(v) {
+			pods = append(pods, v)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return len(pods[i].sessions) > len(pods[j].sessions)
+	})
+
+	slots := 0
+	availablePods := []*ApplicationPod{}
+	for _, v := range pods {
+		if slots >= num {
+			break
+		}
+		availablePods = append(availablePods, v)
+		slots += sessionsPerInstance - len(v.sessions)
+	}
 	for _, v := range pool.podsByState[PodStateIdle] {
-		if len(pods) == num {
+		if slots >= num {
 			break
 		}
-		pods = append(pods, v)
+		if !filter(v) {
+			continue
+		}
+		availablePods = append(availablePods, v)
+		slots += sessionsPerInstance
 	}
 	pool.mu.RUnlock()
-	return pods
+	return availablePods
+}
+
+// freeSessionSlots return how many more sessions can be assigned to a pod,
+// only idle and allocated pods can accept session
+func (pool *ApplicationPool) freeSessionSlots(pod *ApplicationPod, sessionsPerInstance int) int {
+	pool.mu.RLock()
+	slots := 0
+	if (pod.state == PodStateIdle || pod.state == PodStateAllocated) && len(pod.sessions) < sessionsPerInstance {
+		slots = sessionsPerInstance - len(pod.sessions)
+	}
+	pool.mu.RUnlock()
+	return slots
 }
 
 func (pool *ApplicationPool) podLength() int {
@@ -244,6 +307,9 @@ func (pool *ApplicationPool) summarySessionAndPods() (ApplicationSessionSummary,
 	psummary.deletingCount = len(pool.podsByState[PodStateDeleting])
 	psummary.occupiedCount = len(pool.podsByState[PodStateAllocated])
 	psummary.idleCount = len(pool.podsByState[PodStateIdle])
+	for _, p := range pool.podsByState[PodStateAllocated] {
+		psummary.occupiedSessionCount += len(p.sessions)
+	}
 	psummary.totalCount = psummary.pendingCount + psummary.deletingCount + psummary.idleCount + psummary.occupiedCount
 	pool.mu.RUnlock()
 	return ssummary, psummary
@@ -283,6 +349,8 @@ func (pool *ApplicationPool) addSession(sessionName string, session *fornaxv1.Ap
 		return
 	}
 
+	now := time.Now()
+	addTime, stateTime := now, now
 	s := pool._getSessionNoLock(sessionName)
 	if s != nil {
 		if pool.sessionStateTransitionAllowed(s.state, newState) {
@@ -291,12 +359,20 @@ func (pool *ApplicationPool) addSession(sessionName string, session *fornaxv1.Ap
 			pool.mu.Unlock()
 			return
 		}
+		addTime = s.addTime
+		if s.state == newState {
+			stateTime = s.stateTime
+		} else {
+			observeSessionOpenDuration(s, newState, now)
+		}
 	}
 
 	// add into pool with new state
 	pool.sessions[newState][sessionName] = &ApplicationSession{
-		session: session,
-		state:   newState,
+		session:   session,
+		state:     newState,
+		addTime:   addTime,
+		stateTime: stateTime,
 	}
 	if podName, found := session.Annotations[fornaxv1.AnnotationFornaxCorePod]; found {
 		pool._addOrUpdatePodNoLock(podName, PodStateAllocated, []string{sessionName})
@@ -24,9 +24,12 @@ import (
 	"time"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/event"
 	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog/v2"
@@ -51,6 +54,9 @@ const (
 type ApplicationSession struct {
 	session *fornaxv1.ApplicationSession
 	state   ApplicationSessionState
+	// when session is added into pool and when it entered current state, used to measure session open latency
+	addTime   time.Time
+	stateTime time.Time
 }
 
 func (am *ApplicationManager) initApplicationSessionInformer(ctx context.Context) error {
@@ -79,8 +85,27 @@ func (am *ApplicationManager) changeSessionStatus(session *fornaxv1.ApplicationS
 	if status == fornaxv1.SessionStatusClosed || status == fornaxv1.SessionStatusTimeout {
 		newStatus.ClientSessions = []v1.LocalObjectReference{}
 	}
+	oldStatus := session.Status.SessionStatus
 	// set local copy status then update store
 	session.Status = *newStatus
+	if err := am.sessionManager.UpdateSessionStatus(session, newStatus); err != nil {
+		return err
+	}
+	if status == fornaxv1.SessionStatusTimeout && oldStatus != fornaxv1.SessionStatusTimeout {
+		am.eventRecorder.Eventf(session, v1.EventTypeWarning, event.ReasonSessionTimeout, "Session timed out in %s status", oldStatus)
+	}
+	return nil
+}
+
+// closeSessionWithReason close a session which node can not close anymore, reason and message are recorded in session status
+func (am *ApplicationManager) closeSessionWithReason(session *fornaxv1.ApplicationSession, reason, message string) error {
+	newStatus := session.Status.DeepCopy()
+	newStatus.SessionStatus = fornaxv1.SessionStatusClosed
+	newStatus.ClientSessions = []v1.LocalObjectReference{}
+	newStatus.CloseTime = util.NewCurrentMetaTime()
+	newStatus.Reason = reason
+	newStatus.Message = message
+	session.Status = *newStatus
 	return am.sessionManager.UpdateSessionStatus(session, newStatus)
 }
 
@@ -172,7 +197,8 @@ func (ps PendingSessions) Swap(i, j int) {
 }
 
 // deployApplicationSessions group session into pending, timeout, deleting states, and
-// 1, assign pending session to idle pods and call OpenSession on choosen pod.
+// 1, assign pending session to pods which have free session slots and call OpenSession on choosen pod,
+// a pod can hold application's SessionsPerInstance sessions, sessions are packed onto allocated pods before using idle pods.
 // session status change in memory to SessionStatusStarting, session is store in node and report back,
 // if fornax core restart and lost these memory state, it rely on pod to report back.
 // 2, It cleanup timeout session which stuck in pending or starting session for more than a timeout duration.
@@ -183,31 +209,39 @@ func (ps PendingSessions) Swap(i, j int) {
 func (am *ApplicationManager) deployApplicationSessions(pool *ApplicationPool, application *fornaxv1.Application) error {
 	pendingSessions, deletingSessions, timeoutSessions := pool.getNonRunningSessions()
 
-	// 1/ assign pending sessions to idle pod
+	// 1/ assign pending sessions to pods which have free session slots, partially used pods are filled firstly
 	// get 5 more in case some pods assigment failed
-	idlePods := pool.getSomeIdlePods(len(pendingSessions) + 5)
+	sessionsPerInstance := util.ApplicationSessionsPerInstance(application)
+	revision := util.ApplicationRevision(application)
+	// pods of old application revision are draining and pods on draining nodes do not get new session,
+	// filter them out before picking pods, otherwise they could take up all picked pods
+	availablePods := pool.getSomeAvailablePods(sessionsPerInstance, len(pendingSessions)+5, func(ap *ApplicationPod) bool {
+		pod := am.podManager.FindPod(ap.podName)
+		return pod == nil || (!isOldRevisionPod(pod, revision) && !am.isPodOnDrainingNode(ap.podName))
+	})
 	klog.V(5).InfoS("Syncing application pending session", "application", pool.appName, "#pending", len(pendingSessions), "#deleting", len(deletingSessions), "#timeout", len(timeoutSessions))
 
 	// sort by creation timestamp to make sure FIFO
 	sort.Sort(PendingSessions(pendingSessions))
 	sessionErrors := []error{}
 	si := 0
-	for _, ap := range idlePods {
+	for _, ap := range availablePods {
 		if si == len(pendingSessions) {
 			// has assigned all pending sesion to pod
 			break
 		}
 		pod := am.podManager.FindPod(ap.podName)
 		if pod != nil {
-			as := pendingSessions[si]
-			klog.V(5).InfoS("Assign session to pod", "application", pool.appName, "pod", util.Name(pod), "session", util.Name(as.session))
-			err := am.assignSessionToPod(pool, pod, as.session)
-			if err != nil {
-				// move to next pod, it could fail to accept other session also
-				klog.ErrorS(err, "Failed to open session on pod", "app", pool.appName, "session", as.session.Name, "pod", util.Name(pod))
-				sessionErrors = append(sessionErrors, err)
-				continue
-			} else {
+			for slots := pool.freeSessionSlots(ap, sessionsPerInstance); slots > 0 && si < len(pendingSessions); slots-- {
+				as := pendingSessions[si]
+				klog.V(5).InfoS("Assign session to pod", "application", pool.appName, "pod", util.Name(pod), "session", util.Name(as.session))
+				err := am.assignSessionToPod(pool, pod, as.session)
+				if err != nil {
+					// move to next pod, it could fail to accept other session also
+					klog.ErrorS(err, "Failed to open session on pod", "app", pool.appName, "session", as.session.Name, "pod", util.Name(pod))
+					sessionErrors = append(sessionErrors, err)
+					break
+				}
 				si += 1
 			}
 		} else {
@@ -290,7 +324,14 @@ func (am *ApplicationManager) deleteApplicationSession(pool *ApplicationPool, s
 }
 
 // call session manager to open session, change sessions status to starting and set access point, update pod to allocated state
-func (am *ApplicationManager) assignSessionToPod(pool *ApplicationPool, pod *v1.Pod, session *fornaxv1.ApplicationSession) error {
+func (am *ApplicationManager) assignSessionToPod(pool *ApplicationPool, pod *v1.Pod, session *fornaxv1.ApplicationSession) (err error) {
+	// continue trace started when api server accepted session
+	ctx := tracing.ContextWithTraceParent(am.ctx, session.Annotations[fornaxv1.AnnotationFornaxCoreTraceParent])
+	ctx, span := tracing.StartSpan(ctx, "ApplicationManager.AssignSessionToPod",
+		attribute.String("session", util.Name(session)),
+		attribute.String("pod", util.Name(pod)))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	newSession := session.DeepCopy()
 	newSession.Status.SessionStatus = fornaxv1.SessionStatusStarting
 	for _, cont := range pod.Spec.Containers {
@@ -308,10 +349,16 @@ func (am *ApplicationManager) assignSessionToPod(pool *ApplicationPool, pod *v1.
 	} else {
 		newSession.Annotations = map[string]string{fornaxv1.AnnotationFornaxCorePod: util.Name(pod)}
 	}
-	if err := am.sessionManager.OpenSession(pod, newSession); err != nil {
+	if _, found := newSession.Annotations[fornaxv1.AnnotationFornaxCoreTraceId]; !found {
+		if traceId := tracing.TraceID(ctx); len(traceId) > 0 {
+			newSession.Annotations[fornaxv1.AnnotationFornaxCoreTraceId] = traceId
+		}
+	}
+	if err := am.sessionManager.OpenSession(ctx, pod, newSession); err != nil {
 		return err
 	} else {
 		updateSessionPool(pool, newSession)
+		am.eventRecorder.Eventf(newSession, v1.EventTypeNormal, event.ReasonSessionAssigned, "Assigned session to pod %s", util.Name(pod))
 		return nil
 	}
 }
@@ -320,9 +367,30 @@ func (am *ApplicationManager) assignSessionToPod(pool *ApplicationPool, pod *v1.
 // in normal cases,session should be closed before pod is terminated and deleted.
 // It update open session to closed and pending session to timedout,
 // and does not try to call node to close session, as session does not exist at all on node when pod terminated on node
-func (am *ApplicationManager) cleanupSessionOnDeletedPod(pool *ApplicationPool, podName string) {
+func (am *ApplicationManager) cleanupSessionOnDeletedPod(pool *ApplicationPool, pod *v1.Pod) {
+	podName := util.Name(pod)
 	podSessions := pool.getPodSessions(podName)
 	for _, sess := range podSessions {
+		if util.PodIsLost(pod) && !util.SessionInTerminalState(sess.session) {
+			// node of pod is lost, session can not be closed gracefully
+			klog.Infof("Time out session %s on lost pod %s", util.Name(sess.session), podName)
+			if err := am.changeSessionStatus(sess.session, fornaxv1.SessionStatusTimeout); err != nil {
+				klog.ErrorS(err, "Failed to time out session on lost pod", "session", util.Name(sess.session))
+				continue
+			}
+			pool.deleteSession(sess.session)
+			continue
+		}
+		if util.PodIsEvicted(pod) && !util.SessionInTerminalState(sess.session) {
+			// node evicted pod under pressure, record eviction reason on session which node did not close
+			klog.Infof("Close session %s on evicted pod %s", util.Name(sess.session), podName)
+			if err := am.closeSessionWithReason(sess.session, pod.Status.Reason, pod.Status.Message); err != nil {
+				klog.ErrorS(err, "Failed to close session on evicted pod", "session", util.Name(sess.session))
+				continue
+			}
+			pool.deleteSession(sess.session)
+			continue
+		}
 		klog.Infof("Delete session %s on deleted pod %s", util.Name(sess.session), podName)
 		am.deleteApplicationSession(pool, sess)
 	}
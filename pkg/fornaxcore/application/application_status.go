@@ -19,6 +19,7 @@ package application
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -170,7 +171,10 @@ func (asm *ApplicationStatusManager) _updateApplicationStatus(applicationKey str
 			application.Status.PendingInstances == newStatus.PendingInstances &&
 			application.Status.DeletingInstances == newStatus.DeletingInstances &&
 			application.Status.AllocatedInstances == newStatus.AllocatedInstances &&
-			application.Status.IdleInstances == newStatus.IdleInstances {
+			application.Status.IdleInstances == newStatus.IdleInstances &&
+			len(application.Status.History) == len(newStatus.History) &&
+			reflect.DeepEqual(application.Status.LatestHistory, newStatus.LatestHistory) &&
+			reflect.DeepEqual(application.Status.Conditions, newStatus.Conditions) {
 			// no change
 			return nil
 		}
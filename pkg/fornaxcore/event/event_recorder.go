@@ -0,0 +1,245 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sort"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	fornaxk8sv1 "centaurusinfra.io/fornax-serverless/pkg/apis/k8s/core/v1"
+	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
+	"centaurusinfra.io/fornax-serverless/pkg/store/factory"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/tools/reference"
+	"k8s.io/klog/v2"
+)
+
+const (
+	DefaultMaxEvents = 10000
+
+	// component name reported in event source
+	FornaxCoreComponent = "fornaxcore"
+
+	eventQueueSize = 1000
+)
+
+// event reasons recorded by fornaxcore managers
+const (
+	ReasonPodScheduled         = "Scheduled"
+	ReasonPodUnschedulable     = "FailedScheduling"
+	ReasonPodFailed            = "Failed"
+	ReasonSessionAssigned      = "SessionAssigned"
+	ReasonSessionTimeout       = "SessionTimeout"
+	ReasonNodeConnected        = "NodeConnected"
+	ReasonNodeDisconnected     = "NodeDisconnected"
+	ReasonApplicationScaleUp   = "ScaleUp"
+	ReasonApplicationScaleDown = "ScaleDown"
+)
+
+// EventPolicy define how many events are kept in event store, oldest events are deleted when there are more
+type EventPolicy struct {
+	MaxEvents int
+}
+
+var _ record.EventRecorder = &eventRecorder{}
+
+// recordedEvent is a event saved in store, repeated events of same object, type, reason and message are aggregated into it
+type recordedEvent struct {
+	event   *v1.Event
+	element *list.Element
+}
+
+// eventRecorder implements record.EventRecorder, it save events into event store which is served as events resource by api server,
+// events are queued and written by one goroutine, events are dropped if queue is full, managers never block on recording events,
+// repeated events increase count and last timestamp of existing event like kubelet event correlator,
+// store is bounded by EventPolicy.MaxEvents, least recently recorded events are deleted firstly
+type eventRecorder struct {
+	ctx        context.Context
+	policy     *EventPolicy
+	eventStore fornaxstore.ApiStorageInterface
+	scheme     *runtime.Scheme
+	events     chan *v1.Event
+	recorded   map[string]*recordedEvent
+	// aggregation keys of recorded events, front is least recently recorded
+	lru *list.List
+}
+
+// NewEventRecorder return a record.EventRecorder saving events of pods, nodes, applications and sessions into event store
+func NewEventRecorder(ctx context.Context, eventStore fornaxstore.ApiStorageInterface, policy *EventPolicy) *eventRecorder {
+	scheme := runtime.NewScheme()
+	v1.AddToScheme(scheme)
+	fornaxv1.AddToScheme(scheme)
+	return &eventRecorder{
+		ctx:        ctx,
+		policy:     policy,
+		eventStore: eventStore,
+		scheme:     scheme,
+		events:     make(chan *v1.Event, eventQueueSize),
+		recorded:   map[string]*recordedEvent{},
+		lru:        list.New(),
+	}
+}
+
+// Run load events already in store and start to save recorded events
+func (r *eventRecorder) Run() {
+	r.loadEvents()
+	go func() {
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case event := <-r.events:
+				r.saveEvent(event)
+			}
+		}
+	}()
+}
+
+// loadEvents track events recovered by event store, so they are aggregated and pruned as recorded events
+func (r *eventRecorder) loadEvents() {
+	events := &v1.EventList{}
+	err := r.eventStore.GetList(r.ctx, fornaxk8sv1.FornaxEventGrvKey, apistorage.ListOptions{Predicate: apistorage.Everything, Recursive: true}, events)
+	if err != nil {
+		klog.ErrorS(err, "Failed to load events from store")
+		return
+	}
+	sort.Slice(events.Items, func(i, j int) bool {
+		return events.Items[i].LastTimestamp.Before(&events.Items[j].LastTimestamp)
+	})
+	for i := range events.Items {
+		event := events.Items[i].DeepCopy()
+		key := aggregateKey(event)
+		if existing, found := r.recorded[key]; found {
+			r.lru.Remove(existing.element)
+		}
+		r.recorded[key] = &recordedEvent{event: event, element: r.lru.PushBack(key)}
+	}
+	r.prune()
+}
+
+func (r *eventRecorder) saveEvent(event *v1.Event) {
+	key := aggregateKey(event)
+	if existing, found := r.recorded[key]; found {
+		updated := existing.event.DeepCopy()
+		updated.Count += 1
+		updated.LastTimestamp = event.LastTimestamp
+		updated.Annotations = event.Annotations
+		if out, err := factory.UpdateFornaxEvent(r.ctx, r.eventStore, updated); err != nil {
+			klog.ErrorS(err, "Failed to update event", "event", util.Name(updated))
+		} else {
+			existing.event = out
+			r.lru.MoveToBack(existing.element)
+			return
+		}
+		// existing event could be deleted by someone else, forget it and create a new one
+		r.lru.Remove(existing.element)
+		delete(r.recorded, key)
+	}
+
+	out, err := factory.CreateFornaxEvent(r.ctx, r.eventStore, event)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create event", "event", util.Name(event), "reason", event.Reason, "message", event.Message)
+		return
+	}
+	r.recorded[key] = &recordedEvent{event: out, element: r.lru.PushBack(key)}
+	r.prune()
+}
+
+// prune delete least recently recorded events until there are no more than max events
+func (r *eventRecorder) prune() {
+	for r.lru.Len() > r.policy.MaxEvents {
+		front := r.lru.Front()
+		key := front.Value.(string)
+		r.lru.Remove(front)
+		if existing, found := r.recorded[key]; found {
+			delete(r.recorded, key)
+			if _, err := factory.DeleteFornaxEvent(r.ctx, r.eventStore, util.Name(existing.event)); err != nil {
+				klog.ErrorS(err, "Failed to delete event", "event", util.Name(existing.event))
+			}
+		}
+	}
+}
+
+// AnnotatedEventf implements record.EventRecorder
+func (r *eventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype string, reason string, messageFmt string, args ...interface{}) {
+	r.generateEvent(object, annotations, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// Event implements record.EventRecorder
+func (r *eventRecorder) Event(object runtime.Object, eventtype string, reason string, message string) {
+	r.generateEvent(object, nil, eventtype, reason, message)
+}
+
+// Eventf implements record.EventRecorder
+func (r *eventRecorder) Eventf(object runtime.Object, eventtype string, reason string, messageFmt string, args ...interface{}) {
+	r.generateEvent(object, nil, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *eventRecorder) generateEvent(object runtime.Object, annotations map[string]string, eventtype, reason, message string) {
+	if eventtype != v1.EventTypeNormal && eventtype != v1.EventTypeWarning {
+		klog.ErrorS(nil, "Unsupported event type", "type", eventtype, "reason", reason)
+		return
+	}
+	ref, err := reference.GetReference(r.scheme, object)
+	if err != nil {
+		klog.ErrorS(err, "Could not construct reference of event object", "reason", reason)
+		return
+	}
+
+	now := metav1.Now()
+	namespace := ref.Namespace
+	if len(namespace) == 0 {
+		namespace = metav1.NamespaceDefault
+	}
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%v.%x", ref.Name, now.UnixNano()),
+			Namespace:   namespace,
+			Annotations: annotations,
+		},
+		InvolvedObject:      *ref,
+		Reason:              reason,
+		Message:             message,
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               1,
+		Type:                eventtype,
+		Source:              v1.EventSource{Component: FornaxCoreComponent},
+		ReportingController: FornaxCoreComponent,
+	}
+
+	select {
+	case r.events <- event:
+	default:
+		klog.InfoS("Event queue is full, drop event", "object", util.Name(object), "reason", reason, "message", message)
+	}
+}
+
+// aggregateKey identify repeated events of a object
+func aggregateKey(event *v1.Event) string {
+	ref := event.InvolvedObject
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s", ref.Kind, ref.Namespace, ref.Name, ref.UID, event.Type, event.Reason, event.Message)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package nodeagent
 
 import (
+	"context"
 	"errors"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
@@ -43,6 +44,7 @@ type NodeAgentClient interface {
 	CreatePod(nodeId string, pod *v1.Pod) error
 	TerminatePod(nodeId string, pod *v1.Pod) error
 	HibernatePod(nodeId string, pod *v1.Pod) error
-	OpenSession(nodeId string, pod *v1.Pod, session *fornaxv1.ApplicationSession) error
+	UpdatePodConfig(nodeId string, pod *v1.Pod, configMap *v1.ConfigMap) error
+	OpenSession(ctx context.Context, nodeId string, pod *v1.Pod, session *fornaxv1.ApplicationSession) error
 	CloseSession(nodeId string, pod *v1.Pod, session *fornaxv1.ApplicationSession) error
 }
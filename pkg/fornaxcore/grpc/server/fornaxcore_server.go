@@ -26,11 +26,14 @@ import (
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	fornaxcore_grpc "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc"
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/metrics"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc/nodeagent"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -56,6 +59,13 @@ type grpcServer struct {
 	nodeIncommingChans      map[string]chan *fornaxcore_grpc.FornaxCoreMessage
 	nodeIncommingChansMutex sync.RWMutex
 	nodeMessageHandlerChans []chan *fornaxcore_grpc.FornaxCoreMessage
+	// standby fornaxcore keep node connections, but does not handle node messages until it become leader
+	leading          bool
+	fornaxCoreConfig *fornaxcore_grpc.FornaxCoreConfiguration
+	// provide config map sent with pod create message
+	podConfigProvider ie.PodConfigProvider
+	// provide secrets sent with pod create message
+	podSecretProvider ie.PodSecretProvider
 }
 
 func (g *grpcServer) RunGrpcServer(ctx context.Context, nodeMonitor ie.NodeMonitorInterface, port int, certFile, keyFile string) error {
@@ -107,7 +117,20 @@ func (g *grpcServer) enlistNode(node string, ch chan<- *fornaxcore_grpc.FornaxCo
 		return fmt.Errorf("node %s already has channel", node)
 	}
 	g.nodeOutgoingChans[node] = ch
+	metrics.ConnectedNodes.Set(float64(len(g.nodeOutgoingChans)))
+	leading, config := g.leading, g.fornaxCoreConfig
 	g.Unlock()
+	if !leading {
+		return nil
+	}
+	return g.onNodeConnect(node, config)
+}
+
+// onNodeConnect tell node fornaxcore configuration, and ask node to full sync if node monitor does not know its latest state
+func (g *grpcServer) onNodeConnect(node string, config *fornaxcore_grpc.FornaxCoreConfiguration) error {
+	if config != nil {
+		g.DispatchNodeMessage(node, NewFornaxCoreConfigurationMessage(config))
+	}
 	err := g.nodeMonitor.OnNodeConnect(node)
 	if err != nil {
 		if err == nodeagent.NodeRevisionOutOfOrderError {
@@ -119,13 +142,42 @@ func (g *grpcServer) enlistNode(node string, ch chan<- *fornaxcore_grpc.FornaxCo
 	return nil
 }
 
+// StartLeading make this fornaxcore serve nodes, it broadcast fornaxcore configuration to connected nodes
+// and ask them to full sync to rebuild nodes, pods and sessions state
+func (g *grpcServer) StartLeading(config *fornaxcore_grpc.FornaxCoreConfiguration) {
+	g.Lock()
+	g.leading = true
+	g.fornaxCoreConfig = config
+	nodes := []string{}
+	for node := range g.nodeOutgoingChans {
+		nodes = append(nodes, node)
+	}
+	g.Unlock()
+
+	klog.InfoS("Fornaxcore start leading, ask connected nodes to full sync", "nodes", len(nodes))
+	for _, node := range nodes {
+		if err := g.onNodeConnect(node, config); err != nil {
+			klog.ErrorS(err, "Failed to take over node", "node", node)
+		}
+	}
+}
+
+func (g *grpcServer) isLeading() bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.leading
+}
+
 func (g *grpcServer) delistNode(node string) {
 	g.Lock()
-	g.nodeMonitor.OnNodeDisconnect(node)
+	if g.leading {
+		g.nodeMonitor.OnNodeDisconnect(node)
+	}
 	if ch, found := g.nodeOutgoingChans[node]; found {
 		delete(g.nodeOutgoingChans, node)
 		close(ch)
 	}
+	metrics.ConnectedNodes.Set(float64(len(g.nodeOutgoingChans)))
 	g.Unlock()
 }
 
@@ -173,6 +225,11 @@ func (g *grpcServer) getNodeMessageHandlerChannel(nodeId string) chan *fornaxcor
 
 // PutMessage send node's message to handler to process message and return
 func (g *grpcServer) PutMessage(ctx context.Context, message *fornaxcore_grpc.FornaxCoreMessage) (*empty.Empty, error) {
+	metrics.GrpcMessages.WithLabelValues(message.GetMessageType().String(), metrics.MessageDirectionReceived).Inc()
+	// node send messages to all fornaxcores, only leader handle them
+	if !g.isLeading() {
+		return &emptypb.Empty{}, nil
+	}
 	messageCh := g.getNodeMessageHandlerChannel(message.GetNodeIdentifier().GetIdentifier())
 	messageCh <- message
 	return &emptypb.Empty{}, nil
@@ -225,15 +282,46 @@ func NewGrpcServer() *grpcServer {
 	}
 }
 
-// CreatePod dispatch a PodCreate grpc message to node agent
+// SetPodConfigProvider set provider of config map which is sent to node agent when creating a pod
+func (g *grpcServer) SetPodConfigProvider(provider ie.PodConfigProvider) {
+	g.podConfigProvider = provider
+}
+
+// SetPodSecretProvider set provider of secrets which are sent to node agent when creating a pod
+func (g *grpcServer) SetPodSecretProvider(provider ie.PodSecretProvider) {
+	g.podSecretProvider = provider
+}
+
+// CreatePod dispatch a PodCreate grpc message to node agent, pod config map and secrets referenced by pod are sent together
 func (g *grpcServer) CreatePod(nodeIdentifier string, pod *v1.Pod) error {
 	podIdentifier := util.Name(pod)
+	configMap := &v1.ConfigMap{}
+	if g.podConfigProvider != nil {
+		cm, err := g.podConfigProvider.GetPodConfigMap(pod)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get pod config map", "pod", podIdentifier)
+			return err
+		}
+		if cm != nil {
+			configMap = cm
+		}
+	}
+	secrets := []*v1.Secret{}
+	if g.podSecretProvider != nil {
+		s, err := g.podSecretProvider.GetPodSecrets(pod)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get pod secrets", "pod", podIdentifier)
+			return err
+		}
+		secrets = s
+	}
 	messageType := fornaxcore_grpc.MessageType_POD_CREATE
 	podCreate := fornaxcore_grpc.FornaxCoreMessage_PodCreate{
 		PodCreate: &fornaxcore_grpc.PodCreate{
 			PodIdentifier: podIdentifier,
 			Pod:           pod.DeepCopy(),
-			ConfigMap:     &v1.ConfigMap{},
+			ConfigMap:     configMap,
+			Secrets:       secrets,
 		},
 	}
 	m := &fornaxcore_grpc.FornaxCoreMessage{
@@ -293,6 +381,29 @@ func (g *grpcServer) HibernatePod(nodeIdentifier string, pod *v1.Pod) error {
 	return nil
 }
 
+// UpdatePodConfig dispatch a PodConfigUpdate grpc message to node agent
+func (g *grpcServer) UpdatePodConfig(nodeIdentifier string, pod *v1.Pod, configMap *v1.ConfigMap) error {
+	podIdentifier := util.Name(pod)
+	messageType := fornaxcore_grpc.MessageType_POD_CONFIG_UPDATE
+	podConfigUpdate := fornaxcore_grpc.FornaxCoreMessage_PodConfigUpdate{
+		PodConfigUpdate: &fornaxcore_grpc.PodConfigUpdate{
+			PodIdentifier: podIdentifier,
+			ConfigMap:     configMap.DeepCopy(),
+		},
+	}
+	m := &fornaxcore_grpc.FornaxCoreMessage{
+		MessageType: messageType,
+		MessageBody: &podConfigUpdate,
+	}
+
+	err := g.DispatchNodeMessage(nodeIdentifier, m)
+	if err != nil {
+		klog.ErrorS(err, "Failed to dispatch pod config update message to node", "node", nodeIdentifier, "pod", util.Name(pod))
+		return err
+	}
+	return nil
+}
+
 // CloseSession dispatch a SessionClose event to node agent
 func (g *grpcServer) CloseSession(nodeIdentifier string, pod *v1.Pod, session *fornaxv1.ApplicationSession) error {
 	sessionIdentifier := util.Name(session)
@@ -319,8 +430,11 @@ func (g *grpcServer) CloseSession(nodeIdentifier string, pod *v1.Pod, session *f
 }
 
 // OpenSession implements FornaxCoreServer
-func (g *grpcServer) OpenSession(nodeIdentifier string, pod *v1.Pod, session *fornaxv1.ApplicationSession) error {
-	// OpenSession dispatch a SessionOpen event to node agent
+func (g *grpcServer) OpenSession(ctx context.Context, nodeIdentifier string, pod *v1.Pod, session *fornaxv1.ApplicationSession) (err error) {
+	// OpenSession dispatch a SessionOpen event to node agent, node agent continue trace using trace context in message
+	ctx, span := tracing.StartSpan(ctx, "FornaxCore.DispatchSessionOpen", attribute.String("node", nodeIdentifier))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	sessionIdentifier := util.Name(session)
 	podIdentifier := util.Name(pod)
 	messageType := fornaxcore_grpc.MessageType_SESSION_OPEN
@@ -332,11 +446,12 @@ func (g *grpcServer) OpenSession(nodeIdentifier string, pod *v1.Pod, session *fo
 		},
 	}
 	m := &fornaxcore_grpc.FornaxCoreMessage{
-		MessageType: messageType,
-		MessageBody: &body,
+		MessageType:  messageType,
+		MessageBody:  &body,
+		TraceContext: tracing.InjectTraceContext(ctx),
 	}
 
-	err := g.DispatchNodeMessage(nodeIdentifier, m)
+	err = g.DispatchNodeMessage(nodeIdentifier, m)
 	if err != nil {
 		klog.ErrorS(err, "Failed to dispatch message to node", "node", nodeIdentifier, "session", sessionIdentifier)
 		return err
@@ -358,6 +473,17 @@ func (g *grpcServer) FullSyncNode(nodeIdentifier string) error {
 	return nil
 }
 
+func NewFornaxCoreConfigurationMessage(config *fornaxcore_grpc.FornaxCoreConfiguration) *fornaxcore_grpc.FornaxCoreMessage {
+	msg := fornaxcore_grpc.FornaxCoreMessage_FornaxCoreConfiguration{
+		FornaxCoreConfiguration: config,
+	}
+	messageType := fornaxcore_grpc.MessageType_FORNAX_CORE_CONFIGURATION
+	return &fornaxcore_grpc.FornaxCoreMessage{
+		MessageType: messageType,
+		MessageBody: &msg,
+	}
+}
+
 func NewFullSyncRequest() *fornaxcore_grpc.FornaxCoreMessage {
 	msg := fornaxcore_grpc.FornaxCoreMessage_NodeFullSync{
 		NodeFullSync: &fornaxcore_grpc.NodeFullSync{},
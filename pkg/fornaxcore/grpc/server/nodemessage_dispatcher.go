@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/metrics"
 )
 
 func (g *grpcServer) getNodeChan(nodeIdentifer string) (chan<- *grpc.FornaxCoreMessage, error) {
@@ -51,6 +52,7 @@ func (g *grpcServer) DispatchNodeMessage(nodeIdentifier string, message *grpc.Fo
 		ch, err = g.getNodeChan(nodeIdentifier)
 		if err == nil && ch != nil {
 			ch <- message
+			metrics.GrpcMessages.WithLabelValues(message.GetMessageType().String(), metrics.MessageDirectionSent).Inc()
 		}
 	}()
 	return err
@@ -18,6 +18,7 @@ package internal
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
@@ -33,26 +34,100 @@ type PodManagerInterface interface {
 	DeletePod(pod *v1.Pod) (*v1.Pod, error)
 	TerminatePod(podName string) error
 	HibernatePod(podName string) error
+	UpdatePodConfig(podName string, configMap *v1.ConfigMap) error
 	FindPod(podName string) *v1.Pod
 	Watch(watcher chan<- *PodEvent)
 }
 
+// PodConfigProvider return config map which is sent to node agent when a pod is created on node
+type PodConfigProvider interface {
+	GetPodConfigMap(pod *v1.Pod) (*v1.ConfigMap, error)
+}
+
+// PodSecretProvider return secrets referenced by a pod, they are only sent to node agent which create this pod
+type PodSecretProvider interface {
+	GetPodSecrets(pod *v1.Pod) ([]*v1.Secret, error)
+}
+
 type NodeWorkingState string
 
 const (
 	NodeWorkingStateRegistering  NodeWorkingState = "registering"
 	NodeWorkingStateDisconnected NodeWorkingState = "disconnected"
 	NodeWorkingStateRunning      NodeWorkingState = "running"
+	NodeWorkingStateNotReady     NodeWorkingState = "notready"
 )
 
 type FornaxNodeWithState struct {
+	// mu guard node, lastSeen, state and daemonPods, they are changed by node grpc handlers, lease monitor and node store watcher concurrently
+	mu         sync.RWMutex
+	node       *v1.Node
+	lastSeen   time.Time
+	state      NodeWorkingState
+	daemonPods map[string]*v1.Pod
 	NodeId     string
 	Revision   string
-	Node       *v1.Node
-	State      NodeWorkingState
 	Pods       *collection.ConcurrentStringSet
-	DaemonPods map[string]*v1.Pod
-	LastSeen   time.Time
+	// LostPods are pods failed over when node stopped heartbeating, they are terminated if node report them again
+	LostPods *collection.ConcurrentStringSet
+}
+
+// GetNode return latest node object, caller should not modify it
+func (n *FornaxNodeWithState) GetNode() *v1.Node {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.node
+}
+
+// SetNode replace node object with a newer one
+func (n *FornaxNodeWithState) SetNode(node *v1.Node) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.node = node
+}
+
+// GetState return node working state
+func (n *FornaxNodeWithState) GetState() NodeWorkingState {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.state
+}
+
+// SetState change node working state and return previous state
+func (n *FornaxNodeWithState) SetState(state NodeWorkingState) NodeWorkingState {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	prevState := n.state
+	n.state = state
+	return prevState
+}
+
+// GetDaemonPods return daemon pods should run on node, caller should not modify it
+func (n *FornaxNodeWithState) GetDaemonPods() map[string]*v1.Pod {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.daemonPods
+}
+
+// SetDaemonPods replace daemon pods should run on node
+func (n *FornaxNodeWithState) SetDaemonPods(daemonPods map[string]*v1.Pod) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.daemonPods = daemonPods
+}
+
+// RenewLease record node sent a message just now
+func (n *FornaxNodeWithState) RenewLease() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.lastSeen = time.Now()
+}
+
+// GetLastSeen return when node sent last message
+func (n *FornaxNodeWithState) GetLastSeen() time.Time {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.lastSeen
 }
 
 type NodeManagerInterface interface {
@@ -69,7 +144,7 @@ type NodeManagerInterface interface {
 type SessionManagerInterface interface {
 	UpdateSessionStatus(session *fornaxv1.ApplicationSession, newStatus *fornaxv1.ApplicationSessionStatus) error
 	OnSessionStatusFromNode(pod *v1.Pod, session *fornaxv1.ApplicationSession) error
-	OpenSession(pod *v1.Pod, session *fornaxv1.ApplicationSession) error
+	OpenSession(ctx context.Context, pod *v1.Pod, session *fornaxv1.ApplicationSession) error
 	CloseSession(pod *v1.Pod, session *fornaxv1.ApplicationSession) error
 	Watch(ctx context.Context) (<-chan fornaxstore.WatchEventWithOldObj, error)
 }
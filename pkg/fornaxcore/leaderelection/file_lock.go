@@ -0,0 +1,100 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// FileLock is a exclusive advisory lock on a file, lock is released by kernel when holder process exit,
+// it only work for processes on same host
+type FileLock struct {
+	path string
+	file *os.File
+}
+
+// TryLock acquire lock without blocking, it return false if lock is held by another process
+func (l *FileLock) TryLock() (bool, error) {
+	if l.file != nil {
+		return true, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return false, err
+	}
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return false, err
+	}
+	if err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return false, nil
+		}
+		return false, err
+	}
+	l.file = file
+	return true, nil
+}
+
+// SetHolder write lock holder identity into lock file, so others know who hold lock
+func (l *FileLock) SetHolder(holder string) error {
+	if l.file == nil {
+		return errors.New("file lock is not held")
+	}
+	if err := l.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := l.file.WriteAt([]byte(holder), 0); err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
+
+// GetHolder read lock holder identity from lock file
+func (l *FileLock) GetHolder() (string, error) {
+	bytes, err := os.ReadFile(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(bytes)), nil
+}
+
+func (l *FileLock) Unlock() error {
+	if l.file == nil {
+		return nil
+	}
+	file := l.file
+	l.file = nil
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_UN); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func NewFileLock(path string) *FileLock {
+	return &FileLock{
+		path: path,
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	fornaxgrpc "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc"
+	"k8s.io/klog/v2"
+)
+
+const (
+	DefaultLeaderElectionRetryPeriod = 2 * time.Second
+)
+
+// LeaderElectionPolicy define how fornaxcore instances compete to be the leader,
+// only leader serve node agents, standbys take over when leader is gone
+type LeaderElectionPolicy struct {
+	// file lock shared by fornaxcores, leader election is disabled if it's empty, fornaxcore is leader always
+	LockFile string
+	// how often a standby try to acquire lock
+	RetryPeriod time.Duration
+	// grpc endpoint node agents use to connect this fornaxcore, format is ip:port
+	AdvertiseAddress string
+	// grpc endpoints of all fornaxcores, format is ip:port
+	Peers []string
+}
+
+type LeaderElector struct {
+	policy  *LeaderElectionPolicy
+	lock    *FileLock
+	leading int32
+	// identity of current leader read from lock file, it's empty if unknown
+	leader atomic.Value
+}
+
+// Run block until this fornaxcore become leader or ctx is done, onStartedLeading is called when it become leader,
+// leadership is held until process exit, as file lock is released by kernel only when process is gone
+func (le *LeaderElector) Run(ctx context.Context, onStartedLeading func(ctx context.Context)) {
+	if len(le.policy.LockFile) == 0 {
+		klog.InfoS("Leader election is disabled, start leading")
+		atomic.StoreInt32(&le.leading, 1)
+		onStartedLeading(ctx)
+		return
+	}
+
+	// policy is filled by command line flags after elector is created
+	le.lock = NewFileLock(le.policy.LockFile)
+	retryPeriod := le.policy.RetryPeriod
+	if retryPeriod <= 0 {
+		retryPeriod = DefaultLeaderElectionRetryPeriod
+	}
+	ticker := time.NewTicker(retryPeriod)
+	defer ticker.Stop()
+	leader := ""
+	for {
+		acquired, err := le.lock.TryLock()
+		if err != nil {
+			klog.ErrorS(err, "Failed to acquire leader lock", "lock", le.policy.LockFile)
+		}
+		if acquired {
+			klog.InfoS("Acquired leader lock, start leading", "lock", le.policy.LockFile, "identity", le.policy.AdvertiseAddress)
+			if err := le.lock.SetHolder(le.policy.AdvertiseAddress); err != nil {
+				klog.ErrorS(err, "Failed to write leader identity into leader lock", "lock", le.policy.LockFile)
+			}
+			le.leader.Store(le.policy.AdvertiseAddress)
+			atomic.StoreInt32(&le.leading, 1)
+			onStartedLeading(ctx)
+			return
+		}
+		if holder, err := le.lock.GetHolder(); err == nil && holder != leader {
+			leader = holder
+			le.leader.Store(holder)
+			klog.InfoS("Leader lock is held by another fornaxcore, stay standby", "lock", le.policy.LockFile, "leader", leader)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (le *LeaderElector) IsLeader() bool {
+	return atomic.LoadInt32(&le.leading) == 1
+}
+
+// Leader return identity of current leader, it's empty if leader is unknown yet
+func (le *LeaderElector) Leader() string {
+	if leader, ok := le.leader.Load().(string); ok {
+		return leader
+	}
+	return ""
+}
+
+// FornaxCoreConfiguration return fornaxcore configuration nodes use to connect fornaxcores,
+// this fornaxcore is primary, other peers are standbys, it return nil if advertise address is not set
+func (le *LeaderElector) FornaxCoreConfiguration() *fornaxgrpc.FornaxCoreConfiguration {
+	if len(le.policy.AdvertiseAddress) == 0 {
+		return nil
+	}
+	config := &fornaxgrpc.FornaxCoreConfiguration{
+		Primary: &fornaxgrpc.FornaxCore{
+			Ip:         le.policy.AdvertiseAddress,
+			Identifier: le.policy.AdvertiseAddress,
+		},
+		Standbys: []*fornaxgrpc.FornaxCore{},
+	}
+	for _, peer := range le.policy.Peers {
+		if peer == le.policy.AdvertiseAddress {
+			continue
+		}
+		config.Standbys = append(config.Standbys, &fornaxgrpc.FornaxCore{
+			Ip:         peer,
+			Identifier: peer,
+		})
+	}
+	return config
+}
+
+func NewLeaderElector(policy *LeaderElectionPolicy) *LeaderElector {
+	return &LeaderElector{
+		policy: policy,
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const (
+	// how many seconds client should wait before retry a write rejected by standby
+	StandbyRetryAfterSeconds = 5
+)
+
+var mutatingVerbs = sets.NewString("create", "update", "patch", "delete", "deletecollection")
+
+// WithStandbyReadonly reject resource writes when this fornaxcore is not leader,
+// standby has its own memory stores which are not synced with leader, writes accepted by standby are never seen by leader,
+// reads are still served, they could be stale, clients should send writes to leader returned in error message,
+// it must be wrapped by request info filter, e.g. in BuildHandlerChainFunc before calling DefaultBuildHandlerChain
+func (le *LeaderElector) WithStandbyReadonly(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if le.IsLeader() {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		info, found := genericapirequest.RequestInfoFrom(req.Context())
+		if !found || !info.IsResourceRequest || !mutatingVerbs.Has(info.Verb) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		message := "fornaxcore is standby, leader is unknown yet"
+		if leader := le.Leader(); len(leader) > 0 {
+			message = fmt.Sprintf("fornaxcore is standby, send request to leader %s", leader)
+		}
+		status := apierrors.NewServiceUnavailable(message).Status()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", fmt.Sprint(StandbyRetryAfterSeconds))
+		w.WriteHeader(int(status.Code))
+		json.NewEncoder(w).Encode(status)
+	})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	namespace = "fornaxcore"
+
+	SessionPhasePending  = "pending"  // session created until it's assigned to a pod
+	SessionPhaseStarting = "starting" // session assigned until pod report it's available
+	SessionPhaseTotal    = "total"    // session created until it's available
+
+	ScheduleResultScheduled     = "scheduled"
+	ScheduleResultUnschedulable = "unschedulable"
+
+	ScheduleQueueActive  = "active"
+	ScheduleQueueBackoff = "backoff"
+
+	MessageDirectionReceived = "received"
+	MessageDirectionSent     = "sent"
+)
+
+var (
+	SessionOpenDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Namespace:      namespace,
+			Subsystem:      "session",
+			Name:           "open_duration_seconds",
+			Help:           "Latency of opening a session in seconds, split by phase pending, starting and total",
+			Buckets:        metrics.ExponentialBuckets(0.001, 2, 16),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"phase"},
+	)
+
+	ScheduleDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Namespace:      namespace,
+			Subsystem:      "scheduler",
+			Name:           "schedule_duration_seconds",
+			Help:           "Latency of a pod schedule attempt in seconds, including trying all node groups",
+			Buckets:        metrics.ExponentialBuckets(0.0001, 2, 16),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"result"},
+	)
+
+	UnschedulablePods = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      namespace,
+			Subsystem:      "scheduler",
+			Name:           "unschedulable_pods_total",
+			Help:           "Number of pod schedule attempts which did not find a node, by reason",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+
+	ScheduleQueuePods = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      namespace,
+			Subsystem:      "scheduler",
+			Name:           "queue_pods",
+			Help:           "Number of pods waiting in scheduler active and backoff queue",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"queue"},
+	)
+
+	ApplicationPods = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      namespace,
+			Subsystem:      "application",
+			Name:           "pods",
+			Help:           "Number of pods of an application by state",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"application", "state"},
+	)
+
+	ApplicationSessions = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      namespace,
+			Subsystem:      "application",
+			Name:           "sessions",
+			Help:           "Number of sessions of an application by state",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"application", "state"},
+	)
+
+	ConnectedNodes = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      namespace,
+			Subsystem:      "grpc",
+			Name:           "connected_nodes",
+			Help:           "Number of node agents connected to fornaxcore grpc server",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	GrpcMessages = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      namespace,
+			Subsystem:      "grpc",
+			Name:           "messages_total",
+			Help:           "Number of grpc messages received from and sent to node agents by message type",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"message_type", "direction"},
+	)
+)
+
+var registerMetrics sync.Once
+
+// Register register fornaxcore metrics into legacy registry which is served by api server /metrics endpoint
+func Register() {
+	registerMetrics.Do(func() {
+		legacyregistry.MustRegister(SessionOpenDuration)
+		legacyregistry.MustRegister(ScheduleDuration)
+		legacyregistry.MustRegister(UnschedulablePods)
+		legacyregistry.MustRegister(ScheduleQueuePods)
+		legacyregistry.MustRegister(ApplicationPods)
+		legacyregistry.MustRegister(ApplicationSessions)
+		legacyregistry.MustRegister(ConnectedNodes)
+		legacyregistry.MustRegister(GrpcMessages)
+	})
+}
+
+// SinceInSeconds gets the time since the specified start in seconds
+func SinceInSeconds(start time.Time) float64 {
+	return time.Since(start).Seconds()
+}
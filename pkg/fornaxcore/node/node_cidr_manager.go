@@ -16,21 +16,419 @@ limitations under the License.
 
 package node
 
-import v1 "k8s.io/api/core/v1"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	DefaultClusterCIDR             = "192.168.0.0/16"
+	DefaultNodeCIDRMaskSizeIPv4    = 24
+	DefaultNodeCIDRMaskSizeIPv6    = 64
+	DefaultNodeCidrAllocationFile  = "/var/lib/fornaxcore/node_cidrs.json"
+	DefaultNodeCidrReleaseTimeout  = 1 * time.Hour
+	MaxNodeCIDRMaskSizeDifference  = 16
+	nodeCidrAllocationFileTempName = ".node_cidrs.json.tmp"
+)
+
+var (
+	NodeCidrExhaustedError = errors.New("no pod cidr available in cluster cidr")
+	NodeCidrConflictError  = errors.New("pod cidr is allocated to another node")
+)
+
+// NodeCidrPolicy define cluster cidrs node pod cidrs are carved from, at most one ipv4 and one ipv6 cluster cidr are allowed,
+// node get one pod cidr from each cluster cidr, allocations are saved in AllocationFile and reloaded when fornaxcore restart,
+// pod cidrs of a node which has not heartbeated for ReleaseTimeout are released
+type NodeCidrPolicy struct {
+	ClusterCIDRs         []string
+	NodeCIDRMaskSizeIPv4 int
+	NodeCIDRMaskSizeIPv6 int
+	AllocationFile       string
+	ReleaseTimeout       time.Duration
+}
+
+// Validate check cluster cidrs and node cidr mask sizes, fornaxcore should not start with a policy no node can get pod cidrs from
+func (policy *NodeCidrPolicy) Validate() error {
+	_, err := policy.buildCidrSets()
+	return err
+}
+
+func (policy *NodeCidrPolicy) buildCidrSets() ([]*cidrSet, error) {
+	clusterCIDRs := policy.ClusterCIDRs
+	if len(clusterCIDRs) == 0 {
+		clusterCIDRs = []string{DefaultClusterCIDR}
+	}
+	hasIPv4, hasIPv6 := false, false
+	cidrSets := []*cidrSet{}
+	for _, v := range clusterCIDRs {
+		_, clusterCIDR, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("cluster cidr %s is not valid, %v", v, err)
+		}
+		nodeMaskSize := policy.NodeCIDRMaskSizeIPv4
+		if nodeMaskSize == 0 {
+			nodeMaskSize = DefaultNodeCIDRMaskSizeIPv4
+		}
+		if clusterCIDR.IP.To4() == nil {
+			if hasIPv6 {
+				return nil, fmt.Errorf("only one ipv6 cluster cidr is allowed, %v", clusterCIDRs)
+			}
+			hasIPv6 = true
+			nodeMaskSize = policy.NodeCIDRMaskSizeIPv6
+			if nodeMaskSize == 0 {
+				nodeMaskSize = DefaultNodeCIDRMaskSizeIPv6
+			}
+		} else {
+			if hasIPv4 {
+				return nil, fmt.Errorf("only one ipv4 cluster cidr is allowed, %v", clusterCIDRs)
+			}
+			hasIPv4 = true
+		}
+		set, err := newCidrSet(clusterCIDR, nodeMaskSize)
+		if err != nil {
+			return nil, err
+		}
+		cidrSets = append(cidrSets, set)
+	}
+	return cidrSets, nil
+}
+
+func (policy *NodeCidrPolicy) releaseTimeout() time.Duration {
+	if policy.ReleaseTimeout <= 0 {
+		return DefaultNodeCidrReleaseTimeout
+	}
+	return policy.ReleaseTimeout
+}
 
 type NodeCidrManager interface {
-	GetCidr(node *v1.Node) []string
+	GetCidr(node *v1.Node) ([]string, error)
+	ReleaseCidr(nodeName string)
+	// ReleaseStaleCidrs release pod cidrs of nodes which are not active for release timeout, return names of released nodes
+	ReleaseStaleCidrs(activeNodes []string) []string
 }
 
 var _ NodeCidrManager = &nodeCidrManager{}
 
+// cidrSet track allocated node cidrs of a cluster cidr, node cidr is identified by its index in cluster cidr
+type cidrSet struct {
+	clusterCIDR   *net.IPNet
+	nodeMaskSize  int
+	maxCIDRs      int
+	nextCandidate int
+	used          map[int]string
+}
+
+func newCidrSet(clusterCIDR *net.IPNet, nodeMaskSize int) (*cidrSet, error) {
+	if ip := clusterCIDR.IP.To4(); ip != nil {
+		clusterCIDR.IP = ip
+	}
+	clusterMaskSize, bits := clusterCIDR.Mask.Size()
+	if nodeMaskSize < clusterMaskSize || nodeMaskSize > bits {
+		return nil, fmt.Errorf("node cidr mask size %d is not valid for cluster cidr %s", nodeMaskSize, clusterCIDR.String())
+	}
+	if nodeMaskSize-clusterMaskSize > MaxNodeCIDRMaskSizeDifference {
+		return nil, fmt.Errorf("node cidr mask size %d is too large for cluster cidr %s, difference must be no more than %d", nodeMaskSize, clusterCIDR.String(), MaxNodeCIDRMaskSizeDifference)
+	}
+	return &cidrSet{
+		clusterCIDR:  clusterCIDR,
+		nodeMaskSize: nodeMaskSize,
+		maxCIDRs:     1 << uint(nodeMaskSize-clusterMaskSize),
+		used:         map[int]string{},
+	}, nil
+}
+
+func (s *cidrSet) isIPv6() bool {
+	return s.clusterCIDR.IP.To4() == nil
+}
+
+func (s *cidrSet) indexToCidr(index int) *net.IPNet {
+	_, bits := s.clusterCIDR.Mask.Size()
+	ipInt := big.NewInt(0).SetBytes(s.clusterCIDR.IP)
+	ipInt.Add(ipInt, big.NewInt(0).Lsh(big.NewInt(int64(index)), uint(bits-s.nodeMaskSize)))
+	ipBytes := ipInt.Bytes()
+	ip := make(net.IP, bits/8)
+	copy(ip[len(ip)-len(ipBytes):], ipBytes)
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(s.nodeMaskSize, bits)}
+}
+
+func (s *cidrSet) cidrToIndex(cidr *net.IPNet) (int, error) {
+	clusterIP := s.clusterCIDR.IP
+	ip := cidr.IP.To16()
+	if !s.isIPv6() {
+		ip = cidr.IP.To4()
+	}
+	maskSize, bits := cidr.Mask.Size()
+	_, clusterBits := s.clusterCIDR.Mask.Size()
+	if ip == nil || bits != clusterBits || maskSize != s.nodeMaskSize || !s.clusterCIDR.Contains(ip) {
+		return 0, fmt.Errorf("cidr %s is not a node cidr of cluster cidr %s", cidr.String(), s.clusterCIDR.String())
+	}
+	offset := big.NewInt(0).Sub(big.NewInt(0).SetBytes(ip), big.NewInt(0).SetBytes(clusterIP))
+	offset.Rsh(offset, uint(bits-s.nodeMaskSize))
+	return int(offset.Int64()), nil
+}
+
+func (s *cidrSet) allocate(nodeName string) (string, error) {
+	for i := 0; i < s.maxCIDRs; i++ {
+		index := (s.nextCandidate + i) % s.maxCIDRs
+		if _, found := s.used[index]; !found {
+			s.used[index] = nodeName
+			s.nextCandidate = (index + 1) % s.maxCIDRs
+			return s.indexToCidr(index).String(), nil
+		}
+	}
+	return "", NodeCidrExhaustedError
+}
+
+func (s *cidrSet) occupy(cidr *net.IPNet, nodeName string) error {
+	index, err := s.cidrToIndex(cidr)
+	if err != nil {
+		return err
+	}
+	if owner, found := s.used[index]; found && owner != nodeName {
+		return NodeCidrConflictError
+	}
+	s.used[index] = nodeName
+	return nil
+}
+
+func (s *cidrSet) release(cidr *net.IPNet) {
+	if index, err := s.cidrToIndex(cidr); err == nil {
+		delete(s.used, index)
+	}
+}
+
+// nodeCidrManager allocate pod cidrs for nodes from cluster cidrs,
+// it's initialized when first node come since policy is filled by command line flags after node manager is created
 type nodeCidrManager struct {
+	mu          sync.Mutex
+	policy      *NodeCidrPolicy
+	initialized bool
+	cidrSets    []*cidrSet
+	allocations map[string][]string
+	// last time node of an allocation was active, loaded allocations are taken as active at load time
+	activeTime map[string]time.Time
+}
+
+func (cm *nodeCidrManager) init() error {
+	if cm.initialized {
+		return nil
+	}
+	cidrSets, err := cm.policy.buildCidrSets()
+	if err != nil {
+		return err
+	}
+	cm.cidrSets = cidrSets
+	cm.initialized = true
+	cm.load()
+	return nil
+}
+
+// load read saved allocations, allocations which do not fit current cluster cidrs are dropped
+func (cm *nodeCidrManager) load() {
+	if len(cm.policy.AllocationFile) == 0 {
+		return
+	}
+	data, err := os.ReadFile(cm.policy.AllocationFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			klog.ErrorS(err, "Failed to read node cidr allocations", "file", cm.policy.AllocationFile)
+		}
+		return
+	}
+	allocations := map[string][]string{}
+	if err := json.Unmarshal(data, &allocations); err != nil {
+		klog.ErrorS(err, "Failed to parse node cidr allocations", "file", cm.policy.AllocationFile)
+		return
+	}
+	for nodeName, cidrs := range allocations {
+		validCidrs := []string{}
+		for _, v := range cidrs {
+			if err := cm.occupy(v, nodeName); err != nil {
+				klog.Warningf("Drop saved pod cidr %s of node %s, %v", v, nodeName, err)
+				continue
+			}
+			validCidrs = append(validCidrs, v)
+		}
+		if len(validCidrs) == len(cm.cidrSets) {
+			cm.allocations[nodeName] = validCidrs
+			cm.activeTime[nodeName] = time.Now()
+		} else {
+			cm.releaseCidrs(validCidrs)
+		}
+	}
+	klog.InfoS("Loaded node cidr allocations", "file", cm.policy.AllocationFile, "#node", len(cm.allocations))
+}
+
+// save write allocations into a temp file then rename it to avoid a partially written file
+func (cm *nodeCidrManager) save() {
+	if len(cm.policy.AllocationFile) == 0 {
+		return
+	}
+	data, err := json.Marshal(cm.allocations)
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal node cidr allocations")
+		return
+	}
+	dir := filepath.Dir(cm.policy.AllocationFile)
+	if err = os.MkdirAll(dir, os.FileMode(0755)); err != nil {
+		klog.ErrorS(err, "Failed to create node cidr allocation dir", "dir", dir)
+		return
+	}
+	tempFile := filepath.Join(dir, nodeCidrAllocationFileTempName)
+	if err = os.WriteFile(tempFile, data, os.FileMode(0644)); err != nil {
+		klog.ErrorS(err, "Failed to save node cidr allocations", "file", tempFile)
+		return
+	}
+	if err = os.Rename(tempFile, cm.policy.AllocationFile); err != nil {
+		klog.ErrorS(err, "Failed to save node cidr allocations", "file", cm.policy.AllocationFile)
+	}
 }
 
-func (*nodeCidrManager) GetCidr(node *v1.Node) []string {
-	return []string{"192.168.0.1/24"}
+func (cm *nodeCidrManager) cidrSetOf(cidr *net.IPNet) *cidrSet {
+	for _, set := range cm.cidrSets {
+		if set.isIPv6() == (cidr.IP.To4() == nil) {
+			return set
+		}
+	}
+	return nil
+}
+
+func (cm *nodeCidrManager) occupy(cidrStr, nodeName string) error {
+	_, cidr, err := net.ParseCIDR(cidrStr)
+	if err != nil {
+		return err
+	}
+	set := cm.cidrSetOf(cidr)
+	if set == nil {
+		return fmt.Errorf("no cluster cidr has same ip family as %s", cidrStr)
+	}
+	return set.occupy(cidr, nodeName)
+}
+
+func (cm *nodeCidrManager) releaseCidrs(cidrs []string) {
+	for _, v := range cidrs {
+		if _, cidr, err := net.ParseCIDR(v); err == nil {
+			if set := cm.cidrSetOf(cidr); set != nil {
+				set.release(cidr)
+			}
+		}
+	}
+}
+
+// GetCidr return pod cidrs allocated to node, if node does not have allocation yet,
+// try to take pod cidrs node reported if they are free, e.g. fornaxcore lost allocations, otherwise allocate new cidrs,
+// a reported cidr used by another node is a conflict, node is given a new cidr and node agent detect cidr change
+func (cm *nodeCidrManager) GetCidr(node *v1.Node) ([]string, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if err := cm.init(); err != nil {
+		return nil, err
+	}
+
+	nodeName := util.Name(node)
+	reportedCidrs := node.Spec.PodCIDRs
+	if len(reportedCidrs) == 0 && len(node.Spec.PodCIDR) > 0 {
+		reportedCidrs = []string{node.Spec.PodCIDR}
+	}
+	cm.activeTime[nodeName] = time.Now()
+	if cidrs, found := cm.allocations[nodeName]; found {
+		return cidrs, nil
+	}
+
+	cidrs := []string{}
+	for _, set := range cm.cidrSets {
+		var cidr string
+		for _, v := range reportedCidrs {
+			_, reported, err := net.ParseCIDR(v)
+			if err != nil || cm.cidrSetOf(reported) != set {
+				continue
+			}
+			if err = set.occupy(reported, nodeName); err != nil {
+				klog.Warningf("Node %s reported pod cidr %s can not be used, allocate a new one, %v", nodeName, v, err)
+				continue
+			}
+			cidr = reported.String()
+			break
+		}
+		if len(cidr) == 0 {
+			allocated, err := set.allocate(nodeName)
+			if err != nil {
+				cm.releaseCidrs(cidrs)
+				return nil, err
+			}
+			cidr = allocated
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	klog.InfoS("Allocated pod cidrs for node", "node", nodeName, "cidrs", cidrs, "reported", reportedCidrs)
+	cm.allocations[nodeName] = cidrs
+	cm.save()
+	return cidrs, nil
+}
+
+// ReleaseCidr return pod cidrs of a removed node
+func (cm *nodeCidrManager) ReleaseCidr(nodeName string) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if cidrs, found := cm.allocations[nodeName]; found {
+		klog.InfoS("Release pod cidrs of node", "node", nodeName, "cidrs", cidrs)
+		cm.releaseCidrs(cidrs)
+		delete(cm.allocations, nodeName)
+		delete(cm.activeTime, nodeName)
+		cm.save()
+	}
+}
+
+// ReleaseStaleCidrs release pod cidrs of nodes which are not active for release timeout,
+// e.g. a node never reconnect after fornaxcore restart,
+// if such a node come back later, it get its reported pod cidrs back if they are still free
+func (cm *nodeCidrManager) ReleaseStaleCidrs(activeNodes []string) []string {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if err := cm.init(); err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	for _, nodeName := range activeNodes {
+		if _, found := cm.allocations[nodeName]; found {
+			cm.activeTime[nodeName] = now
+		}
+	}
+	released := []string{}
+	for nodeName, cidrs := range cm.allocations {
+		if now.Sub(cm.activeTime[nodeName]) <= cm.policy.releaseTimeout() {
+			continue
+		}
+		klog.InfoS("Release pod cidrs of inactive node", "node", nodeName, "cidrs", cidrs, "lastActive", cm.activeTime[nodeName])
+		cm.releaseCidrs(cidrs)
+		delete(cm.allocations, nodeName)
+		delete(cm.activeTime, nodeName)
+		released = append(released, nodeName)
+	}
+	if len(released) > 0 {
+		cm.save()
+	}
+	return released
 }
 
-func NewPodCidrManager() NodeCidrManager {
-	return &nodeCidrManager{}
+func NewPodCidrManager(policy *NodeCidrPolicy) NodeCidrManager {
+	return &nodeCidrManager{
+		mu:          sync.Mutex{},
+		policy:      policy,
+		allocations: map[string][]string{},
+		activeTime:  map[string]time.Time{},
+	}
 }
@@ -17,25 +17,475 @@ limitations under the License.
 package node
 
 import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc/nodeagent"
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
+	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
+	"centaurusinfra.io/fornax-serverless/pkg/store/factory"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+	"github.com/google/uuid"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/watch"
+	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/klog/v2"
+	k8spodutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	hashutil "k8s.io/kubernetes/pkg/util/hash"
+)
+
+const (
+	DefaultNodeDaemonSyncPeriod            = 5 * time.Second
+	DefaultNodeDaemonPodTerminateTimeout   = 30 * time.Second
+	DefaultNodeDaemonRollingMaxUnavailable = 1
 )
 
 type NodeDaemonManager interface {
+	Run() error
 	GetDaemons(node *ie.FornaxNodeWithState) map[string]*v1.Pod
+	SyncNodeDaemons(nodes []*ie.FornaxNodeWithState)
 }
 
 var _ NodeDaemonManager = &nodeDaemonManager{}
 
+// nodeDaemonManager run a daemon pod of each NodeDaemon on every node matching daemon node selector,
+// daemon pods are sent to node in node configuration when node register,
+// and are created or replaced on running nodes when a NodeDaemon is created or its template is changed
 type nodeDaemonManager struct {
+	ctx           context.Context
+	mu            sync.RWMutex
+	daemonStore   fornaxstore.ApiStorageInterface
+	daemonUpdates <-chan fornaxstore.WatchEventWithOldObj
+	daemons       map[string]*fornaxv1.NodeDaemon
+	// daemonsSynced is set after daemons in store are listed, daemonsLoaded is set once any daemon is seen,
+	// daemon pods of unknown daemons are only terminated when both are set, so daemon pods are not terminated before daemons are loaded
+	daemonsSynced   bool
+	daemonsLoaded   bool
+	terminatingPods map[string]time.Time
+	nodeAgent       nodeagent.NodeAgentClient
+	podManager      ie.PodManagerInterface
+}
+
+// GetDaemons implements NodeDaemonManager, it return daemon pods which should run on node,
+// daemon pods node already have are kept, rolling update replace them later after node is ready
+func (dm *nodeDaemonManager) GetDaemons(node *ie.FornaxNodeWithState) map[string]*v1.Pod {
+	daemonPods := map[string]*v1.Pod{}
+	for _, daemon := range dm.listDaemons() {
+		if !nodeMatchDaemon(daemon, node.GetNode()) {
+			continue
+		}
+		pods := dm.daemonPodsOnNode(util.Name(daemon), node)
+		if len(pods) == 0 {
+			pods = append(pods, buildDaemonPod(daemon, nodeDaemonRevision(daemon), node))
+		}
+		for _, pod := range pods {
+			daemonPods[util.Name(pod)] = pod.DeepCopy()
+		}
+	}
+	return daemonPods
+}
+
+// SyncNodeDaemons create missing daemon pods on running nodes, roll out changed daemon templates,
+// terminate daemon pods on nodes which do not match daemon anymore, and update daemon status
+func (dm *nodeDaemonManager) SyncNodeDaemons(nodes []*ie.FornaxNodeWithState) {
+	dm.expireTerminatingPods()
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeId < nodes[j].NodeId
+	})
+
+	daemonNames := map[string]bool{}
+	for _, daemon := range dm.listDaemons() {
+		daemonNames[util.Name(daemon)] = true
+		if err := dm.syncNodeDaemon(daemon, nodes); err != nil {
+			klog.ErrorS(err, "Failed to sync node daemon", "daemon", util.Name(daemon))
+		}
+	}
+
+	// terminate daemon pods of deleted daemons
+	if !dm.orphanCleanupAllowed() {
+		return
+	}
+	for _, fornaxNode := range nodes {
+		if fornaxNode.GetState() != ie.NodeWorkingStateRunning {
+			continue
+		}
+		for _, pod := range dm.daemonPodsOnNode("", fornaxNode) {
+			if _, found := daemonNames[getPodDaemonName(pod)]; !found {
+				dm.terminateDaemonPod(fornaxNode, pod)
+			}
+		}
+	}
+}
+
+func (dm *nodeDaemonManager) syncNodeDaemon(daemon *fornaxv1.NodeDaemon, nodes []*ie.FornaxNodeWithState) error {
+	daemonName := util.Name(daemon)
+	revision := nodeDaemonRevision(daemon)
+	maxUnavailable := nodeDaemonMaxUnavailable(daemon)
+	status := fornaxv1.NodeDaemonStatus{
+		Revision: revision,
+	}
+
+	// rolling update does not make more nodes than maxUnavailable have no ready daemon pod
+	unavailable := 0
+	for _, fornaxNode := range nodes {
+		if nodeMatchDaemon(daemon, fornaxNode.GetNode()) && dm.findReadyPod(dm.daemonPodsOnNode(daemonName, fornaxNode)) == nil {
+			unavailable += 1
+		}
+	}
+
+	for _, fornaxNode := range nodes {
+		pods := dm.daemonPodsOnNode(daemonName, fornaxNode)
+		nodeRunning := fornaxNode.GetState() == ie.NodeWorkingStateRunning
+		if !nodeMatchDaemon(daemon, fornaxNode.GetNode()) {
+			if nodeRunning {
+				for _, pod := range pods {
+					dm.terminateDaemonPod(fornaxNode, pod)
+				}
+			}
+			continue
+		}
+
+		var updatedPod *v1.Pod
+		oldPods := []*v1.Pod{}
+		for _, pod := range pods {
+			if pod.Labels[fornaxv1.LabelFornaxCoreNodeDaemonRevision] == revision {
+				updatedPod = pod
+			} else {
+				oldPods = append(oldPods, pod)
+			}
+		}
+
+		if nodeRunning {
+			switch {
+			case updatedPod != nil:
+				// updated pod is ready, old pods are not needed anymore
+				if dm.findReadyPod([]*v1.Pod{updatedPod}) != nil {
+					for _, pod := range oldPods {
+						dm.terminateDaemonPod(fornaxNode, pod)
+					}
+				}
+			case len(oldPods) == 0:
+				if pod, err := dm.createDaemonPod(daemon, revision, fornaxNode); err != nil {
+					klog.ErrorS(err, "Failed to create daemon pod", "daemon", daemonName, "node", fornaxNode.NodeId)
+				} else {
+					updatedPod = pod
+				}
+			default:
+				// old pod is terminated first, updated pod is created when old pod is gone, as daemon pod use host network
+				oldPodReady := dm.findReadyPod(oldPods) != nil
+				if !oldPodReady || unavailable < maxUnavailable {
+					if oldPodReady {
+						unavailable += 1
+					}
+					for _, pod := range oldPods {
+						dm.terminateDaemonPod(fornaxNode, pod)
+					}
+				}
+			}
+		}
+
+		status.DesiredNumberScheduled += 1
+		nodeStatus := fornaxv1.NodeDaemonNodeStatus{
+			NodeName: fornaxNode.NodeId,
+		}
+		pod := updatedPod
+		if pod == nil && len(oldPods) > 0 {
+			pod = oldPods[0]
+		}
+		if pod != nil {
+			status.CurrentNumberScheduled += 1
+			nodeStatus.PodName = pod.Name
+			nodeStatus.Revision = pod.Labels[fornaxv1.LabelFornaxCoreNodeDaemonRevision]
+			nodeStatus.Phase = pod.Status.Phase
+			nodeStatus.Message = pod.Status.Message
+		}
+		if updatedPod != nil {
+			status.UpdatedNumberScheduled += 1
+		}
+		if dm.findReadyPod(pods) != nil {
+			status.NumberReady += 1
+			nodeStatus.Ready = true
+		}
+		status.NodeStatuses = append(status.NodeStatuses, nodeStatus)
+	}
+
+	return dm.updateDaemonStatus(daemon, &status)
 }
 
-// GetDaemons implements NodeDaemonManager
-func (*nodeDaemonManager) GetDaemons(node *ie.FornaxNodeWithState) map[string]*v1.Pod {
-	// TODO manage real daemon pods
-	return map[string]*v1.Pod{}
+func (dm *nodeDaemonManager) updateDaemonStatus(daemon *fornaxv1.NodeDaemon, status *fornaxv1.NodeDaemonStatus) error {
+	if reflect.DeepEqual(daemon.Status, *status) {
+		return nil
+	}
+	daemonInStore, err := factory.GetNodeDaemonCache(dm.daemonStore, util.Name(daemon))
+	if err != nil {
+		return err
+	}
+	if daemonInStore == nil {
+		return nil
+	}
+	daemonInStore.Status = *status.DeepCopy()
+	daemonInStore, err = factory.UpdateNodeDaemon(dm.ctx, dm.daemonStore, daemonInStore)
+	if err != nil {
+		return err
+	}
+	dm.mu.Lock()
+	dm.daemons[util.Name(daemonInStore)] = daemonInStore
+	dm.mu.Unlock()
+	return nil
+}
+
+// createDaemonPod add daemon pod into pod manager and send it to node, pod is bound with node already,
+// if node never report it back, node manager delete it in next node state sync and it's created again
+func (dm *nodeDaemonManager) createDaemonPod(daemon *fornaxv1.NodeDaemon, revision string, fornaxNode *ie.FornaxNodeWithState) (*v1.Pod, error) {
+	pod := buildDaemonPod(daemon, revision, fornaxNode)
+	klog.InfoS("Create daemon pod on node", "daemon", util.Name(daemon), "node", fornaxNode.NodeId, "pod", util.Name(pod), "revision", revision)
+	pod, err := dm.podManager.AddOrUpdatePod(pod)
+	if err != nil {
+		return nil, err
+	}
+	fornaxNode.Pods.Add(util.Name(pod))
+	if err = dm.nodeAgent.CreatePod(fornaxNode.NodeId, pod); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+// terminateDaemonPod ask node to terminate a daemon pod, terminate request is not sent again until timeout
+func (dm *nodeDaemonManager) terminateDaemonPod(fornaxNode *ie.FornaxNodeWithState, pod *v1.Pod) {
+	podName := util.Name(pod)
+	if dm.isTerminating(podName) {
+		return
+	}
+	klog.InfoS("Terminate daemon pod on node", "daemon", getPodDaemonName(pod), "node", fornaxNode.NodeId, "pod", podName)
+	if err := dm.podManager.TerminatePod(podName); err != nil {
+		klog.ErrorS(err, "Failed to terminate daemon pod", "pod", podName)
+		return
+	}
+	dm.mu.Lock()
+	dm.terminatingPods[podName] = time.Now()
+	dm.mu.Unlock()
+}
+
+func (dm *nodeDaemonManager) isTerminating(podName string) bool {
+	dm.mu.RLock()
+	defer dm.mu.RUnlock()
+	_, found := dm.terminatingPods[podName]
+	return found
+}
+
+func (dm *nodeDaemonManager) expireTerminatingPods() {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	for podName, t := range dm.terminatingPods {
+		if time.Since(t) > DefaultNodeDaemonPodTerminateTimeout || dm.podManager.FindPod(podName) == nil {
+			delete(dm.terminatingPods, podName)
+		}
+	}
+}
+
+// findReadyPod return a ready pod which is not being terminated
+func (dm *nodeDaemonManager) findReadyPod(pods []*v1.Pod) *v1.Pod {
+	for _, pod := range pods {
+		if !dm.isTerminating(util.Name(pod)) && k8spodutil.IsPodReady(pod) {
+			return pod
+		}
+	}
+	return nil
+}
+
+// daemonPodsOnNode return not terminated daemon pods of a daemon on node, all daemon pods are returned if daemon name is empty
+func (dm *nodeDaemonManager) daemonPodsOnNode(daemonName string, fornaxNode *ie.FornaxNodeWithState) []*v1.Pod {
+	pods := []*v1.Pod{}
+	for _, podName := range fornaxNode.Pods.GetKeys() {
+		pod := dm.podManager.FindPod(podName)
+		if pod == nil || !util.PodNotTerminated(pod) {
+			continue
+		}
+		if _, found := pod.Labels[fornaxv1.LabelFornaxCoreNodeDaemonRevision]; !found {
+			continue
+		}
+		if len(daemonName) == 0 || getPodDaemonName(pod) == daemonName {
+			pods = append(pods, pod)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	return pods
+}
+
+func (dm *nodeDaemonManager) listDaemons() []*fornaxv1.NodeDaemon {
+	dm.mu.RLock()
+	defer dm.mu.RUnlock()
+	daemons := []*fornaxv1.NodeDaemon{}
+	for _, v := range dm.daemons {
+		if v.DeletionTimestamp == nil {
+			daemons = append(daemons, v)
+		}
+	}
+	return daemons
+}
+
+func (dm *nodeDaemonManager) orphanCleanupAllowed() bool {
+	dm.mu.RLock()
+	defer dm.mu.RUnlock()
+	return dm.daemonsSynced && dm.daemonsLoaded
+}
+
+// loadDaemons list daemons in store before watching, so daemons which exist are known before first sync
+func (dm *nodeDaemonManager) loadDaemons() error {
+	daemons := &fornaxv1.NodeDaemonList{}
+	err := dm.daemonStore.GetList(dm.ctx, fornaxv1.NodeDaemonGrvKey, apistorage.ListOptions{Predicate: apistorage.Everything, Recursive: true}, daemons)
+	if err != nil {
+		return err
+	}
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	for i := range daemons.Items {
+		daemon := daemons.Items[i].DeepCopy()
+		dm.daemons[util.Name(daemon)] = daemon
+		dm.daemonsLoaded = true
+	}
+	dm.daemonsSynced = true
+	return nil
+}
+
+func (dm *nodeDaemonManager) onNodeDaemonEventFromStorage(we fornaxstore.WatchEventWithOldObj) {
+	daemon, ok := we.Object.(*fornaxv1.NodeDaemon)
+	if !ok {
+		return
+	}
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	switch we.Type {
+	case watch.Added, watch.Modified:
+		dm.daemons[util.Name(daemon)] = daemon.DeepCopy()
+		dm.daemonsLoaded = true
+	case watch.Deleted:
+		klog.InfoS("Node daemon is deleted, terminate its daemon pods", "daemon", util.Name(daemon))
+		delete(dm.daemons, util.Name(daemon))
+	}
+}
+
+func (dm *nodeDaemonManager) Run() error {
+	if err := dm.loadDaemons(); err != nil {
+		return err
+	}
+	wi, err := dm.daemonStore.WatchWithOldObj(dm.ctx, fornaxv1.NodeDaemonGrvKey, apistorage.ListOptions{
+		ResourceVersion:      "0",
+		ResourceVersionMatch: "",
+		Predicate:            apistorage.Everything,
+		Recursive:            true,
+		ProgressNotify:       true,
+	})
+	if err != nil {
+		return err
+	}
+	dm.daemonUpdates = wi.ResultChanWithPrevobj()
+
+	go func() {
+		for {
+			select {
+			case <-dm.ctx.Done():
+				return
+			case we := <-dm.daemonUpdates:
+				dm.onNodeDaemonEventFromStorage(we)
+			}
+		}
+	}()
+	return nil
+}
+
+func nodeMatchDaemon(daemon *fornaxv1.NodeDaemon, node *v1.Node) bool {
+	return labels.SelectorFromSet(daemon.Spec.NodeSelector).Matches(labels.Set(node.Labels))
+}
+
+func nodeDaemonMaxUnavailable(daemon *fornaxv1.NodeDaemon) int {
+	if daemon.Spec.UpdateStrategy.MaxUnavailable == 0 {
+		return DefaultNodeDaemonRollingMaxUnavailable
+	}
+	return int(daemon.Spec.UpdateStrategy.MaxUnavailable)
+}
+
+// nodeDaemonRevision return a hash of daemon pod template, daemon pods are replaced when it change
+func nodeDaemonRevision(daemon *fornaxv1.NodeDaemon) string {
+	hasher := fnv.New32a()
+	hashutil.DeepHashObject(hasher, daemon.Spec.Template)
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
+
+// getPodDaemonName return daemon name of a daemon pod, daemon is in same namespace as pod
+func getPodDaemonName(pod *v1.Pod) string {
+	return fmt.Sprintf("%s/%s", pod.Namespace, pod.Labels[fornaxv1.LabelFornaxCoreNodeDaemon])
+}
+
+// buildDaemonPod build a daemon pod bound with node from daemon pod template,
+// pod name is unique for a template revision on a node, node ignore a daemon pod it already has
+func buildDaemonPod(daemon *fornaxv1.NodeDaemon, revision string, fornaxNode *ie.FornaxNodeWithState) *v1.Pod {
+	template := daemon.Spec.Template.DeepCopy()
+	podLabels := map[string]string{}
+	for k, v := range template.Labels {
+		podLabels[k] = v
+	}
+	podLabels[fornaxv1.LabelFornaxCoreNodeDaemon] = daemon.Name
+	podLabels[fornaxv1.LabelFornaxCoreNodeDaemonRevision] = revision
+	podAnnotations := map[string]string{}
+	for k, v := range template.Annotations {
+		podAnnotations[k] = v
+	}
+	podAnnotations[fornaxv1.AnnotationFornaxCoreNode] = fornaxNode.NodeId
+
+	name := fmt.Sprintf("%s-%s-%s", daemon.Name, revision, fornaxNode.GetNode().Name)
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       daemon.Namespace,
+			UID:             types.UID(uuid.New().String()),
+			ResourceVersion: "0",
+			Generation:      1,
+			CreationTimestamp: metav1.Time{
+				Time: time.Now(),
+			},
+			Labels:      podLabels,
+			Annotations: podAnnotations,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: fornaxv1.NodeDaemonKind.GroupVersion().String(),
+					Kind:       fornaxv1.NodeDaemonKind.Kind,
+					Name:       daemon.Name,
+					UID:        daemon.UID,
+				},
+			},
+		},
+		Spec: template.Spec,
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+		},
+	}
 }
 
-func NewNodeDaemonManager() NodeDaemonManager {
-	return &nodeDaemonManager{}
+func NewNodeDaemonManager(ctx context.Context, daemonStore fornaxstore.ApiStorageInterface, nodeAgent nodeagent.NodeAgentClient, podManager ie.PodManagerInterface) NodeDaemonManager {
+	return &nodeDaemonManager{
+		ctx:             ctx,
+		mu:              sync.RWMutex{},
+		daemonStore:     daemonStore,
+		daemons:         map[string]*fornaxv1.NodeDaemon{},
+		terminatingPods: map[string]time.Time{},
+		nodeAgent:       nodeAgent,
+		podManager:      podManager,
+	}
 }
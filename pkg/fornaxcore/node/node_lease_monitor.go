@@ -0,0 +1,163 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"time"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
+	fornaxpod "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/pod"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	DefaultNodeHeartbeatGracePeriod = 60 * time.Second
+	DefaultNodeLeaseCheckPeriod     = 5 * time.Second
+	NodeReasonStatusUnknown         = "NodeStatusUnknown"
+)
+
+// NodeLeasePolicy define how long a node can stay silent before it's taken as lost,
+// any message from node agent, e.g. node state, pod state renew node lease
+type NodeLeasePolicy struct {
+	HeartbeatGracePeriod time.Duration
+}
+
+func (nm *nodeManager) heartbeatGracePeriod() time.Duration {
+	if nm.leasePolicy == nil || nm.leasePolicy.HeartbeatGracePeriod <= 0 {
+		return DefaultNodeHeartbeatGracePeriod
+	}
+	return nm.leasePolicy.HeartbeatGracePeriod
+}
+
+// checkNodeLeases find nodes which did not heartbeat within grace period and fail over them,
+// pods which failed to be failed over stay in node pods, they are retried in next check until node come back,
+// pod cidrs are kept for all nodes known by node manager including not ready nodes,
+// pod cidrs of nodes which are not in node manager for a long time, e.g. node never reconnect after fornaxcore restart, are released
+func (nm *nodeManager) checkNodeLeases() {
+	gracePeriod := nm.heartbeatGracePeriod()
+	activeNodes := []string{}
+	defer func() {
+		for _, nodeName := range nm.nodePodCidrManager.ReleaseStaleCidrs(activeNodes) {
+			nm.removedNodeLostPodsMu.Lock()
+			delete(nm.removedNodeLostPods, nodeName)
+			nm.removedNodeLostPodsMu.Unlock()
+			if err := nm.clearNodePodCidrsInStore(nodeName); err != nil {
+				klog.ErrorS(err, "Failed to clear released pod cidrs of node in store", "node", nodeName)
+			}
+		}
+	}()
+	for _, fornaxNode := range nm.nodes.list() {
+		activeNodes = append(activeNodes, fornaxNode.NodeId)
+		if fornaxNode.GetState() == ie.NodeWorkingStateNotReady {
+			if err := nm.failoverNodePods(fornaxNode); err != nil {
+				klog.ErrorS(err, "Failed to fail over pods on not ready node, retry in next check", "node", fornaxNode.NodeId)
+			}
+			continue
+		}
+		lastSeen := fornaxNode.GetLastSeen()
+		if time.Since(lastSeen) <= gracePeriod {
+			continue
+		}
+		klog.InfoS("Node lease expired, mark node not ready", "node", fornaxNode.NodeId, "lastSeen", lastSeen, "gracePeriod", gracePeriod)
+		if err := nm.markNodeNotReady(fornaxNode, gracePeriod); err != nil {
+			klog.ErrorS(err, "Failed to mark node not ready, retry in next check", "node", fornaxNode.NodeId)
+		}
+	}
+}
+
+// markNodeNotReady set node ready condition as unknown and fail over all non daemon pods on node,
+// pods are marked as lost and deleted, application manager time out sessions on lost pods and recreate pods on other nodes.
+func (nm *nodeManager) markNodeNotReady(fornaxNode *ie.FornaxNodeWithState, gracePeriod time.Duration) error {
+	node := fornaxNode.GetNode().DeepCopy()
+	util.SetNodeConditionNotReady(node, NodeReasonStatusUnknown, fmt.Sprintf("Node stopped posting status for more than %s", gracePeriod))
+	nodeInStore, err := nm.updateNodeStatusInStore(node)
+	if err != nil {
+		return err
+	}
+	fornaxNode.SetState(ie.NodeWorkingStateNotReady)
+	fornaxNode.SetNode(nodeInStore)
+	nm.nodeUpdates <- &ie.NodeEvent{
+		Node: fornaxNode.GetNode().DeepCopy(),
+		Type: ie.NodeEventTypeUpdate,
+	}
+
+	return nm.failoverNodePods(fornaxNode)
+}
+
+// failoverNodePods mark all non daemon pods on node as lost
+func (nm *nodeManager) failoverNodePods(fornaxNode *ie.FornaxNodeWithState) error {
+	errs := []error{}
+	for _, podName := range fornaxNode.Pods.GetKeys() {
+		if _, found := fornaxNode.GetDaemonPods()[podName]; found {
+			continue
+		}
+		pod := nm.podManager.FindPod(podName)
+		if pod == nil {
+			fornaxNode.Pods.Delete(podName)
+			continue
+		}
+		if _, found := pod.Labels[fornaxv1.LabelFornaxCoreNodeDaemon]; found {
+			continue
+		}
+		if err := nm.markPodLost(fornaxNode, pod.DeepCopy()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("Some pods failed to be failed over, errors=%v", errs)
+	}
+	return nil
+}
+
+func (nm *nodeManager) markPodLost(fornaxNode *ie.FornaxNodeWithState, pod *v1.Pod) error {
+	podName := util.Name(pod)
+	klog.InfoS("Node is lost, fail over pod", "node", fornaxNode.NodeId, "pod", podName)
+	if util.PodNotTerminated(pod) {
+		pod.Status.Phase = v1.PodFailed
+		pod.Status.Reason = util.PodReasonNodeLost
+		pod.Status.Message = fmt.Sprintf("Node %s which pod was running on is unresponsive", fornaxNode.NodeId)
+	}
+	if pod.DeletionTimestamp == nil {
+		pod.DeletionTimestamp = util.NewCurrentMetaTime()
+	}
+	_, err := nm.podManager.DeletePod(pod)
+	if err != nil && err != fornaxpod.PodNotFoundError {
+		return err
+	}
+	fornaxNode.Pods.Delete(podName)
+	fornaxNode.LostPods.Add(podName)
+	return nil
+}
+
+// terminateLostPod terminate a pod which was failed over when node was not ready,
+// application already recreated it somewhere else, it's not taken back when node come back
+func (nm *nodeManager) terminateLostPod(fornaxNode *ie.FornaxNodeWithState, pod *v1.Pod) error {
+	podName := util.Name(pod)
+	if util.PodIsTerminated(pod) {
+		fornaxNode.LostPods.Delete(podName)
+		return nil
+	}
+	klog.InfoS("Terminate a lost pod reported by recovered node", "node", fornaxNode.NodeId, "pod", podName)
+	if pod.DeletionTimestamp == nil {
+		pod.DeletionTimestamp = util.NewCurrentMetaTime()
+	}
+	return nm.nodeAgent.TerminatePod(fornaxNode.NodeId, pod)
+}
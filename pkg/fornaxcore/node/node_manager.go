@@ -18,13 +18,16 @@ package node
 
 import (
 	"context"
+	"reflect"
 	"sync"
 
 	// "sync"
 	"time"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	fornaxk8sv1 "centaurusinfra.io/fornax-serverless/pkg/apis/k8s/core/v1"
 	"centaurusinfra.io/fornax-serverless/pkg/collection"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/event"
 	fornaxgrpc "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc/nodeagent"
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
@@ -33,13 +36,17 @@ import (
 	"centaurusinfra.io/fornax-serverless/pkg/store/factory"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 )
 
 // todo: determine more proper timeout
 const (
-	DefaultStaleNodeTimeout = 120 * time.Second
-	MaxlengthOfNodeUpdates  = 5000
+	DefaultStaleNodeTimeout    = 120 * time.Second
+	DefaultNodeDrainSyncPeriod = 5 * time.Second
+	MaxlengthOfNodeUpdates     = 5000
 )
 
 var _ ie.NodeManagerInterface = &nodeManager{}
@@ -56,6 +63,12 @@ type nodeManager struct {
 	nodePodCidrManager NodeCidrManager
 	nodeDaemonManager  NodeDaemonManager
 	houseKeepingTicker *time.Ticker
+	nodeStoreUpdates   <-chan fornaxstore.WatchEventWithOldObj
+	leasePolicy        *NodeLeasePolicy
+	eventRecorder      record.EventRecorder
+	// lost pods of nodes removed by client, they are terminated if node agent register again and report them
+	removedNodeLostPods   map[string]*collection.ConcurrentStringSet
+	removedNodeLostPodsMu sync.Mutex
 }
 
 // Watch add a watcher, and beging to send NodeEvent to watcher
@@ -68,7 +81,7 @@ func (nm *nodeManager) List() []*ie.NodeEvent {
 	nodeEvents := []*ie.NodeEvent{}
 	for _, v := range nm.nodes.list() {
 		nodeEvents = append(nodeEvents, &ie.NodeEvent{
-			Node: v.Node.DeepCopy(),
+			Node: v.GetNode().DeepCopy(),
 			Type: ie.NodeEventTypeCreate,
 		})
 	}
@@ -94,11 +107,13 @@ func (nm *nodeManager) UpdateSessionState(nodeId string, session *fornaxv1.Appli
 func (nm *nodeManager) UpdatePodState(nodeId string, pod *v1.Pod, sessionStates []*fornaxgrpc.SessionState) error {
 	podName := util.Name(pod)
 	if nodeWS := nm.nodes.get(nodeId); nodeWS != nil {
-		nodeWS.LastSeen = time.Now()
+		nodeWS.RenewLease()
 		if existingPod := nm.podManager.FindPod(podName); existingPod != nil {
 			if largerRv, _ := util.NodeRevisionLargerThan(pod, existingPod); !largerRv {
 				return nil
 			}
+		} else if nodeWS.LostPods.Has(podName) {
+			return nm.terminateLostPod(nodeWS, pod)
 		}
 		updatedPod, err := nm.podManager.AddOrUpdatePod(pod)
 		if err != nil {
@@ -129,7 +144,7 @@ func (nm *nodeManager) SyncPodStates(nodeId string, podStates []*fornaxgrpc.PodS
 		return
 	}
 
-	nodeWS.LastSeen = time.Now()
+	nodeWS.RenewLease()
 	reportedPods := map[string]bool{}
 	for _, podState := range podStates {
 		podName := util.Name(podState.GetPod())
@@ -140,6 +155,13 @@ func (nm *nodeManager) SyncPodStates(nodeId string, podStates []*fornaxgrpc.PodS
 		}
 	}
 
+	// lost pods are gone from node, forget them
+	for _, podName := range nodeWS.LostPods.GetKeys() {
+		if _, found := reportedPods[podName]; !found {
+			nodeWS.LostPods.Delete(podName)
+		}
+	}
+
 	// reverse lookup, find deleted pods
 	existingPodNames := nodeWS.Pods.GetKeys()
 	disappearingPods := []string{}
@@ -173,8 +195,12 @@ func (nm *nodeManager) SyncPodStates(nodeId string, podStates []*fornaxgrpc.PodS
 // 2/ node daemons
 // it also return daemon pods node should initialize before taking service pods
 func (nm *nodeManager) UpdateNodeState(nodeId string, node *v1.Node) (fornaxNode *ie.FornaxNodeWithState, err error) {
-	cidrs := nm.nodePodCidrManager.GetCidr(node)
-	if node.Spec.PodCIDR != cidrs[0] {
+	cidrs, err := nm.nodePodCidrManager.GetCidr(node)
+	if err != nil {
+		klog.ErrorS(err, "Failed to allocate pod cidr for node", "node", nodeId)
+		return nil, err
+	}
+	if node.Spec.PodCIDR != cidrs[0] || !reflect.DeepEqual(node.Spec.PodCIDRs, cidrs) {
 		node.Spec.PodCIDR = cidrs[0]
 		node.Spec.PodCIDRs = cidrs
 	}
@@ -192,10 +218,11 @@ func (nm *nodeManager) UpdateNodeState(nodeId string, node *v1.Node) (fornaxNode
 
 	// recalculate daemon pods on node always to make sure node has correct setup
 	daemons := nm.nodeDaemonManager.GetDaemons(fornaxNode)
-	fornaxNode.DaemonPods = daemons
+	fornaxNode.SetDaemonPods(daemons)
 	return fornaxNode, nil
 }
 
+// createOrUpdateNodeInStore save node reported by node agent, status and labels reported by node agent are merged into node in store
 func (nm *nodeManager) createOrUpdateNodeInStore(node *v1.Node) (*v1.Node, error) {
 	nodeInStore, err := factory.GetFornaxNodeCache(nm.nodeStore, util.Name(node))
 	if err != nil {
@@ -203,12 +230,13 @@ func (nm *nodeManager) createOrUpdateNodeInStore(node *v1.Node) (*v1.Node, error
 	}
 	if nodeInStore == nil {
 		nodeInStore = node.DeepCopy()
+		mergeNodeFromAgent(nodeInStore, node)
 		nodeInStore, err = factory.CreateFornaxNode(nm.ctx, nm.nodeStore, nodeInStore)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		util.MergeNodeStatus(nodeInStore, node)
+		mergeNodeFromAgent(nodeInStore, node)
 		nodeInStore, err = factory.UpdateFornaxNode(nm.ctx, nm.nodeStore, nodeInStore)
 		if err != nil {
 			return nil, err
@@ -218,6 +246,55 @@ func (nm *nodeManager) createOrUpdateNodeInStore(node *v1.Node) (*v1.Node, error
 	return nodeInStore, nil
 }
 
+// nodeAgentAnnotations are node annotations owned by node agent, they are replaced by values node agent reported
+var nodeAgentAnnotations = []string{
+	fornaxv1.AnnotationFornaxCoreNodeRuntimeHandlers,
+}
+
+// mergeNodeFromAgent merge node reported by node agent into node in store,
+// pod cidrs are assigned by node cidr manager when node register, they replace pod cidrs in store, node agent use them to setup pod network
+func mergeNodeFromAgent(nodeInStore *v1.Node, node *v1.Node) {
+	util.MergeNodeStatus(nodeInStore, node)
+	nodeInStore.Spec.PodCIDR = node.Spec.PodCIDR
+	nodeInStore.Spec.PodCIDRs = append([]string{}, node.Spec.PodCIDRs...)
+	for _, k := range nodeAgentAnnotations {
+		if v, found := node.Annotations[k]; found {
+			nodeInStore.Annotations[k] = v
+		} else {
+			delete(nodeInStore.Annotations, k)
+		}
+	}
+}
+
+// clearNodePodCidrsInStore remove released pod cidrs from node in store, so they are not reported as node pod cidrs any more
+func (nm *nodeManager) clearNodePodCidrsInStore(nodeName string) error {
+	nodeInStore, err := factory.GetFornaxNodeCache(nm.nodeStore, nodeName)
+	if err != nil || nodeInStore == nil {
+		return err
+	}
+	if len(nodeInStore.Spec.PodCIDR) == 0 && len(nodeInStore.Spec.PodCIDRs) == 0 {
+		return nil
+	}
+	nodeInStore.Spec.PodCIDR = ""
+	nodeInStore.Spec.PodCIDRs = nil
+	_, err = factory.UpdateFornaxNode(nm.ctx, nm.nodeStore, nodeInStore)
+	return err
+}
+
+// updateNodeStatusInStore save node status changed by fornaxcore, e.g. node disconnected or its lease expired,
+// other fields of node in store are kept as it is
+func (nm *nodeManager) updateNodeStatusInStore(node *v1.Node) (*v1.Node, error) {
+	nodeInStore, err := factory.GetFornaxNodeCache(nm.nodeStore, util.Name(node))
+	if err != nil {
+		return nil, err
+	}
+	if nodeInStore == nil {
+		return nil, nodeagent.NodeNotFoundError
+	}
+	nodeInStore.Status = *node.Status.DeepCopy()
+	return factory.UpdateFornaxNode(nm.ctx, nm.nodeStore, nodeInStore)
+}
+
 // createNode create a new node, and assign pod cidr
 func (nm *nodeManager) createNode(nodeId string, node *v1.Node) (fornaxNode *ie.FornaxNodeWithState, err error) {
 	if fornaxNode = nm.nodes.get(nodeId); fornaxNode != nil {
@@ -225,50 +302,66 @@ func (nm *nodeManager) createNode(nodeId string, node *v1.Node) (fornaxNode *ie.
 	}
 
 	fornaxNode = &ie.FornaxNodeWithState{
-		NodeId:     nodeId,
-		Revision:   node.ResourceVersion,
-		State:      ie.NodeWorkingStateRegistering,
-		Pods:       collection.NewConcurrentSet(),
-		DaemonPods: map[string]*v1.Pod{},
-		LastSeen:   time.Now(),
+		NodeId:   nodeId,
+		Revision: node.ResourceVersion,
+		Pods:     collection.NewConcurrentSet(),
+		LostPods: collection.NewConcurrentSet(),
+	}
+	fornaxNode.SetState(ie.NodeWorkingStateRegistering)
+	fornaxNode.SetDaemonPods(map[string]*v1.Pod{})
+	fornaxNode.RenewLease()
+	nm.removedNodeLostPodsMu.Lock()
+	if lostPods, found := nm.removedNodeLostPods[nodeId]; found {
+		fornaxNode.LostPods = lostPods
+		delete(nm.removedNodeLostPods, nodeId)
 	}
+	nm.removedNodeLostPodsMu.Unlock()
 
 	if util.IsNodeCondtionReady(node) {
-		fornaxNode.State = ie.NodeWorkingStateRunning
+		fornaxNode.SetState(ie.NodeWorkingStateRunning)
 	}
 
 	nodeInStore, err := nm.createOrUpdateNodeInStore(node)
 	if err != nil {
 		return nil, err
 	}
-	fornaxNode.Node = nodeInStore
+	fornaxNode.SetNode(nodeInStore)
 	daemons := nm.nodeDaemonManager.GetDaemons(fornaxNode)
-	fornaxNode.DaemonPods = daemons
+	fornaxNode.SetDaemonPods(daemons)
 	nm.nodes.add(util.Name(node), fornaxNode)
 	nm.nodeUpdates <- &ie.NodeEvent{
 		Node: nodeInStore.DeepCopy(),
 		Type: ie.NodeEventTypeCreate,
 	}
+	nm.eventRecorder.Event(nodeInStore, v1.EventTypeNormal, event.ReasonNodeConnected, "Node connected to fornaxcore")
 	return fornaxNode, nil
 }
 
 // updateNode implements NodeManager
 func (nm *nodeManager) updateNode(nodeId string, node *v1.Node) (*ie.FornaxNodeWithState, error) {
 	if fornaxNode := nm.nodes.get(nodeId); fornaxNode != nil {
+		reconnected := false
 		if util.IsNodeCondtionReady(node) {
-			fornaxNode.State = ie.NodeWorkingStateRunning
+			prevState := fornaxNode.SetState(ie.NodeWorkingStateRunning)
+			if prevState == ie.NodeWorkingStateNotReady {
+				klog.InfoS("Node come back after lease expired", "node", nodeId, "lostPods", fornaxNode.LostPods.Len())
+			}
+			reconnected = prevState == ie.NodeWorkingStateNotReady || prevState == ie.NodeWorkingStateDisconnected
 		}
-		fornaxNode.LastSeen = time.Now()
+		fornaxNode.RenewLease()
 
 		nodeInStore, err := nm.createOrUpdateNodeInStore(node)
 		if err != nil {
 			return nil, err
 		}
-		fornaxNode.Node = nodeInStore
+		fornaxNode.SetNode(nodeInStore)
 		nm.nodeUpdates <- &ie.NodeEvent{
-			Node: fornaxNode.Node.DeepCopy(),
+			Node: fornaxNode.GetNode().DeepCopy(),
 			Type: ie.NodeEventTypeUpdate,
 		}
+		if reconnected {
+			nm.eventRecorder.Event(nodeInStore, v1.EventTypeNormal, event.ReasonNodeConnected, "Node reconnected to fornaxcore")
+		}
 		return fornaxNode, nil
 	} else {
 		return nil, nodeagent.NodeNotFoundError
@@ -276,31 +369,181 @@ func (nm *nodeManager) updateNode(nodeId string, node *v1.Node) (*ie.FornaxNodeW
 }
 
 // DisconnectNode update node to pending phase and send node event tell node not schedulable,
-// TODO, it got removed from store after a graceful period
+// if node does not reconnect within heartbeat grace period, its pods are failed over by lease monitor
 func (nm *nodeManager) DisconnectNode(nodeId string) error {
 	if fornaxNode := nm.nodes.get(nodeId); fornaxNode != nil {
-		fornaxNode.State = ie.NodeWorkingStateDisconnected
-		node := fornaxNode.Node.DeepCopy()
+		fornaxNode.SetState(ie.NodeWorkingStateDisconnected)
+		node := fornaxNode.GetNode().DeepCopy()
 		node.Status.Phase = v1.NodePending
-		nodeInStore, err := nm.createOrUpdateNodeInStore(node)
+		nodeInStore, err := nm.updateNodeStatusInStore(node)
 		if err != nil {
 			return err
 		}
-		fornaxNode.Node = nodeInStore
+		fornaxNode.SetNode(nodeInStore)
 		nm.nodeUpdates <- &ie.NodeEvent{
-			Node: fornaxNode.Node.DeepCopy(),
+			Node: fornaxNode.GetNode().DeepCopy(),
 			Type: ie.NodeEventTypeUpdate,
 		}
+		nm.eventRecorder.Event(nodeInStore, v1.EventTypeWarning, event.ReasonNodeDisconnected, "Node disconnected from fornaxcore")
+	}
+	return nil
+}
+
+// onNodeEventFromStorage handle node changes made by client, e.g. cordon, taint, drain or remove a node,
+// changes made by node manager itself are skipped as they are same as node in node pool
+func (nm *nodeManager) onNodeEventFromStorage(we fornaxstore.WatchEventWithOldObj) {
+	if we.Type != watch.Modified && we.Type != watch.Deleted {
+		return
+	}
+	node, ok := we.Object.(*v1.Node)
+	if !ok {
+		return
+	}
+	fornaxNode := nm.nodes.get(util.Name(node))
+	if fornaxNode == nil {
+		return
+	}
+	if we.Type == watch.Deleted {
+		nm.removeNode(fornaxNode)
+		return
+	}
+	oldNode := fornaxNode.GetNode()
+	if oldNode.Spec.Unschedulable == node.Spec.Unschedulable &&
+		reflect.DeepEqual(oldNode.Spec.Taints, node.Spec.Taints) &&
+		reflect.DeepEqual(oldNode.Labels, node.Labels) &&
+		reflect.DeepEqual(oldNode.Annotations, node.Annotations) {
+		return
+	}
+
+	klog.InfoS("Node is updated by client", "node", util.Name(node), "unschedulable", node.Spec.Unschedulable, "taints", node.Spec.Taints, "drain", util.IsNodeDrainRequested(node))
+	fornaxNode.SetNode(node.DeepCopy())
+	nm.nodeUpdates <- &ie.NodeEvent{
+		Node: fornaxNode.GetNode().DeepCopy(),
+		Type: ie.NodeEventTypeUpdate,
+	}
+	if err := nm.syncNodeDrainState(fornaxNode); err != nil {
+		klog.ErrorS(err, "Failed to sync node drain state", "node", util.Name(node))
+	}
+}
+
+// removeNode fail over pods on node removed by client and release node pod cidrs,
+// if node agent is still alive, it register again as a new node
+func (nm *nodeManager) removeNode(fornaxNode *ie.FornaxNodeWithState) {
+	klog.InfoS("Node is removed by client", "node", fornaxNode.NodeId)
+	if err := nm.failoverNodePods(fornaxNode); err != nil {
+		klog.ErrorS(err, "Failed to fail over pods on removed node", "node", fornaxNode.NodeId)
+	}
+	nm.nodes.delete(fornaxNode.NodeId)
+	if fornaxNode.LostPods.Len() > 0 {
+		nm.removedNodeLostPodsMu.Lock()
+		nm.removedNodeLostPods[fornaxNode.NodeId] = fornaxNode.LostPods
+		nm.removedNodeLostPodsMu.Unlock()
+	}
+	nm.nodePodCidrManager.ReleaseCidr(fornaxNode.NodeId)
+	nm.nodeUpdates <- &ie.NodeEvent{
+		Node: fornaxNode.GetNode().DeepCopy(),
+		Type: ie.NodeEventTypeDelete,
+	}
+}
+
+// syncNodeDrainState cordon node when a drain is requested and set drain state as Draining,
+// application manager close sessions and delete application pods on a draining node,
+// when all non daemon pods are gone, drain state is set as Drained.
+// if drain request is removed, drain state is cleared, but node keep cordoned until client uncordon it
+func (nm *nodeManager) syncNodeDrainState(fornaxNode *ie.FornaxNodeWithState) error {
+	node := fornaxNode.GetNode()
+	state := util.GetNodeDrainState(node)
+	newState := state
+	if util.IsNodeDrainRequested(node) {
+		if nm.hasWorkloadPods(fornaxNode) {
+			newState = fornaxv1.NodeDrainStateDraining
+		} else {
+			newState = fornaxv1.NodeDrainStateDrained
+		}
+	} else {
+		newState = ""
+	}
+	if newState == state && (len(newState) == 0 || node.Spec.Unschedulable) {
+		return nil
+	}
+
+	klog.InfoS("Update node drain state", "node", util.Name(node), "state", newState)
+	nodeInStore, err := factory.GetFornaxNodeCache(nm.nodeStore, util.Name(node))
+	if err != nil {
+		return err
+	}
+	if nodeInStore == nil {
+		return nil
+	}
+	if nodeInStore.Annotations == nil {
+		nodeInStore.Annotations = map[string]string{}
+	}
+	if len(newState) == 0 {
+		delete(nodeInStore.Annotations, fornaxv1.AnnotationFornaxCoreNodeDrainState)
+	} else {
+		nodeInStore.Spec.Unschedulable = true
+		nodeInStore.Annotations[fornaxv1.AnnotationFornaxCoreNodeDrainState] = newState
+	}
+	nodeInStore, err = factory.UpdateFornaxNode(nm.ctx, nm.nodeStore, nodeInStore)
+	if err != nil {
+		return err
+	}
+	fornaxNode.SetNode(nodeInStore)
+	nm.nodeUpdates <- &ie.NodeEvent{
+		Node: fornaxNode.GetNode().DeepCopy(),
+		Type: ie.NodeEventTypeUpdate,
+	}
+	return nil
+}
+
+// hasWorkloadPods check if there are still non daemon pods alive on node
+func (nm *nodeManager) hasWorkloadPods(fornaxNode *ie.FornaxNodeWithState) bool {
+	for _, podName := range fornaxNode.Pods.GetKeys() {
+		if _, found := fornaxNode.GetDaemonPods()[podName]; found {
+			continue
+		}
+		pod := nm.podManager.FindPod(podName)
+		if pod == nil {
+			continue
+		}
+		if _, found := pod.Labels[fornaxv1.LabelFornaxCoreNodeDaemon]; found {
+			continue
+		}
+		if util.PodNotTerminated(pod) {
+			return true
+		}
+	}
+	return false
+}
+
+func (nm *nodeManager) initNodeInformer(ctx context.Context) error {
+	wi, err := nm.nodeStore.WatchWithOldObj(ctx, fornaxk8sv1.FornaxNodeGrvKey, apistorage.ListOptions{
+		ResourceVersion:      "0",
+		ResourceVersionMatch: "",
+		Predicate:            apistorage.Everything,
+		Recursive:            true,
+		ProgressNotify:       true,
+	})
+	if err != nil {
+		return err
 	}
+	nm.nodeStoreUpdates = wi.ResultChanWithPrevobj()
 	return nil
 }
 
 func (nm *nodeManager) Run() error {
+	if err := nm.initNodeInformer(nm.ctx); err != nil {
+		return err
+	}
+	if err := nm.nodeDaemonManager.Run(); err != nil {
+		return err
+	}
+
 	go func() {
 		for {
 			select {
 			case <-nm.ctx.Done():
-				break
+				return
 			case update := <-nm.nodeUpdates:
 				for _, watcher := range nm.watchers {
 					watcher <- update
@@ -309,28 +552,62 @@ func (nm *nodeManager) Run() error {
 		}
 	}()
 
+	// handle cordon, taint and drain request from client, node lease expiration and node daemon rollout,
+	// node events are sent to nodeUpdates channel, use a separate routine to avoid blocking
+	go func() {
+		drainTicker := time.NewTicker(DefaultNodeDrainSyncPeriod)
+		defer drainTicker.Stop()
+		leaseTicker := time.NewTicker(DefaultNodeLeaseCheckPeriod)
+		defer leaseTicker.Stop()
+		daemonTicker := time.NewTicker(DefaultNodeDaemonSyncPeriod)
+		defer daemonTicker.Stop()
+		for {
+			select {
+			case <-nm.ctx.Done():
+				return
+			case we := <-nm.nodeStoreUpdates:
+				nm.onNodeEventFromStorage(we)
+			case <-drainTicker.C:
+				for _, fornaxNode := range nm.nodes.list() {
+					if util.IsNodeDrainRequested(fornaxNode.GetNode()) || len(util.GetNodeDrainState(fornaxNode.GetNode())) > 0 {
+						if err := nm.syncNodeDrainState(fornaxNode); err != nil {
+							klog.ErrorS(err, "Failed to sync node drain state", "node", fornaxNode.NodeId)
+						}
+					}
+				}
+			case <-leaseTicker.C:
+				nm.checkNodeLeases()
+			case <-daemonTicker.C:
+				nm.nodeDaemonManager.SyncNodeDaemons(nm.nodes.list())
+			}
+		}
+	}()
+
 	return nil
 }
 
 func (nm *nodeManager) PrintNodeSummary() {
 	klog.InfoS("node summary:", "#node", nm.nodes.length())
 	for _, v := range nm.nodes.list() {
-		klog.InfoS("node", "node", v.Node.Name, "state", v.State, "#pod", v.Pods.Len(), "#daemon pod", len(v.DaemonPods))
+		klog.InfoS("node", "node", v.GetNode().Name, "state", v.GetState(), "#pod", v.Pods.Len(), "#daemon pod", len(v.GetDaemonPods()))
 	}
 }
 
-func NewNodeManager(ctx context.Context, nodeStore fornaxstore.ApiStorageInterface, nodeAgent nodeagent.NodeAgentClient, podManager ie.PodManagerInterface, sessionManager ie.SessionManagerInterface) *nodeManager {
+func NewNodeManager(ctx context.Context, nodeStore fornaxstore.ApiStorageInterface, nodeDaemonStore fornaxstore.ApiStorageInterface, nodeAgent nodeagent.NodeAgentClient, podManager ie.PodManagerInterface, sessionManager ie.SessionManagerInterface, leasePolicy *NodeLeasePolicy, cidrPolicy *NodeCidrPolicy, eventRecorder record.EventRecorder) *nodeManager {
 	return &nodeManager{
-		ctx:                ctx,
-		nodeUpdates:        make(chan *ie.NodeEvent, 100),
-		watchers:           []chan<- *ie.NodeEvent{},
-		nodeStore:          nodeStore,
-		nodeAgent:          nodeAgent,
-		nodePodCidrManager: NewPodCidrManager(),
-		nodeDaemonManager:  NewNodeDaemonManager(),
-		houseKeepingTicker: time.NewTicker(DefaultStaleNodeTimeout),
-		podManager:         podManager,
-		sessionManager:     sessionManager,
+		ctx:                 ctx,
+		nodeUpdates:         make(chan *ie.NodeEvent, 100),
+		watchers:            []chan<- *ie.NodeEvent{},
+		nodeStore:           nodeStore,
+		nodeAgent:           nodeAgent,
+		nodePodCidrManager:  NewPodCidrManager(cidrPolicy),
+		nodeDaemonManager:   NewNodeDaemonManager(ctx, nodeDaemonStore, nodeAgent, podManager),
+		houseKeepingTicker:  time.NewTicker(DefaultStaleNodeTimeout),
+		podManager:          podManager,
+		sessionManager:      sessionManager,
+		leasePolicy:         leasePolicy,
+		eventRecorder:       eventRecorder,
+		removedNodeLostPods: map[string]*collection.ConcurrentStringSet{},
 		nodes: NodePool{
 			mu:    sync.RWMutex{},
 			nodes: map[string]*ie.FornaxNodeWithState{},
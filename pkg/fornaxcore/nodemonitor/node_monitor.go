@@ -130,7 +130,7 @@ func (nm *nodeMonitor) OnNodeRegistry(message *grpc.FornaxCoreMessage) (*grpc.Fo
 	}
 
 	daemons := []*v1.Pod{}
-	for _, v := range fornaxNode.DaemonPods {
+	for _, v := range fornaxNode.GetDaemonPods() {
 		daemons = append(daemons, v.DeepCopy())
 	}
 	// return node configuration back to node to initialize
@@ -138,7 +138,7 @@ func (nm *nodeMonitor) OnNodeRegistry(message *grpc.FornaxCoreMessage) (*grpc.Fo
 	nodeConig := grpc.FornaxCoreMessage_NodeConfiguration{
 		NodeConfiguration: &grpc.NodeConfiguration{
 			ClusterDomain: domain,
-			Node:          fornaxNode.Node.DeepCopy(),
+			Node:          fornaxNode.GetNode().DeepCopy(),
 			DaemonPods:    daemons,
 		},
 	}
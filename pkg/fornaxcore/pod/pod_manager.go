@@ -21,6 +21,7 @@ import (
 	"errors"
 	"time"
 
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/event"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc/nodeagent"
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/podscheduler"
@@ -29,6 +30,7 @@ import (
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 )
 
@@ -59,6 +61,7 @@ type podManager struct {
 	podStore        fornaxstore.ApiStorageInterface
 	podScheduler    podscheduler.PodScheduler
 	nodeAgentClient nodeagent.NodeAgentClient
+	eventRecorder   record.EventRecorder
 }
 
 func (pm *podManager) FindPod(podName string) *v1.Pod {
@@ -110,7 +113,11 @@ func (pm *podManager) DeletePod(pod *v1.Pod) (*v1.Pod, error) {
 	}
 
 	if util.PodIsRunning(podInStore) {
-		return nil, PodNotTerminatedYetError
+		if util.PodNotTerminated(pod) {
+			return nil, PodNotTerminatedYetError
+		}
+		// caller know pod is gone though node did not report it, e.g. node lost or node does not report it anymore
+		podInStore.Status = *pod.Status.DeepCopy()
 	}
 
 	// pod does not have deletion timestamp, set it
@@ -204,6 +211,28 @@ func (pm *podManager) HibernatePod(podName string) error {
 	return nil
 }
 
+// UpdatePodConfig send new config map to node agent which updates config projected into a running pod
+func (pm *podManager) UpdatePodConfig(podName string, configMap *v1.ConfigMap) error {
+	podInStore, err := factory.GetFornaxPodCache(pm.podStore, podName)
+	if err != nil {
+		return err
+	} else {
+		if podInStore == nil {
+			return PodNotFoundError
+		}
+	}
+
+	nodeId := util.GetPodFornaxNodeIdAnnotation(podInStore)
+	if len(nodeId) > 0 && util.PodNotTerminated(podInStore) {
+		err := pm.nodeAgentClient.UpdatePodConfig(nodeId, podInStore, configMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (pm *podManager) createPodAndSendEvent(pod *v1.Pod) (*v1.Pod, error) {
 	var eType ie.PodEventType
 	switch {
@@ -260,6 +289,9 @@ func (pm *podManager) AddOrUpdatePod(pod *v1.Pod) (*v1.Pod, error) {
 				return nil, err
 			}
 		}
+		if pod.Status.Phase == v1.PodFailed && podInStore.Status.Phase != v1.PodFailed {
+			pm.eventRecorder.Eventf(pod, v1.EventTypeWarning, event.ReasonPodFailed, "Pod failed on node %s, reason: %s, message: %s", nodeId, pod.Status.Reason, pod.Status.Message)
+		}
 		util.MergePod(pod, podInStore)
 		if util.PodIsTerminated(pod) {
 			factory.DeleteFornaxPod(pm.ctx, pm.podStore, util.Name(pod))
@@ -279,12 +311,13 @@ func (pm *podManager) AddOrUpdatePod(pod *v1.Pod) (*v1.Pod, error) {
 	}
 }
 
-func NewPodManager(ctx context.Context, podStore fornaxstore.ApiStorageInterface, nodeAgentProxy nodeagent.NodeAgentClient) *podManager {
+func NewPodManager(ctx context.Context, podStore fornaxstore.ApiStorageInterface, nodeAgentProxy nodeagent.NodeAgentClient, eventRecorder record.EventRecorder) *podManager {
 	return &podManager{
 		ctx:             ctx,
 		podUpdates:      make(chan *ie.PodEvent, 1000),
 		watchers:        []chan<- *ie.PodEvent{},
 		podStore:        podStore,
 		nodeAgentClient: nodeAgentProxy,
+		eventRecorder:   eventRecorder,
 	}
 }
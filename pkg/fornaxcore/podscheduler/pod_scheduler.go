@@ -22,16 +22,20 @@ import (
 	"math"
 	"math/rand"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	"centaurusinfra.io/fornax-serverless/pkg/collection"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/event"
 	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc/nodeagent"
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
+	"centaurusinfra.io/fornax-serverless/pkg/fornaxcore/metrics"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 )
 
@@ -52,11 +56,60 @@ const (
 )
 
 var (
-	PodIsDeletedError         = fmt.Errorf("pod has a deletion timestamp")
-	InsufficientResourceError = fmt.Errorf("can not find node with sufficient resources")
-	PodBindToNodeError        = fmt.Errorf("Pod bind to node error")
+	PodIsDeletedError  = fmt.Errorf("pod has a deletion timestamp")
+	PodBindToNodeError = fmt.Errorf("Pod bind to node error")
 )
 
+// NoNodeAvailableReason is unschedulable reason when there is no candidate node at all
+const NoNodeAvailableReason = "no_node"
+
+// UnschedulableError tell which mandatory condition no candidate node satisfied,
+// Reason is the condition most nodes failed, NodeReasons count failed nodes of each condition
+type UnschedulableError struct {
+	Reason      string
+	NodeReasons map[string]int
+}
+
+func (e *UnschedulableError) Error() string {
+	if len(e.NodeReasons) == 0 {
+		return "can not find any node"
+	}
+	reasons := []string{}
+	for k, v := range e.NodeReasons {
+		reasons = append(reasons, fmt.Sprintf("%d nodes %s", v, k))
+	}
+	sort.Strings(reasons)
+	return fmt.Sprintf("can not find node satisfying pod conditions, %s", strings.Join(reasons, ", "))
+}
+
+func newUnschedulableError(nodeReasons map[string]int) *UnschedulableError {
+	err := &UnschedulableError{Reason: NoNodeAvailableReason, NodeReasons: nodeReasons}
+	count := 0
+	for k, v := range nodeReasons {
+		// pick the reason failed most nodes, use name order when counts are same to have a stable label
+		if v > count || (v == count && k < err.Reason) {
+			err.Reason = k
+			count = v
+		}
+	}
+	return err
+}
+
+// unschedulableReason return reason label of unschedulable pods metric for a schedule error
+func unschedulableReason(err error) string {
+	if e, ok := err.(*UnschedulableError); ok {
+		return e.Reason
+	}
+	switch err {
+	case PodIsDeletedError:
+		return "pod_deleted"
+	case PodBindToNodeError:
+		return "bind_failed"
+	default:
+		return "unknown"
+	}
+}
+
 // we want to use more memory node, so, lager value are put ahead in sorted list
 func NodeHasMoreMemorySortFunc(pi, pj interface{}) bool {
 	piResource := pi.(*SchedulableNode).GetAllocatableResources()
@@ -147,6 +200,7 @@ type podScheduler struct {
 	ScheduleConditionBuilders []ConditionBuildFunc
 	policy                    *SchedulePolicy
 	nodeGroups                []*nodeGroup
+	eventRecorder             record.EventRecorder
 }
 
 // RemovePod remove a pod from scheduling queue
@@ -212,6 +266,7 @@ func (ps *podScheduler) bindNode(snode *SchedulableNode, pod *v1.Pod) error {
 		return err
 	}
 	snode.LastUsed = time.Now()
+	ps.eventRecorder.Eventf(pod, v1.EventTypeNormal, event.ReasonPodScheduled, "Successfully assigned %s to %s", podName, nodeId)
 
 	return nil
 }
@@ -239,13 +294,18 @@ func (ps *podScheduler) schedulePod(pod *v1.Pod, candidateNodes []*SchedulableNo
 	}
 
 	availableNodes := []*SchedulableNode{}
+	nodeReasons := map[string]int{}
 	conditions := CalculateScheduleConditions(ps.ScheduleConditionBuilders, pod)
 	for _, node := range candidateNodes {
 		allocatedResources := node.GetAllocatableResources()
 		goodNode := true
 		for _, cond := range conditions {
+			if !cond.Mandatory() {
+				continue
+			}
 			goodNode = goodNode && cond.Apply(node, &allocatedResources)
 			if !goodNode {
+				nodeReasons[cond.Reason()] += 1
 				break
 			}
 		}
@@ -255,7 +315,7 @@ func (ps *podScheduler) schedulePod(pod *v1.Pod, candidateNodes []*SchedulableNo
 	}
 
 	if len(availableNodes) == 0 {
-		return InsufficientResourceError
+		return newUnschedulableError(nodeReasons)
 	} else {
 		// sort candidates to use first one,
 		sortedNodes := &SortedNodes{
@@ -263,6 +323,7 @@ func (ps *podScheduler) schedulePod(pod *v1.Pod, candidateNodes []*SchedulableNo
 			lessFunc: BuildNodeSortingFunc(NodeSortingMethodLessLastUse),
 		}
 		sort.Sort(sortedNodes)
+		ps.sortNodesByPreference(sortedNodes.nodes, conditions)
 
 		var bindError error
 		for _, node := range sortedNodes.nodes {
@@ -281,6 +342,35 @@ func (ps *podScheduler) schedulePod(pod *v1.Pod, candidateNodes []*SchedulableNo
 	return nil
 }
 
+// sortNodesByPreference move nodes which have higher score of optional conditions ahead,
+// nodes with same score keep their order
+func (ps *podScheduler) sortNodesByPreference(nodes []*SchedulableNode, conditions []ScheduleCondition) {
+	preferences := []ScheduleCondition{}
+	for _, cond := range conditions {
+		if !cond.Mandatory() {
+			preferences = append(preferences, cond)
+		}
+	}
+	if len(preferences) == 0 {
+		return
+	}
+
+	scores := map[string]int64{}
+	for _, node := range nodes {
+		allocatedResources := node.GetAllocatableResources()
+		score := int64(0)
+		for _, cond := range preferences {
+			if cond.Apply(node, &allocatedResources) {
+				score += cond.Score(node, &allocatedResources)
+			}
+		}
+		scores[node.NodeName] = score
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return scores[nodes[i].NodeName] > scores[nodes[j].NodeName]
+	})
+}
+
 func (ps *podScheduler) updateNodePool(v1node *v1.Node, updateType ie.NodeEventType) *SchedulableNode {
 	nodeName := util.Name(v1node)
 	if updateType == ie.NodeEventTypeDelete {
@@ -289,6 +379,8 @@ func (ps *podScheduler) updateNodePool(v1node *v1.Node, updateType ie.NodeEventT
 	} else {
 		if snode := ps.nodePool.GetNode(nodeName); snode != nil {
 			snode.LastSeen = time.Now()
+			// node labels could be changed, keep latest node to match node selector and affinity
+			snode.Node = v1node.DeepCopy()
 			if !util.IsNodeRunning(v1node) {
 				ps.nodePool.DeleteNode(nodeName)
 			}
@@ -326,6 +418,8 @@ func (ps *podScheduler) updatePodOccupiedResourceList(snode *SchedulableNode, po
 
 func (ps *podScheduler) printScheduleSummary() {
 	activeNum, retryNum := ps.scheduleQueue.Length()
+	metrics.ScheduleQueuePods.WithLabelValues(metrics.ScheduleQueueActive).Set(float64(activeNum))
+	metrics.ScheduleQueuePods.WithLabelValues(metrics.ScheduleQueueBackoff).Set(float64(retryNum))
 	klog.InfoS("Scheduler summary", "active queue length", activeNum, "backoff queue length", retryNum, "available nodes", ps.nodePool.size(), "node groups", len(ps.nodeGroups))
 	// ps.nodePool.printSummary()
 }
@@ -384,6 +478,7 @@ func (ps *podScheduler) scheduleLoop() {
 				i := rand.Intn(len(nodeGroups))
 				wg.Add(1)
 				go func(pod *v1.Pod, index int) {
+					st := time.Now()
 					var schedErr error
 					for i := 0; i < numOfScheduler; i++ {
 						nodeGroup := nodeGroups[(index+i)%numOfScheduler]
@@ -395,6 +490,13 @@ func (ps *podScheduler) scheduleLoop() {
 					if schedErr != nil {
 						klog.ErrorS(schedErr, "Can not find node for pod, come back later", "pod", util.Name(pod), "required resource", util.GetPodResourceList(pod))
 						ps.scheduleQueue.BackoffPod(pod, ps.policy.BackoffDuration)
+						metrics.ScheduleDuration.WithLabelValues(metrics.ScheduleResultUnschedulable).Observe(metrics.SinceInSeconds(st))
+						metrics.UnschedulablePods.WithLabelValues(unschedulableReason(schedErr)).Inc()
+						if schedErr != PodIsDeletedError {
+							ps.eventRecorder.Eventf(pod, v1.EventTypeWarning, event.ReasonPodUnschedulable, "Failed to schedule pod: %v", schedErr)
+						}
+					} else {
+						metrics.ScheduleDuration.WithLabelValues(metrics.ScheduleResultScheduled).Observe(metrics.SinceInSeconds(st))
 					}
 					wg.Done()
 				}(pod, i)
@@ -461,7 +563,7 @@ func (ps *podScheduler) Run() {
 	go ps.scheduleLoop()
 }
 
-func NewPodScheduler(ctx context.Context, nodeAgent nodeagent.NodeAgentClient, nodeInfoP ie.NodeInfoLWInterface, podInfoP ie.PodInfoLWInterface, policy *SchedulePolicy) *podScheduler {
+func NewPodScheduler(ctx context.Context, nodeAgent nodeagent.NodeAgentClient, nodeInfoP ie.NodeInfoLWInterface, podInfoP ie.PodInfoLWInterface, eventRecorder record.EventRecorder, policy *SchedulePolicy) *podScheduler {
 	ps := &podScheduler{
 		ctx:             ctx,
 		stop:            false,
@@ -478,9 +580,19 @@ func NewPodScheduler(ctx context.Context, nodeAgent nodeagent.NodeAgentClient, n
 		ScheduleConditionBuilders: []ConditionBuildFunc{
 			NewPodCPUCondition,
 			NewPodMemoryCondition,
+			NewExclusiveCPUCondition,
+			NewNodeNameCondition,
+			NewNodeSelectorCondition,
+			NewNodeAffinityCondition,
+			NewPreferredNodeAffinityCondition,
+			NewRuntimeHandlerCondition,
+			NewNodeUnschedulableCondition,
+			NewTaintTolerationCondition,
+			NewPreferNoScheduleTaintCondition,
 		},
-		policy:     policy,
-		nodeGroups: []*nodeGroup{},
+		policy:        policy,
+		nodeGroups:    []*nodeGroup{},
+		eventRecorder: eventRecorder,
 	}
 	nodeInfoP.Watch(ps.nodeUpdateCh)
 	podInfoP.Watch(ps.podUpdateCh)
@@ -16,9 +16,15 @@ limitations under the License.
 package podscheduler
 
 import (
+	"fmt"
+	"strings"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	podutil "centaurusinfra.io/fornax-serverless/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 )
 
 var (
@@ -28,6 +34,8 @@ var (
 
 type ScheduleCondition interface {
 	Mandatory() bool
+	// Reason tell why pod is unschedulable when no node satisfy condition, it's used as metric label
+	Reason() string
 	Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool
 	Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64
 }
@@ -37,8 +45,10 @@ type ConditionBuildFunc func(*v1.Pod) ScheduleCondition
 func CalculateScheduleConditions(condBuildFuncs []ConditionBuildFunc, pod *v1.Pod) []ScheduleCondition {
 	conditions := []ScheduleCondition{}
 	for _, v := range condBuildFuncs {
-		condition := v(pod)
-		conditions = append(conditions, condition)
+		// builder return nil if pod does not ask for this condition
+		if condition := v(pod); condition != nil {
+			conditions = append(conditions, condition)
+		}
 	}
 	return conditions
 }
@@ -55,6 +65,11 @@ func (*CPUCondition) Mandatory() bool {
 	return true
 }
 
+// Reason implements ScheduleCondition
+func (*CPUCondition) Reason() string {
+	return "insufficient_cpu"
+}
+
 // check if node stastify cpu requirement
 func (cond *CPUCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
 	cpu := allocatableResourceList.Cpu()
@@ -93,6 +108,11 @@ func (*MemoryCondition) Mandatory() bool {
 	return true
 }
 
+// Reason of memory condition
+func (*MemoryCondition) Reason() string {
+	return "insufficient_memory"
+}
+
 // check if node stastify memory requirement
 func (cond *MemoryCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
 	memory := allocatableResourceList.Memory()
@@ -131,6 +151,11 @@ func (*StorageCondition) Mandatory() bool {
 	return false
 }
 
+// Reason implements ScheduleCondition
+func (*StorageCondition) Reason() string {
+	return "insufficient_storage"
+}
+
 // check if node stastify storage requirement
 func (cond *StorageCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
 	storage := allocatableResourceList.Storage()
@@ -157,32 +182,432 @@ func NewStorageCondition(pod *v1.Pod) ScheduleCondition {
 
 }
 
-type NodeNameCondition struct {
+// ExclusiveCPUCondition require node has enough cpus which are not pinned to other pods yet
+type ExclusiveCPUCondition struct {
 	Name             string
 	ResourceQuantity resource.Quantity
 }
 
-func (*NodeNameCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
-	panic("unimplemented")
+// Mandatory of exclusive cpu condition, true always
+func (*ExclusiveCPUCondition) Mandatory() bool {
+	return true
+}
+
+// Reason of exclusive cpu condition
+func (*ExclusiveCPUCondition) Reason() string {
+	return "insufficient_exclusive_cpu"
 }
 
+// check if node stastify exclusive cpu requirement, pod can use all remaining exclusive cpus
+func (cond *ExclusiveCPUCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	cpu := allocatableResourceList.Name(fornaxv1.ResourceFornaxCoreExclusiveCPU, resource.DecimalSI)
+	return cpu.Cmp(cond.ResourceQuantity) >= 0
+}
+
+// calc score of exclusive cpu condition
+func (cond *ExclusiveCPUCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	cpu := *allocatableResourceList.Name(fornaxv1.ResourceFornaxCoreExclusiveCPU, resource.DecimalSI)
+	cpu.Sub(cond.ResourceQuantity)
+	return cpu.Value()
+}
+
+func NewExclusiveCPUCondition(pod *v1.Pod) ScheduleCondition {
+	resourceList := podutil.GetPodResourceList(pod)
+	if cpu, found := (*resourceList)[fornaxv1.ResourceFornaxCoreExclusiveCPU]; found && cpu.Sign() > 0 {
+		return &ExclusiveCPUCondition{
+			Name:             "ExclusiveCPU",
+			ResourceQuantity: cpu,
+		}
+	}
+	return nil
+}
+
+// NodeNameCondition pin pod to the node set in pod.Spec.NodeName
+type NodeNameCondition struct {
+	Name     string
+	NodeName string
+}
+
+// Mandatory of node name condition, true always
 func (*NodeNameCondition) Mandatory() bool {
-	panic("unimplemented")
+	return true
+}
+
+// Reason of node name condition
+func (*NodeNameCondition) Reason() string {
+	return "node_name"
+}
+
+// check if node is the node pod asked for
+func (cond *NodeNameCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	return node.NodeName == cond.NodeName
 }
 
+// node name does not make a node better than others
 func (*NodeNameCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
-	panic("unimplemented")
+	return 0
 }
 
 func NewNodeNameCondition(pod *v1.Pod) ScheduleCondition {
-	resource := podutil.GetPodResourceList(pod).Cpu()
-	if resource.Sign() > 0 {
+	if len(pod.Spec.NodeName) > 0 {
 		return &NodeNameCondition{
-			Name:             "NodeName",
-			ResourceQuantity: *resource,
+			Name:     "NodeName",
+			NodeName: pod.Spec.NodeName,
+		}
+	} else {
+		return nil
+	}
+}
+
+// NodeSelectorCondition require node labels match all key/value pairs in pod.Spec.NodeSelector
+type NodeSelectorCondition struct {
+	Name     string
+	Selector labels.Selector
+}
+
+// Mandatory of node selector condition, true always
+func (*NodeSelectorCondition) Mandatory() bool {
+	return true
+}
+
+// Reason of node selector condition
+func (*NodeSelectorCondition) Reason() string {
+	return "node_selector"
+}
+
+// check if node labels match pod node selector
+func (cond *NodeSelectorCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	return cond.Selector.Matches(labels.Set(node.Node.Labels))
+}
+
+// node selector does not make a node better than others
+func (*NodeSelectorCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	return 0
+}
+
+func NewNodeSelectorCondition(pod *v1.Pod) ScheduleCondition {
+	if len(pod.Spec.NodeSelector) > 0 {
+		return &NodeSelectorCondition{
+			Name:     "NodeSelector",
+			Selector: labels.SelectorFromSet(pod.Spec.NodeSelector),
 		}
 	} else {
 		return nil
 	}
+}
+
+// NodeAffinityCondition require node match at least one term of RequiredDuringSchedulingIgnoredDuringExecution node affinity,
+// node label changes after pod scheduled do not move pod
+type NodeAffinityCondition struct {
+	Name  string
+	Terms []v1.NodeSelectorTerm
+}
+
+// Mandatory of required node affinity condition, true always
+func (*NodeAffinityCondition) Mandatory() bool {
+	return true
+}
+
+// Reason of required node affinity condition
+func (*NodeAffinityCondition) Reason() string {
+	return "node_affinity"
+}
 
+// check if node match any of required node selector terms
+func (cond *NodeAffinityCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	for _, term := range cond.Terms {
+		if nodeMatchSelectorTerm(node, &term) {
+			return true
+		}
+	}
+	return false
+}
+
+// required node affinity does not make a node better than others
+func (*NodeAffinityCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	return 0
+}
+
+func NewNodeAffinityCondition(pod *v1.Pod) ScheduleCondition {
+	if pod.Spec.Affinity == nil || pod.Spec.Affinity.NodeAffinity == nil || pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return nil
+	}
+	terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		return nil
+	}
+	return &NodeAffinityCondition{
+		Name:  "NodeAffinity",
+		Terms: terms,
+	}
+}
+
+// PreferredNodeAffinityCondition prefer nodes match PreferredDuringSchedulingIgnoredDuringExecution node affinity,
+// node get score of sum of weights of matched terms, node match none of terms can still be used
+type PreferredNodeAffinityCondition struct {
+	Name  string
+	Terms []v1.PreferredSchedulingTerm
+}
+
+// Mandatory of preferred node affinity condition, false always
+func (*PreferredNodeAffinityCondition) Mandatory() bool {
+	return false
+}
+
+// Reason of preferred node affinity condition
+func (*PreferredNodeAffinityCondition) Reason() string {
+	return "preferred_node_affinity"
+}
+
+// check if node match any of preferred node selector terms
+func (cond *PreferredNodeAffinityCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	return cond.Score(node, allocatableResourceList) > 0
+}
+
+// calc score of preferred node affinity condition
+func (cond *PreferredNodeAffinityCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	score := int64(0)
+	for _, term := range cond.Terms {
+		if term.Weight > 0 && nodeMatchSelectorTerm(node, &term.Preference) {
+			score += int64(term.Weight)
+		}
+	}
+	return score
+}
+
+func NewPreferredNodeAffinityCondition(pod *v1.Pod) ScheduleCondition {
+	if pod.Spec.Affinity == nil || pod.Spec.Affinity.NodeAffinity == nil {
+		return nil
+	}
+	terms := pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	if len(terms) == 0 {
+		return nil
+	}
+	return &PreferredNodeAffinityCondition{
+		Name:  "PreferredNodeAffinity",
+		Terms: terms,
+	}
+}
+
+// nodeMatchSelectorTerm check node labels and fields against a node selector term,
+// requirements in a term are ANDed, a empty term or invalid requirement match nothing
+func nodeMatchSelectorTerm(node *SchedulableNode, term *v1.NodeSelectorTerm) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	if len(term.MatchExpressions) > 0 {
+		selector, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions)
+		if err != nil || !selector.Matches(labels.Set(node.Node.Labels)) {
+			return false
+		}
+	}
+	if len(term.MatchFields) > 0 {
+		selector, err := nodeSelectorRequirementsAsSelector(term.MatchFields)
+		if err != nil || !selector.Matches(labels.Set{"metadata.name": node.NodeName}) {
+			return false
+		}
+	}
+	return true
+}
+
+func nodeSelectorRequirementsAsSelector(requirements []v1.NodeSelectorRequirement) (labels.Selector, error) {
+	selector := labels.NewSelector()
+	for _, expr := range requirements {
+		var op selection.Operator
+		switch expr.Operator {
+		case v1.NodeSelectorOpIn:
+			op = selection.In
+		case v1.NodeSelectorOpNotIn:
+			op = selection.NotIn
+		case v1.NodeSelectorOpExists:
+			op = selection.Exists
+		case v1.NodeSelectorOpDoesNotExist:
+			op = selection.DoesNotExist
+		case v1.NodeSelectorOpGt:
+			op = selection.GreaterThan
+		case v1.NodeSelectorOpLt:
+			op = selection.LessThan
+		default:
+			return nil, fmt.Errorf("%q is not a valid node selector operator", expr.Operator)
+		}
+		r, err := labels.NewRequirement(expr.Key, op, expr.Values)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*r)
+	}
+	return selector, nil
+}
+
+// RuntimeHandlerCondition require node advertise runtime handler of pod runtime class
+type RuntimeHandlerCondition struct {
+	Name           string
+	RuntimeHandler string
+}
+
+// Mandatory of runtime handler condition, true always
+func (*RuntimeHandlerCondition) Mandatory() bool {
+	return true
+}
+
+// Reason of runtime handler condition
+func (*RuntimeHandlerCondition) Reason() string {
+	return "runtime_handler"
+}
+
+// check if runtime handler is in node runtime handlers annotation
+func (cond *RuntimeHandlerCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	for _, v := range strings.Split(node.Node.Annotations[fornaxv1.AnnotationFornaxCoreNodeRuntimeHandlers], ",") {
+		if v == cond.RuntimeHandler {
+			return true
+		}
+	}
+	return false
+}
+
+// runtime handler does not make a node better than others
+func (*RuntimeHandlerCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	return 0
+}
+
+// NewRuntimeHandlerCondition return nil if pod does not have a runtime class, it can run with default runtime handler of any node
+func NewRuntimeHandlerCondition(pod *v1.Pod) ScheduleCondition {
+	if pod.Spec.RuntimeClassName != nil && len(*pod.Spec.RuntimeClassName) > 0 {
+		return &RuntimeHandlerCondition{
+			Name:           "RuntimeHandler",
+			RuntimeHandler: *pod.Spec.RuntimeClassName,
+		}
+	} else {
+		return nil
+	}
+}
+
+// NodeUnschedulableCondition exclude cordoned node, unless pod tolerate node.kubernetes.io/unschedulable taint
+type NodeUnschedulableCondition struct {
+	Name string
+}
+
+// Mandatory of node unschedulable condition, true always
+func (*NodeUnschedulableCondition) Mandatory() bool {
+	return true
+}
+
+// Reason of node unschedulable condition
+func (*NodeUnschedulableCondition) Reason() string {
+	return "node_unschedulable"
+}
+
+// check if node is not cordoned
+func (*NodeUnschedulableCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	return !node.Node.Spec.Unschedulable
+}
+
+// node unschedulable does not make a node better than others
+func (*NodeUnschedulableCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	return 0
+}
+
+func NewNodeUnschedulableCondition(pod *v1.Pod) ScheduleCondition {
+	unschedulableTaint := &v1.Taint{
+		Key:    v1.TaintNodeUnschedulable,
+		Effect: v1.TaintEffectNoSchedule,
+	}
+	for _, toleration := range pod.Spec.Tolerations {
+		if toleration.ToleratesTaint(unschedulableTaint) {
+			return nil
+		}
+	}
+	return &NodeUnschedulableCondition{
+		Name: "NodeUnschedulable",
+	}
+}
+
+// TaintTolerationCondition require pod tolerate all NoSchedule and NoExecute taints of node
+type TaintTolerationCondition struct {
+	Name        string
+	Tolerations []v1.Toleration
+}
+
+// Mandatory of taint toleration condition, true always
+func (*TaintTolerationCondition) Mandatory() bool {
+	return true
+}
+
+// Reason of taint toleration condition
+func (*TaintTolerationCondition) Reason() string {
+	return "taint_toleration"
+}
+
+// check if pod tolerate all NoSchedule and NoExecute taints of node
+func (cond *TaintTolerationCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	for _, taint := range node.Node.Spec.Taints {
+		if taint.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !tolerationsTolerateTaint(cond.Tolerations, &taint) {
+			return false
+		}
+	}
+	return true
+}
+
+// taint toleration does not make a node better than others, PreferNoSchedule taints are scored by PreferNoScheduleTaintCondition
+func (*TaintTolerationCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	return 0
+}
+
+func NewTaintTolerationCondition(pod *v1.Pod) ScheduleCondition {
+	return &TaintTolerationCondition{
+		Name:        "TaintToleration",
+		Tolerations: pod.Spec.Tolerations,
+	}
+}
+
+// PreferNoScheduleTaintCondition avoid nodes which have PreferNoSchedule taints not tolerated by pod,
+// node get negative score of num of these taints, and still can be used if no better node
+type PreferNoScheduleTaintCondition struct {
+	Name        string
+	Tolerations []v1.Toleration
+}
+
+// Mandatory of prefer no schedule taint condition, false always
+func (*PreferNoScheduleTaintCondition) Mandatory() bool {
+	return false
+}
+
+// Reason of prefer no schedule taint condition
+func (*PreferNoScheduleTaintCondition) Reason() string {
+	return "prefer_no_schedule_taint"
+}
+
+// any node can be used
+func (*PreferNoScheduleTaintCondition) Apply(node *SchedulableNode, allocatableResourceList *v1.ResourceList) bool {
+	return true
+}
+
+// calc score of prefer no schedule taint condition
+func (cond *PreferNoScheduleTaintCondition) Score(node *SchedulableNode, allocatableResourceList *v1.ResourceList) int64 {
+	score := int64(0)
+	for _, taint := range node.Node.Spec.Taints {
+		if taint.Effect == v1.TaintEffectPreferNoSchedule && !tolerationsTolerateTaint(cond.Tolerations, &taint) {
+			score -= 1
+		}
+	}
+	return score
+}
+
+func NewPreferNoScheduleTaintCondition(pod *v1.Pod) ScheduleCondition {
+	return &PreferNoScheduleTaintCondition{
+		Name:        "PreferNoScheduleTaint",
+		Tolerations: pod.Spec.Tolerations,
+	}
+}
+
+func tolerationsTolerateTaint(tolerations []v1.Toleration, taint *v1.Taint) bool {
+	for _, toleration := range tolerations {
+		if toleration.ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
 }
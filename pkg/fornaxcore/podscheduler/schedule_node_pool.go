@@ -20,9 +20,11 @@ import (
 	"sync"
 	"time"
 
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	"centaurusinfra.io/fornax-serverless/pkg/collection"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
 )
 
@@ -62,6 +64,15 @@ func (snode *SchedulableNode) AdmitPodOccupiedResourceList(resourceList *v1.Reso
 		nodeStorage.Set(0)
 	}
 	snode.PodPreOccupiedResourceList[v1.ResourceStorage] = nodeStorage
+
+	if exclusiveCpu, found := (*resourceList)[fornaxv1.ResourceFornaxCoreExclusiveCPU]; found {
+		nodeExclusiveCpu := snode.PodPreOccupiedResourceList.Name(fornaxv1.ResourceFornaxCoreExclusiveCPU, resource.DecimalSI).DeepCopy()
+		nodeExclusiveCpu.Add(exclusiveCpu)
+		if nodeExclusiveCpu.Sign() <= 0 {
+			nodeExclusiveCpu.Set(0)
+		}
+		snode.PodPreOccupiedResourceList[fornaxv1.ResourceFornaxCoreExclusiveCPU] = nodeExclusiveCpu
+	}
 	snode.mu.Unlock()
 }
 
@@ -93,6 +104,14 @@ func (snode *SchedulableNode) GetAllocatableResources() v1.ResourceList {
 	}
 	allocatedResources[v1.ResourceStorage] = nodeStorage
 
+	nodeExclusiveCpu := snode.ResourceList.Name(fornaxv1.ResourceFornaxCoreExclusiveCPU, resource.DecimalSI).DeepCopy()
+	exclusiveCpu := snode.PodPreOccupiedResourceList.Name(fornaxv1.ResourceFornaxCoreExclusiveCPU, resource.DecimalSI).DeepCopy()
+	nodeExclusiveCpu.Sub(exclusiveCpu)
+	if nodeExclusiveCpu.Sign() <= 0 {
+		nodeExclusiveCpu.Set(0)
+	}
+	allocatedResources[fornaxv1.ResourceFornaxCoreExclusiveCPU] = nodeExclusiveCpu
+
 	snode.mu.Unlock()
 	return allocatedResources
 }
@@ -122,6 +141,15 @@ func (snode *SchedulableNode) ReleasePodOccupiedResourceList(resourceList *v1.Re
 		nodeStorage.Set(0)
 	}
 	snode.PodPreOccupiedResourceList[v1.ResourceStorage] = nodeStorage
+
+	if exclusiveCpu, found := (*resourceList)[fornaxv1.ResourceFornaxCoreExclusiveCPU]; found {
+		nodeExclusiveCpu := snode.PodPreOccupiedResourceList.Name(fornaxv1.ResourceFornaxCoreExclusiveCPU, resource.DecimalSI).DeepCopy()
+		nodeExclusiveCpu.Sub(exclusiveCpu)
+		if nodeExclusiveCpu.Sign() <= 0 {
+			nodeExclusiveCpu.Set(0)
+		}
+		snode.PodPreOccupiedResourceList[fornaxv1.ResourceFornaxCoreExclusiveCPU] = nodeExclusiveCpu
+	}
 	snode.mu.Unlock()
 }
 
@@ -218,5 +246,11 @@ func GetNodeAllocatableResourceList(node *v1.Node) v1.ResourceList {
 		resourceList[v1.ResourceStorage] = util.ResourceQuantity(0, v1.ResourceStorage)
 	}
 
+	if exclusiveCpu := res.Name(fornaxv1.ResourceFornaxCoreExclusiveCPU, resource.DecimalSI); exclusiveCpu.Sign() > 0 {
+		resourceList[fornaxv1.ResourceFornaxCoreExclusiveCPU] = *exclusiveCpu
+	} else {
+		resourceList[fornaxv1.ResourceFornaxCoreExclusiveCPU] = util.ResourceQuantity(0, fornaxv1.ResourceFornaxCoreExclusiveCPU)
+	}
+
 	return resourceList
 }
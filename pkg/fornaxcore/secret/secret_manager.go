@@ -0,0 +1,70 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"fmt"
+	"sort"
+
+	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
+	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
+	storefactory "centaurusinfra.io/fornax-serverless/pkg/store/factory"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ ie.PodSecretProvider = &secretManager{}
+
+// secretManager look up secrets in secret store, only secrets referenced by a pod are sent to node with the pod
+type secretManager struct {
+	secretStore fornaxstore.ApiStorageInterface
+}
+
+func NewSecretManager(secretStore fornaxstore.ApiStorageInterface) *secretManager {
+	return &secretManager{
+		secretStore: secretStore,
+	}
+}
+
+// GetPodSecrets implements ie.PodSecretProvider, secrets are looked up in pod namespace,
+// it return error if a secret which is not optional does not exist, optional secrets not found are skipped
+func (sm *secretManager) GetPodSecrets(pod *v1.Pod) ([]*v1.Secret, error) {
+	names := util.GetPodSecretNames(pod)
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	secrets := []*v1.Secret{}
+	for _, name := range sortedNames {
+		secretName := fmt.Sprintf("%s/%s", pod.Namespace, name)
+		secret, err := storefactory.GetFornaxSecretCache(sm.secretStore, secretName)
+		if err != nil {
+			return nil, err
+		}
+		if secret == nil {
+			if names[name] {
+				continue
+			}
+			return nil, fmt.Errorf("secret %s referenced by pod %s not found", secretName, util.Name(pod))
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"fmt"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reasons of session conditions
+const (
+	ConditionReasonWaitingForPod    = "WaitingForPod"
+	ConditionReasonScheduledToPod   = "ScheduledToPod"
+	ConditionReasonWaitingForStart  = "WaitingForSessionStart"
+	ConditionReasonSessionStarted   = "SessionStarted"
+	ConditionReasonSessionAvailable = "SessionAvailable"
+	ConditionReasonSessionInUse     = "SessionInUse"
+	ConditionReasonSessionTimeout   = "SessionTimeout"
+	ConditionReasonSessionClosing   = "SessionClosing"
+	ConditionReasonSessionClosed    = "SessionClosed"
+)
+
+// setSessionConditions derive Scheduled, Assigned and Ready conditions from session status and pod annotation,
+// node agent report session status without conditions, conditions of a session are only maintained by fornaxcore,
+// Scheduled and Assigned keep last value when session is closed or timed out, they tell how far a session went
+func setSessionConditions(session *fornaxv1.ApplicationSession) {
+	status := &session.Status
+	generation := session.Generation
+	podName := session.Annotations[fornaxv1.AnnotationFornaxCorePod]
+
+	scheduled := metav1.Condition{Type: fornaxv1.SessionConditionScheduled, ObservedGeneration: generation}
+	assigned := metav1.Condition{Type: fornaxv1.SessionConditionAssigned, ObservedGeneration: generation}
+	ready := metav1.Condition{Type: fornaxv1.SessionConditionReady, ObservedGeneration: generation}
+	waitingForPod := func(c *metav1.Condition) {
+		c.Status, c.Reason, c.Message = metav1.ConditionFalse, ConditionReasonWaitingForPod, "session is waiting for a available pod"
+	}
+	scheduledToPod := func(c *metav1.Condition) {
+		c.Status, c.Reason, c.Message = metav1.ConditionTrue, ConditionReasonScheduledToPod, fmt.Sprintf("session is sent to pod %s", podName)
+	}
+	sessionStarted := func(c *metav1.Condition) {
+		c.Status, c.Reason, c.Message = metav1.ConditionTrue, ConditionReasonSessionStarted, fmt.Sprintf("pod %s reported session started", podName)
+	}
+	keepOr := func(c *metav1.Condition, fallback func(*metav1.Condition)) {
+		if existing := meta.FindStatusCondition(status.Conditions, c.Type); existing != nil {
+			c.Status, c.Reason, c.Message = existing.Status, existing.Reason, existing.Message
+		} else {
+			fallback(c)
+		}
+	}
+
+	switch status.SessionStatus {
+	case fornaxv1.SessionStatusUnspecified, fornaxv1.SessionStatusPending:
+		waitingForPod(&scheduled)
+		waitingForPod(&assigned)
+		waitingForPod(&ready)
+	case fornaxv1.SessionStatusStarting:
+		scheduledToPod(&scheduled)
+		assigned.Status, assigned.Reason, assigned.Message = metav1.ConditionFalse, ConditionReasonWaitingForStart, fmt.Sprintf("waiting for pod %s to start session", podName)
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, ConditionReasonWaitingForStart, assigned.Message
+	case fornaxv1.SessionStatusAvailable, fornaxv1.SessionStatusInUse:
+		scheduledToPod(&scheduled)
+		sessionStarted(&assigned)
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionTrue, ConditionReasonSessionAvailable, "session is available for client"
+		if status.SessionStatus == fornaxv1.SessionStatusInUse {
+			ready.Reason, ready.Message = ConditionReasonSessionInUse, "session is used by client"
+		}
+	case fornaxv1.SessionStatusClosing:
+		scheduledToPod(&scheduled)
+		sessionStarted(&assigned)
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, ConditionReasonSessionClosing, "session is closing, wait for session client exit"
+	case fornaxv1.SessionStatusTimeout:
+		keepOr(&scheduled, waitingForPod)
+		keepOr(&assigned, waitingForPod)
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, ConditionReasonSessionTimeout, "session is timed out"
+	case fornaxv1.SessionStatusClosed:
+		keepOr(&scheduled, scheduledToPod)
+		keepOr(&assigned, sessionStarted)
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, ConditionReasonSessionClosed, "session is closed"
+		if len(status.Reason) > 0 {
+			ready.Reason = status.Reason
+		}
+		if len(status.Message) > 0 {
+			ready.Message = status.Message
+		}
+	}
+
+	meta.SetStatusCondition(&status.Conditions, scheduled)
+	meta.SetStatusCondition(&status.Conditions, assigned)
+	meta.SetStatusCondition(&status.Conditions, ready)
+}
@@ -26,10 +26,13 @@ import (
 	ie "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/internal"
 	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
 	storefactory "centaurusinfra.io/fornax-serverless/pkg/store/factory"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
+	"go.opentelemetry.io/otel/attribute"
 	apistorage "k8s.io/apiserver/pkg/storage"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ ie.SessionManagerInterface = &sessionManager{}
@@ -68,6 +71,13 @@ func (sm *sessionManager) OnSessionStatusFromNode(pod *v1.Pod, session *fornaxv1
 			session.DeletionTimestamp = storeCopy.DeletionTimestamp
 			sm.CloseSession(pod, session)
 		}
+		if util.SessionInTerminalState(storeCopy) && !util.SessionInTerminalState(session) {
+			// session was timed out when its node was lost, node come back should not reopen it
+			if util.SessionIsOpen(session) {
+				sm.CloseSession(pod, session)
+			}
+			return nil
+		}
 		// set available and close time received in fornax core, for perf benchmark
 		if session.Status.SessionStatus == fornaxv1.SessionStatusAvailable {
 			session.Status.AvailableTime = util.NewCurrentMetaTimeNormallized()
@@ -94,9 +104,13 @@ func (sm *sessionManager) CloseSession(pod *v1.Pod, session *fornaxv1.Applicatio
 	}
 }
 
-func (sm *sessionManager) OpenSession(pod *v1.Pod, session *fornaxv1.ApplicationSession) error {
+func (sm *sessionManager) OpenSession(ctx context.Context, pod *v1.Pod, session *fornaxv1.ApplicationSession) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "SessionManager.OpenSession", attribute.String("session", util.Name(session)))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	if nodeName, found := pod.GetAnnotations()[fornaxv1.AnnotationFornaxCoreNode]; found {
-		return sm.nodeAgentClient.OpenSession(nodeName, pod, session)
+		span.SetAttributes(attribute.String("node", nodeName))
+		return sm.nodeAgentClient.OpenSession(ctx, nodeName, pod, session)
 	} else {
 		return fmt.Errorf("Can not find which node session is on, %s", util.Name(session))
 	}
@@ -140,9 +154,13 @@ func (sm *sessionManager) UpdateSessionStatus(session *fornaxv1.ApplicationSessi
 	return updateErr
 }
 
-// attempts to update the Status of the given Application Session name
+// attempts to update the Status of the given Application Session name,
+// conditions are carried over from current session and updated according new session status
 func setSessionStatus(session *fornaxv1.ApplicationSession, newStatus *fornaxv1.ApplicationSessionStatus) *fornaxv1.ApplicationSession {
+	conditions := append([]metav1.Condition{}, session.Status.Conditions...)
 	session.Status = *newStatus
+	session.Status.Conditions = conditions
+	setSessionConditions(session)
 	if util.SessionIsOpen(session) {
 		util.AddFinalizer(&session.ObjectMeta, fornaxv1.FinalizerOpenSession)
 	} else {
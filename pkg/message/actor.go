@@ -62,6 +62,14 @@ func (a *LocalChannelActorRef) Receive(msg ActorMessage) error {
 	return err
 }
 
+// MailboxLength return number of messages waiting in a local channel actor's mailbox, it's zero for other actor refs
+func MailboxLength(ref ActorRef) int {
+	if local, ok := ref.(*LocalChannelActorRef); ok && local.Channel != nil {
+		return len(*local.Channel)
+	}
+	return 0
+}
+
 type Actor interface {
 	Start()
 	Stop()
@@ -34,6 +34,7 @@ import (
 	"github.com/google/cadvisor/manager"
 	"github.com/google/cadvisor/utils/sysfs"
 	"k8s.io/klog/v2"
+	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
 )
 
 var _ CAdvisorInfoProvider = &cadvisorInfoProvider{}
@@ -139,11 +140,8 @@ func NewCAdvisorInfoProvider(
 	}, nil
 }
 
+// Start periodically collect node cadvisor info and send it to receivers, real cadvisor manager is already started when provider is created
 func (cc *cadvisorInfoProvider) Start() error {
-	if err := cc.realCAdvisor.Start(); err != nil {
-		return err
-	}
-
 	go func() {
 		ticker := time.NewTicker(cc.nodeInfoInterval)
 		for {
@@ -158,7 +156,7 @@ func (cc *cadvisorInfoProvider) Start() error {
 
 				panicReceivers := make(map[string]bool)
 				for n, r := range cc.receivers {
-					klog.Infof("send node cavisor info to receiver %s", n)
+					klog.V(5).Infof("send node cavisor info to receiver %s", n)
 					func() {
 						defer func() {
 							if err := recover(); err != nil {
@@ -222,10 +220,17 @@ func (cc *cadvisorInfoProvider) collectCAdvisorInfo() (*NodeCAdvisorInfo, error)
 		return nil, err
 	}
 
-	// containerInfos := cc.collectCAdvisorContainerInfo()
-	// for _, v := range containerInfos {
-	// 	event.ContainerInfo = append(event.ContainerInfo, v)
-	// }
+	if rootStats, err := cc.collectCAdvisorRootStats(); err == nil {
+		event.RootStats = rootStats
+	} else {
+		klog.ErrorS(err, "Failed to collect root cgroup stats")
+	}
+
+	if containerInfos, err := cc.collectCAdvisorContainerInfo(); err == nil {
+		event.ContainerInfo = containerInfos
+	} else {
+		klog.ErrorS(err, "Failed to collect pod container stats")
+	}
 	return &event, nil
 }
 
@@ -233,6 +238,24 @@ func (cc *cadvisorInfoProvider) collectCAdvisorDirFsInfo(path string) (cadvisori
 	return cc.realCAdvisor.GetDirFsInfo(path)
 }
 
+// collectCAdvisorRootStats get latest stats of root cgroup
+func (cc *cadvisorInfoProvider) collectCAdvisorRootStats() (*cadvisorinfov2.ContainerStats, error) {
+	options := cadvisorinfov2.RequestOptions{
+		IdType:    cadvisorinfov2.TypeName,
+		Count:     1,
+		Recursive: false,
+	}
+	infos, err := cc.realCAdvisor.GetContainerInfoV2("/", options)
+	if err != nil {
+		return nil, err
+	}
+	info, found := infos["/"]
+	if !found || len(info.Stats) == 0 {
+		return nil, fmt.Errorf("root cgroup stats not found")
+	}
+	return info.Stats[len(info.Stats)-1], nil
+}
+
 func (cc *cadvisorInfoProvider) collectCAdvisorMachineInfo() (*cadvisorinfov1.MachineInfo, error) {
 	return cc.realCAdvisor.GetMachineInfo()
 }
@@ -241,26 +264,26 @@ func (cc *cadvisorInfoProvider) collectCAdvisorVersionInfo() (*cadvisorinfov1.Ve
 	return cc.realCAdvisor.GetVersionInfo()
 }
 
-// func (cc *cadvisorInfoProvider) collectCAdvisorContainerInfo() map[string]*cadvisorinfov2.ContainerInfo {
-// 	options := cadvisorinfov2.RequestOptions{
-// 		IdType:    "name",
-// 		Count:     1,
-// 		Recursive: true,
-// 		MaxAge:    nil,
-// 	}
-// 	containerInfos := make(map[string]*cadvisorinfov2.ContainerInfo)
-//
-// 	cc.getContainerList()
-// 	for c := range cc.containers {
-// 		if infos, err := cc.realCAdvisor.GetContainerInfoV2(c, options); err != nil {
-// 			// skip get single container info error
-// 			klog.Errorf("failed to get container cadvisor info: %v", err)
-// 		} else {
-// 			for n, info := range infos {
-// 				containerInfos[n] = &info
-// 			}
-// 		}
-// 	}
-//
-// 	return containerInfos
-// }
+// collectCAdvisorContainerInfo get latest two stats of pod sandboxes and containers, cpu usage rate is calculated from them,
+// cgroups which do not belong to a pod are skipped
+func (cc *cadvisorInfoProvider) collectCAdvisorContainerInfo() ([]*cadvisorinfov2.ContainerInfo, error) {
+	options := cadvisorinfov2.RequestOptions{
+		IdType:    cadvisorinfov2.TypeName,
+		Count:     2,
+		Recursive: true,
+	}
+	infos, err := cc.realCAdvisor.GetContainerInfoV2("/", options)
+	if err != nil {
+		return nil, err
+	}
+
+	containerInfos := []*cadvisorinfov2.ContainerInfo{}
+	for _, v := range infos {
+		if _, found := v.Spec.Labels[kubelettypes.KubernetesPodUIDLabel]; !found || len(v.Stats) == 0 {
+			continue
+		}
+		info := v
+		containerInfos = append(containerInfos, &info)
+	}
+	return containerInfos, nil
+}
@@ -9,7 +9,7 @@ import (
 const (
 	DefaultNodeInfoInterval     = 10 * time.Second
 	DefaultStatsCacheDuration   = 1 * time.Minute
-	DefaultHousekeepingInterval = 10 * time.Minute
+	DefaultHousekeepingInterval = 10 * time.Second
 )
 
 type CAdvisorConfig struct {
@@ -23,6 +23,7 @@ import (
 
 type NodeCAdvisorInfo struct {
 	ContainerInfo []*cadvisorv2.ContainerInfo
+	RootStats     *cadvisorv2.ContainerStats // latest stats of root cgroup, it's node level memory usage
 	MachineInfo   *cadvisorv1.MachineInfo
 	RootFsInfo    *cadvisorv2.FsInfo
 	ImageFsInfo   *cadvisorv2.FsInfo
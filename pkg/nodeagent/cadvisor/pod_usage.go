@@ -0,0 +1,87 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cadvisor
+
+import (
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+
+	cadvisorv2 "github.com/google/cadvisor/info/v2"
+	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
+)
+
+// PodResourceUsages sum latest stats of pod containers by pod uid, container is identified by kubelet labels set on runtime containers,
+// pod sandbox does not have container name label, pod network usage is collected from it since containers share sandbox network namespace
+func PodResourceUsages(info *NodeCAdvisorInfo) map[string]*types.PodResourceUsage {
+	usages := map[string]*types.PodResourceUsage{}
+	if info == nil {
+		return usages
+	}
+	for _, v := range info.ContainerInfo {
+		uid, found := v.Spec.Labels[kubelettypes.KubernetesPodUIDLabel]
+		if !found || len(v.Stats) == 0 {
+			continue
+		}
+		stats := v.Stats[len(v.Stats)-1]
+		usage, found := usages[uid]
+		if !found {
+			usage = &types.PodResourceUsage{
+				Containers: map[string]types.ContainerResourceUsage{},
+				Timestamp:  stats.Timestamp,
+			}
+			usages[uid] = usage
+		}
+
+		containerName, found := v.Spec.Labels[kubelettypes.KubernetesContainerNameLabel]
+		if !found {
+			if stats.Network != nil {
+				for _, i := range stats.Network.Interfaces {
+					usage.NetworkRxBytes += i.RxBytes
+					usage.NetworkTxBytes += i.TxBytes
+				}
+			}
+			continue
+		}
+
+		containerUsage := containerResourceUsage(stats)
+		usage.Containers[containerName] = containerUsage
+		usage.CPUUsageCoreNanoSeconds += containerUsage.CPUUsageCoreNanoSeconds
+		usage.CPUUsageNanoCores += containerUsage.CPUUsageNanoCores
+		usage.MemoryWorkingSetBytes += containerUsage.MemoryWorkingSetBytes
+		usage.FsUsedBytes += containerUsage.FsUsedBytes
+		if stats.Timestamp.After(usage.Timestamp) {
+			usage.Timestamp = stats.Timestamp
+		}
+	}
+	return usages
+}
+
+func containerResourceUsage(stats *cadvisorv2.ContainerStats) types.ContainerResourceUsage {
+	usage := types.ContainerResourceUsage{}
+	if stats.Cpu != nil {
+		usage.CPUUsageCoreNanoSeconds = stats.Cpu.Usage.Total
+	}
+	if stats.CpuInst != nil {
+		usage.CPUUsageNanoCores = stats.CpuInst.Usage.Total
+	}
+	if stats.Memory != nil {
+		usage.MemoryWorkingSetBytes = stats.Memory.WorkingSet
+	}
+	if stats.Filesystem != nil && stats.Filesystem.TotalUsageBytes != nil {
+		usage.FsUsedBytes = *stats.Filesystem.TotalUsageBytes
+	}
+	return usage
+}
@@ -47,6 +47,7 @@ const (
 	PID                               = "PID"
 	DefaultRootPath                   = "/var/lib/nodeagent"
 	DefaultDBName                     = "nodeagent.sqlite"
+	DefaultSecretKeyFileName          = "secret.key"
 	DefaultContainerRuntimeEndpoint   = "/run/containerd/containerd.sock"
 	DefaultMaxPods                    = 2000
 	DefaultPodPidLimits               = -1
@@ -69,11 +70,15 @@ const (
 	DefaultPodResourcesDirName        = "pod-resources"
 	DefaultMemoryThrottlingFactor     = 0.8
 	DefaultSessionServicePort         = 1022
+	DefaultMetricsBindAddress         = "0.0.0.0:1023"
 	DefaultNodePortStartingNum        = 1024
 	KubeletPluginsDirSELinuxLabel     = "system_u:object_r:container_file_t:s0"
 	DefaultPodCgroupName              = "containers"
 	DefaultRuntimeHandler             = "runc"
 	DefaultPodConcurrency             = 5
+	DefaultNodeStateReportInterval    = 15 * time.Second
+	DefaultEvictionMemoryAvailable    = "100Mi"
+	DefaultEvictionNodeFsAvailable    = "10%"
 )
 
 type NodeConfiguration struct {
@@ -82,14 +87,20 @@ type NodeConfiguration struct {
 	CgroupRoot               string
 	CgroupDriver             string
 	DatabaseURL              string // /var/lib/nodeagent/db/nodeagent.sqlite
+	EvictionMemoryAvailable  string // evict pods when node available memory is less than it, a quantity like 100Mi or a percentage of capacity like 5%
+	EvictionNodeFsAvailable  string // evict pods when available space of node root path file system is less than it, a quantity or a percentage
 	FornaxCoreUrls           []string
+	AllowedHostPaths         []string // host paths which pod hostPath volumes can mount, a pod can also mount sub path of them
 	Hostname                 string
 	MemoryQoS                bool
+	MetricsBindAddress       string // address node agent serve /metrics on, metrics endpoint is disabled if empty
 	DisableSwap              bool
 	MaxPods                  int
 	MaxContainerPerPod       int
 	MounterPath              string // a mounter bin path, leave it empty if use default
 	NodeIP                   string
+	NodeLabels               map[string]string // extra node labels reported to fornaxcore, used by node selector and affinity
+	NodeStateReportInterval  time.Duration     // how often node report state to fornaxcore, it's node heartbeat and should be less than fornaxcore node heartbeat grace period
 	NodeAgentCgroupName      string
 	OOMScoreAdj              int32
 	QOSReserved              map[v1.ResourceName]int64
@@ -97,8 +108,9 @@ type NodeConfiguration struct {
 	PodPidLimits             int // default 100
 	PodsPerCore              int
 	PodCgroupName            string
-	RootPath                 string // node agent state root, /var/lib/nodeagent/
-	RuntimeHandler           string
+	RootPath                 string   // node agent state root, /var/lib/nodeagent/
+	RuntimeHandler           string   // default runtime handler of pods which do not have a runtime class
+	RuntimeHandlers          []string // all runtime handlers configured in container runtime, they are advertised to fornaxcore to schedule pods
 	ProtectKernelDefaults    bool
 	SystemCgroupName         string
 	EnforceCPULimits         bool
@@ -110,9 +122,11 @@ type NodeConfiguration struct {
 	SystemReserved           v1.ResourceList
 	SeccompProfileRoot       string
 	SeccompDefault           bool
+	SecretKeyFile            string // aes-256 key file used to encrypt pod secrets saved in node agent store, a random key is generated if file does not exist
 	NodePortStartingNo       int32
 	SessionServicePort       int32
 	PodConcurrency           int
+	TracingEndpoint          string // otlp grpc collector address session open spans are exported to, spans are not exported if it's empty
 }
 
 func DefaultNodeConfiguration() (*NodeConfiguration, error) {
@@ -135,12 +149,18 @@ func DefaultNodeConfiguration() (*NodeConfiguration, error) {
 		CgroupRoot:               DefaultCgroupRoot,
 		CgroupDriver:             DefaultCgroupDriver,
 		DatabaseURL:              fmt.Sprintf("file:%s/db/%s?cache=shared&mode=rwc", DefaultRootPath, DefaultDBName),
+		EvictionMemoryAvailable:  DefaultEvictionMemoryAvailable,
+		EvictionNodeFsAvailable:  DefaultEvictionNodeFsAvailable,
 		FornaxCoreUrls:           []string{},
+		AllowedHostPaths:         []string{},
 		Hostname:                 hostname,
 		MaxPods:                  DefaultMaxPods,
 		MaxContainerPerPod:       DefaultMaxContainerPerPod,
+		MetricsBindAddress:       DefaultMetricsBindAddress,
 		MounterPath:              DefaultMounter,
 		NodeIP:                   ips[0].String(),
+		NodeLabels:               map[string]string{},
+		NodeStateReportInterval:  DefaultNodeStateReportInterval,
 		NodeAgentCgroupName:      DefaultNodeAgentCgroupName,
 		OOMScoreAdj:              -999,
 		QOSReserved:              map[v1.ResourceName]int64{},
@@ -151,10 +171,12 @@ func DefaultNodeConfiguration() (*NodeConfiguration, error) {
 		PodCgroupName:            DefaultPodCgroupName,
 		RootPath:                 DefaultRootPath,
 		RuntimeHandler:           DefaultRuntimeHandler,
+		RuntimeHandlers:          []string{},
 		SeccompProfileRoot:       filepath.Join(DefaultRootPath, "seccomp"),
 		NodePortStartingNo:       DefaultNodePortStartingNum,
 		SessionServicePort:       DefaultSessionServicePort,
 		SeccompDefault:           false,
+		SecretKeyFile:            filepath.Join(DefaultRootPath, "db", DefaultSecretKeyFileName),
 		ProtectKernelDefaults:    false,
 		SystemCgroupName:         DefaultSystemCgroupName,
 		MemoryQoS:                true,
@@ -204,11 +226,74 @@ func ValidateNodeConfiguration(nodeConfig NodeConfiguration) []error {
 func AddConfigFlags(flagSet *pflag.FlagSet, nodeConfig *NodeConfiguration) {
 	flagSet.BoolVar(&nodeConfig.DisableSwap, "disable-swap", nodeConfig.DisableSwap, "should disable swap, fail when host swap is on")
 
+	flagSet.StringVar(&nodeConfig.MetricsBindAddress, "metrics-bind-address", nodeConfig.MetricsBindAddress, "address node agent serve node, pod and container usage and runtime metrics on, format is ip:port. metrics endpoint is disabled if empty")
+
 	flagSet.StringVar(&nodeConfig.NodeIP, "node-ip", nodeConfig.NodeIP, "IPv4 addresses of the node. If unset, use the node's default IPv4 address")
 
+	flagSet.StringToStringVar(&nodeConfig.NodeLabels, "node-labels", nodeConfig.NodeLabels, "labels to add when registering the node, format is key1=value1,key2=value2")
+
+	flagSet.DurationVar(&nodeConfig.NodeStateReportInterval, "node-state-report-interval", nodeConfig.NodeStateReportInterval, "how often node report its state to fornaxcore, it should be less than fornaxcore node heartbeat grace period")
+
 	flagSet.StringVar(&nodeConfig.ContainerRuntimeEndpoint, "remote-runtime-endpoint", nodeConfig.ContainerRuntimeEndpoint, "container runtime remote endpoint")
 
 	flagSet.StringArrayVar(&nodeConfig.FornaxCoreUrls, "fornaxcore-url", nodeConfig.FornaxCoreUrls, "addresses of the fornaxcores, format is ip:port. must provided")
 
+	flagSet.StringSliceVar(&nodeConfig.AllowedHostPaths, "allowed-host-paths", nodeConfig.AllowedHostPaths, "host paths which pod hostPath volumes are allowed to mount, format is path1,path2. hostPath volume is rejected if unset")
+
+	flagSet.StringVar(&nodeConfig.EvictionMemoryAvailable, "eviction-memory-available", nodeConfig.EvictionMemoryAvailable, "evict pods when node available memory is less than this threshold, format is a quantity like 100Mi or a percentage of memory capacity like 5%")
+
+	flagSet.StringVar(&nodeConfig.EvictionNodeFsAvailable, "eviction-nodefs-available", nodeConfig.EvictionNodeFsAvailable, "evict pods when available space of node root path file system is less than this threshold, format is a quantity like 1Gi or a percentage of file system capacity like 10%")
+
+	flagSet.Var(&cpuSetValue{cpus: &nodeConfig.ReservedSystemCPUs}, "reserved-cpus", "cpus reserved for system and node agent, they are never pinned to pods exclusively, format is a cpu list like 0-1,4. first cpu is kept in shared pool if unset")
+
 	flagSet.StringVar(&nodeConfig.RuntimeHandler, "runtime-handler", nodeConfig.RuntimeHandler, "container runtime handler name, check /etc/docker/daemon.json for valid name")
+
+	flagSet.StringSliceVar(&nodeConfig.RuntimeHandlers, "runtime-handlers", nodeConfig.RuntimeHandlers, "runtime handlers configured in container runtime which pods can choose using runtime class name, format is handler1,handler2. default runtime handler is always supported")
+
+	flagSet.StringVar(&nodeConfig.SecretKeyFile, "secret-key-file", nodeConfig.SecretKeyFile, "aes-256 key file used to encrypt pod secrets saved on node, a random key is generated and saved in this file if it does not exist")
+
+	flagSet.StringVar(&nodeConfig.TracingEndpoint, "tracing-endpoint", nodeConfig.TracingEndpoint, "otlp grpc collector endpoint session open spans are exported to, format is host:port, spans are not exported if it's empty")
+}
+
+// cpuSetValue parse a cpu list flag into a cpuset
+type cpuSetValue struct {
+	cpus *cpuset.CPUSet
+}
+
+func (v *cpuSetValue) String() string {
+	return v.cpus.String()
+}
+
+func (v *cpuSetValue) Set(value string) error {
+	cpus, err := cpuset.Parse(value)
+	if err != nil {
+		return err
+	}
+	*v.cpus = cpus
+	return nil
+}
+
+func (v *cpuSetValue) Type() string {
+	return "cpuset"
+}
+
+// GetNodeRuntimeHandlers return runtime handlers supported by node, default runtime handler is the first one
+func GetNodeRuntimeHandlers(nodeConfig NodeConfiguration) []string {
+	handlers := []string{nodeConfig.RuntimeHandler}
+	seen := sets.NewString(nodeConfig.RuntimeHandler)
+	for _, v := range nodeConfig.RuntimeHandlers {
+		if len(v) > 0 && !seen.Has(v) {
+			seen.Insert(v)
+			handlers = append(handlers, v)
+		}
+	}
+	return handlers
+}
+
+// GetPodRuntimeHandler return runtime handler of pod runtime class, or default runtime handler if pod does not have one
+func GetPodRuntimeHandler(nodeConfig NodeConfiguration, pod *v1.Pod) string {
+	if pod.Spec.RuntimeClassName != nil && len(*pod.Spec.RuntimeClassName) > 0 {
+		return *pod.Spec.RuntimeClassName
+	}
+	return nodeConfig.RuntimeHandler
 }
@@ -20,6 +20,7 @@ import (
 
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/cadvisor"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/eviction"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/images"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/network"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/qos"
@@ -29,6 +30,7 @@ import (
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice"
 	sessionserver "centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice/grpc"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/store"
+	"centaurusinfra.io/fornax-serverless/pkg/store/storage"
 	"centaurusinfra.io/fornax-serverless/pkg/store/storage/sqlite"
 	v1 "k8s.io/api/core/v1"
 	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
@@ -45,11 +47,13 @@ type Dependencies struct {
 	QosManager      qos.QoSManager
 	ImageManager    images.ImageManager
 	MemoryManager   resourcemanager.MemoryManager
-	CPUManager      resourcemanager.CPUManager
+	CPUManager      *resourcemanager.CPUManager
 	VolumeManager   resourcemanager.VolumeManager
+	EvictionManager *eviction.EvictionManager
 	SandboxManger   *sandbox.SandboxManager
 	NodeStore       *store.NodeStore
 	PodStore        *store.PodStore
+	PodSecretStore  *store.PodSecretStore
 	SessionService  sessionservice.SessionService
 }
 
@@ -60,8 +64,8 @@ func InitBasicDependencies(ctx context.Context, nodeConfig config.NodeConfigurat
 		RuntimeService:  nil,
 		QosManager:      nil,
 		MemoryManager:   resourcemanager.MemoryManager{},
-		CPUManager:      resourcemanager.CPUManager{},
-		VolumeManager:   resourcemanager.VolumeManager{},
+		CPUManager:      nil,
+		VolumeManager:   resourcemanager.NewVolumeManager(nodeConfig.RootPath, nodeConfig.AllowedHostPaths, mount.New(nodeConfig.MounterPath)),
 		PodStore:        &store.PodStore{},
 		NodeStore:       &store.NodeStore{},
 	}
@@ -78,6 +82,11 @@ func InitBasicDependencies(ctx context.Context, nodeConfig config.NodeConfigurat
 		return nil, err
 	}
 
+	dependencies.PodSecretStore, err = InitPodSecretStore(nodeConfig.DatabaseURL, nodeConfig.SecretKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
 	// NetworkProvider
 	dependencies.NetworkProvider = InitNetworkProvider(nodeConfig.Hostname)
 
@@ -124,6 +133,22 @@ func InitNodeStore(databaseURL string) (*store.NodeStore, error) {
 	})
 }
 
+func InitPodSecretStore(databaseURL string, secretKeyFile string) (*store.PodSecretStore, error) {
+	key, err := storage.LoadOrCreateEncryptionKey(secretKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return store.NewPodSecretSqliteStore(&sqlite.SQLiteStoreOptions{
+		ConnUrl: databaseURL,
+	}, key)
+}
+
+func InitCPUAssignmentStore(databaseURL string) (*store.CPUAssignmentStore, error) {
+	return store.NewCPUAssignmentSqliteStore(&sqlite.SQLiteStoreOptions{
+		ConnUrl: databaseURL,
+	})
+}
+
 func InitCAdvisor(cAdvisorConfig cadvisor.CAdvisorConfig, CRIRuntime runtime.RuntimeService) (cadvisor.CAdvisorInfoProvider, error) {
 	return cadvisor.NewCAdvisorInfoProvider(cAdvisorConfig, CRIRuntime)
 }
@@ -153,6 +178,15 @@ func (n *Dependencies) Complete(node *v1.Node, nodeConfig config.NodeConfigurati
 		}
 	}
 
+	// SqliteStore
+	if n.PodSecretStore == nil {
+		n.PodSecretStore, err = InitPodSecretStore(nodeConfig.DatabaseURL, nodeConfig.SecretKeyFile)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init node agent store")
+			return err
+		}
+	}
+
 	// networkProvider
 	if n.NetworkProvider == nil {
 		n.NetworkProvider = InitNetworkProvider(nodeConfig.Hostname)
@@ -183,6 +217,34 @@ func (n *Dependencies) Complete(node *v1.Node, nodeConfig config.NodeConfigurati
 			klog.ErrorS(err, "Failed to init cadvisor info provider")
 			return err
 		}
+		if err = n.CAdvisor.Start(); err != nil {
+			klog.ErrorS(err, "Failed to start cadvisor info provider")
+			return err
+		}
+	}
+
+	// EvictionManager
+	if n.EvictionManager == nil {
+		n.EvictionManager, err = eviction.NewEvictionManager(nodeConfig, n.CAdvisor)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init eviction manager")
+			return err
+		}
+		n.EvictionManager.Start()
+	}
+
+	// CPUManager
+	if n.CPUManager == nil {
+		cpuAssignmentStore, err := InitCPUAssignmentStore(nodeConfig.DatabaseURL)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init cpu assignment store")
+			return err
+		}
+		n.CPUManager, err = resourcemanager.NewCpuManager(nodeConfig, n.CAdvisor, cpuAssignmentStore)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init cpu manager")
+			return err
+		}
 	}
 
 	// QosManager
@@ -215,7 +277,6 @@ func (n *Dependencies) Complete(node *v1.Node, nodeConfig config.NodeConfigurati
 	}
 	// TODO
 	// MemoryManager   resourcemanager.MemoryManager
-	// CPUManager      resourcemanager.CPUManager
 	// VolumeManager   resourcemanager.VolumeManager
 	return nil
 }
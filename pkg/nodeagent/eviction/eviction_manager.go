@@ -0,0 +1,291 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eviction
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/cadvisor"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+)
+
+type Signal string
+
+const (
+	SignalMemoryAvailable Signal = "memory.available"
+	SignalNodeFsAvailable Signal = "nodefs.available"
+)
+
+// Threshold is minimal available amount of a signal, it's either a quantity or a percentage of capacity
+type Threshold struct {
+	Signal     Signal
+	Quantity   *resource.Quantity
+	Percentage float64
+}
+
+// value return threshold quantity of a resource with given capacity
+func (t Threshold) value(capacity int64) int64 {
+	if t.Quantity != nil {
+		return t.Quantity.Value()
+	}
+	return int64(float64(capacity) * t.Percentage)
+}
+
+func (t Threshold) String() string {
+	if t.Quantity != nil {
+		return t.Quantity.String()
+	}
+	return fmt.Sprintf("%g%%", t.Percentage*100)
+}
+
+// ParseThreshold parse a quantity like 100Mi or a percentage like 10%, empty value disable threshold
+func ParseThreshold(signal Signal, value string) (*Threshold, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return nil, nil
+	}
+	if strings.HasSuffix(value, "%") {
+		percentage, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s threshold %s, cause %v", signal, value, err)
+		}
+		if percentage < 0 || percentage > 100 {
+			return nil, fmt.Errorf("invalid %s threshold %s, percentage should be in [0, 100]", signal, value)
+		}
+		return &Threshold{Signal: signal, Percentage: percentage / 100}, nil
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s threshold %s, cause %v", signal, value, err)
+	}
+	if quantity.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %s threshold %s, quantity should not be negative", signal, value)
+	}
+	return &Threshold{Signal: signal, Quantity: &quantity}, nil
+}
+
+// observation is available and capacity bytes of a signal collected from cadvisor
+type observation struct {
+	available int64
+	capacity  int64
+}
+
+// EvictionManager watch node memory and root path file system using cadvisor info,
+// node is under pressure when available amount of a signal is less than its threshold
+type EvictionManager struct {
+	mu           sync.RWMutex
+	stop         bool
+	channel      chan cadvisor.NodeCAdvisorInfo
+	advisor      cadvisor.CAdvisorInfoProvider
+	thresholds   map[Signal]*Threshold
+	observations map[Signal]observation
+}
+
+func NewEvictionManager(nodeConfig config.NodeConfiguration, advisor cadvisor.CAdvisorInfoProvider) (*EvictionManager, error) {
+	manager := &EvictionManager{
+		channel:      make(chan cadvisor.NodeCAdvisorInfo, 1),
+		advisor:      advisor,
+		thresholds:   map[Signal]*Threshold{},
+		observations: map[Signal]observation{},
+	}
+	for signal, value := range map[Signal]string{
+		SignalMemoryAvailable: nodeConfig.EvictionMemoryAvailable,
+		SignalNodeFsAvailable: nodeConfig.EvictionNodeFsAvailable,
+	} {
+		threshold, err := ParseThreshold(signal, value)
+		if err != nil {
+			return nil, err
+		}
+		if threshold != nil {
+			manager.thresholds[signal] = threshold
+		}
+	}
+
+	if info, err := advisor.GetNodeCAdvisorInfo(); err == nil {
+		manager.observe(info)
+	}
+	advisor.ReceiveCAdvisorInfo("EvictionManager", &manager.channel)
+	return manager, nil
+}
+
+func (m *EvictionManager) Start() error {
+	go func() {
+		for info := range m.channel {
+			if m.isStopped() {
+				break
+			}
+			info := info
+			m.observe(&info)
+		}
+	}()
+	return nil
+}
+
+func (m *EvictionManager) Stop() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stop = true
+	return nil
+}
+
+func (m *EvictionManager) isStopped() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.stop
+}
+
+// observe save node memory and root fs available bytes, memory available is capacity minus root cgroup working set like kubelet
+func (m *EvictionManager) observe(info *cadvisor.NodeCAdvisorInfo) {
+	observations := map[Signal]observation{}
+	if info.MachineInfo != nil && info.RootStats != nil && info.RootStats.Memory != nil {
+		capacity := int64(info.MachineInfo.MemoryCapacity)
+		available := capacity - int64(info.RootStats.Memory.WorkingSet)
+		if available < 0 {
+			available = 0
+		}
+		observations[SignalMemoryAvailable] = observation{available: available, capacity: capacity}
+	}
+	if info.RootFsInfo != nil && info.RootFsInfo.Capacity > 0 {
+		observations[SignalNodeFsAvailable] = observation{available: int64(info.RootFsInfo.Available), capacity: int64(info.RootFsInfo.Capacity)}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for signal, o := range observations {
+		m.observations[signal] = o
+	}
+}
+
+// thresholdMet return a message describing pressure if signal's available amount is less than its threshold
+func (m *EvictionManager) thresholdMet(signal Signal) (bool, string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	threshold, found := m.thresholds[signal]
+	if !found {
+		return false, ""
+	}
+	o, found := m.observations[signal]
+	if !found {
+		return false, ""
+	}
+	if o.available < threshold.value(o.capacity) {
+		return true, fmt.Sprintf("node is low on %s, available %d bytes is less than threshold %s", signal, o.available, threshold.String())
+	}
+	return false, ""
+}
+
+func (m *EvictionManager) IsUnderMemoryPressure() bool {
+	met, _ := m.thresholdMet(SignalMemoryAvailable)
+	return met
+}
+
+func (m *EvictionManager) IsUnderDiskPressure() bool {
+	met, _ := m.thresholdMet(SignalNodeFsAvailable)
+	return met
+}
+
+// PodToEvict return the first pod to evict and a message tell why, memory pressure is handled before disk pressure,
+// nil is returned if node is not under pressure or there is no pod can be evicted
+func (m *EvictionManager) PodToEvict(pods []*types.FornaxPod) (*types.FornaxPod, string) {
+	for _, signal := range []Signal{SignalMemoryAvailable, SignalNodeFsAvailable} {
+		if met, message := m.thresholdMet(signal); met {
+			ranked := RankPodsForEviction(signal, pods)
+			if len(ranked) == 0 {
+				klog.InfoS("Node is under pressure, but no pod can be evicted", "signal", signal)
+				return nil, ""
+			}
+			return ranked[0], message
+		}
+	}
+	return nil, ""
+}
+
+// eviction cost of pods, pod with lower cost is evicted first
+const (
+	costHibernated = iota
+	costIdle
+	costPending
+	costInUse
+)
+
+func podEvictionCost(pod *types.FornaxPod) int {
+	if pod.FornaxPodState == types.PodStateHibernated {
+		return costHibernated
+	}
+	for _, v := range pod.Sessions {
+		if util.SessionIsOpen(v.Session) {
+			return costInUse
+		}
+	}
+	if pod.FornaxPodState == types.PodStateRunning {
+		return costIdle
+	}
+	return costPending
+}
+
+// podMemoryUsage return working set bytes of pod containers collected from cadvisor, pod without stats yet uses nothing
+func podMemoryUsage(pod *types.FornaxPod) int64 {
+	if pod.ResourceUsage == nil {
+		return 0
+	}
+	return int64(pod.ResourceUsage.MemoryWorkingSetBytes)
+}
+
+func podDiskUsage(pod *types.FornaxPod) int64 {
+	usage := int64(0)
+	for _, v := range pod.VolumeUsages {
+		if v.Medium != string(v1.StorageMediumMemory) {
+			usage += v.UsedBytes
+		}
+	}
+	return usage
+}
+
+// RankPodsForEviction sort evictable pods by cost, hibernated standby pods first, then idle running pods, pods with open sessions last,
+// pods with same cost are sorted by usage of resource under pressure, memory usage is working set of pod containers,
+// daemon pods and terminating pods are never evicted
+func RankPodsForEviction(signal Signal, pods []*types.FornaxPod) []*types.FornaxPod {
+	usage := podMemoryUsage
+	if signal == SignalNodeFsAvailable {
+		usage = podDiskUsage
+	}
+	ranked := []*types.FornaxPod{}
+	for _, v := range pods {
+		if v.Daemon || v.Pod == nil || types.PodInTerminating(v) || v.FornaxPodState == types.PodStateFailed {
+			continue
+		}
+		ranked = append(ranked, v)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		ci, cj := podEvictionCost(ranked[i]), podEvictionCost(ranked[j])
+		if ci != cj {
+			return ci < cj
+		}
+		return usage(ranked[i]) > usage(ranked[j])
+	})
+	return ranked
+}
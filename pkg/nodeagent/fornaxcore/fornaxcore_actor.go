@@ -19,6 +19,7 @@ package fornaxcore
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	fornax "centaurusinfra.io/fornax-serverless/pkg/fornaxcore/grpc"
 	"centaurusinfra.io/fornax-serverless/pkg/message"
@@ -35,6 +36,7 @@ type FornaxCoreActor struct {
 	nodeName      string
 	stop          bool
 	innerActor    message.Actor
+	mu            sync.RWMutex
 	fornaxcores   map[string]FornaxCoreClient
 	fornaxChannel chan *fornax.FornaxCoreMessage
 	nodeActor     message.ActorRef
@@ -104,6 +106,8 @@ func (n *FornaxCoreActor) Stop() error {
 // when fornaxcore actor received another actor's message, it meant to send to fornaxcore a grpc message
 // do not return error as it works as proxy, node/pod/session actors are supposed to resend new state
 func (n *FornaxCoreActor) actorMessageProcess(msg message.ActorMessage) (interface{}, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
 	for _, v := range n.fornaxcores {
 		msgBody := msg.Body.(*fornax.FornaxCoreMessage)
 		msgBody.NodeIdentifier = &fornax.NodeIdentifier{
@@ -127,19 +131,20 @@ func (n *FornaxCoreActor) onFornaxCoreConfigurationCommand(msg *fornax.FornaxCor
 	if len(primaryIp) == 0 {
 		return errors.New("primary ip in fornax core configuration is nil")
 	}
-	_, found := n.fornaxcores[primaryIp]
-	if !found {
-		newips = append(newips, primaryIp)
-		newipset[primaryIp] = true
-	}
-
+	klog.InfoS("Received fornaxcore configuration", "primary", primaryIp, "standbys", msg.GetStandbys())
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	newipset[primaryIp] = true
 	for _, v := range msg.GetStandbys() {
-		_, found := n.fornaxcores[v.GetIp()]
-		if !found {
-			newips = append(newips, v.GetIp())
+		if len(v.GetIp()) > 0 {
 			newipset[v.GetIp()] = true
 		}
 	}
+	for ip := range newipset {
+		if _, found := n.fornaxcores[ip]; !found {
+			newips = append(newips, ip)
+		}
+	}
 
 	oldfornaxcores := map[string]FornaxCoreClient{}
 	for k, v := range n.fornaxcores {
@@ -150,9 +155,12 @@ func (n *FornaxCoreActor) onFornaxCoreConfigurationCommand(msg *fornax.FornaxCor
 		}
 	}
 
+	// clients are started already, listen to their grpc message
 	newfornaxcores := InitFornaxCoreClients(n.nodeIP, n.nodeName, newips)
 	for k, v := range newfornaxcores {
-		v.Start()
+		if err := v.GetMessage(fmt.Sprintf("FornaxCoreActor@%s", n.nodeName), n.fornaxChannel); err != nil {
+			return err
+		}
 		n.fornaxcores[k] = v
 	}
 
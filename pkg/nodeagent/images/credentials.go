@@ -0,0 +1,153 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	dockerref "github.com/docker/distribution/reference"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const defaultDockerRegistry = "docker.io"
+
+// dockerConfigEntry is credential of a registry in docker config
+type dockerConfigEntry struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+// dockerConfigJSON is content of a kubernetes.io/dockerconfigjson secret
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+// dockerConfigEntries parse docker config in a kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg secret,
+// other secret types are ignored
+func dockerConfigEntries(secret *v1.Secret) (map[string]dockerConfigEntry, error) {
+	switch secret.Type {
+	case v1.SecretTypeDockerConfigJson:
+		config := dockerConfigJSON{}
+		if err := json.Unmarshal(secret.Data[v1.DockerConfigJsonKey], &config); err != nil {
+			return nil, fmt.Errorf("invalid docker config json in secret %s: %v", secret.Name, err)
+		}
+		return config.Auths, nil
+	case v1.SecretTypeDockercfg:
+		config := map[string]dockerConfigEntry{}
+		if err := json.Unmarshal(secret.Data[v1.DockerConfigKey], &config); err != nil {
+			return nil, fmt.Errorf("invalid docker config in secret %s: %v", secret.Name, err)
+		}
+		return config, nil
+	default:
+		return map[string]dockerConfigEntry{}, nil
+	}
+}
+
+// normalizeRegistry strip scheme and api version from a docker config key, and use docker.io for docker hub aliases
+func normalizeRegistry(key string) string {
+	key = strings.TrimPrefix(key, "https://")
+	key = strings.TrimPrefix(key, "http://")
+	key = strings.TrimSuffix(key, "/")
+	key = strings.TrimSuffix(key, "/v1")
+	key = strings.TrimSuffix(key, "/v2")
+	host, path, _ := strings.Cut(key, "/")
+	if host == "index.docker.io" || host == "registry-1.docker.io" {
+		host = defaultDockerRegistry
+	}
+	if len(path) > 0 {
+		return host + "/" + path
+	}
+	return host
+}
+
+// ImagePullCredentials return auth configs in pull secrets which match image repository, more specific registry path come first,
+// it return a empty list if no credential match, image is pulled using node default credential in this case
+func ImagePullCredentials(image string, pullSecrets []*v1.Secret) ([]*criv1.AuthConfig, error) {
+	named, err := dockerref.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil, err
+	}
+	repo := dockerref.Domain(named) + "/" + dockerref.Path(named)
+
+	type matchedAuth struct {
+		registry string
+		auth     *criv1.AuthConfig
+	}
+	matched := []matchedAuth{}
+	for _, secret := range pullSecrets {
+		entries, err := dockerConfigEntries(secret)
+		if err != nil {
+			return nil, err
+		}
+		for key, entry := range entries {
+			registry := normalizeRegistry(key)
+			if repo != registry && !strings.HasPrefix(repo, registry+"/") {
+				continue
+			}
+			auth := &criv1.AuthConfig{
+				Username:      entry.Username,
+				Password:      entry.Password,
+				Auth:          entry.Auth,
+				ServerAddress: key,
+				IdentityToken: entry.IdentityToken,
+				RegistryToken: entry.RegistryToken,
+			}
+			if len(auth.Username) == 0 && len(entry.Auth) > 0 {
+				decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+				if err != nil {
+					return nil, fmt.Errorf("invalid auth of registry %s in secret %s: %v", key, secret.Name, err)
+				}
+				auth.Username, auth.Password, _ = strings.Cut(string(decoded), ":")
+			}
+			matched = append(matched, matchedAuth{registry: registry, auth: auth})
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return len(matched[i].registry) > len(matched[j].registry)
+	})
+	auths := []*criv1.AuthConfig{}
+	for _, v := range matched {
+		auths = append(auths, v.auth)
+	}
+	return auths, nil
+}
+
+// isAuthError check if runtime failed to pull a image because registry rejected or required credential,
+// runtimes do not use a dedicated grpc code for it, registry response in error message is checked too
+func isAuthError(err error) bool {
+	if s, ok := status.FromError(err); ok && (s.Code() == codes.Unauthenticated || s.Code() == codes.PermissionDenied) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, v := range []string{"401 unauthorized", "403 forbidden", "unauthorized", "failed to authorize", "authorization failed", "pull access denied", "denied:"} {
+		if strings.Contains(msg, v) {
+			return true
+		}
+	}
+	return false
+}
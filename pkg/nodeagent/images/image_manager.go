@@ -29,14 +29,15 @@ import (
 )
 
 type ImageManager interface {
-	PullImageForContainer(container *v1.Container, podSandboxConfig *criv1.PodSandboxConfig) (*criv1.Image, error)
+	PullImageForContainer(container *v1.Container, podSandboxConfig *criv1.PodSandboxConfig, pullSecrets []*v1.Secret) (*criv1.Image, error)
 }
 
 // imageManager provides the functionalities for image pulling.
 type imageManager struct {
 	imageRefs    map[string]*criv1.Image
 	imageService cri.ImageManagerService
-	authConfig   *criv1.AuthConfig
+	// node default credential, it's used when no pod pull secret match image registry
+	authConfig *criv1.AuthConfig
 }
 
 var _ ImageManager = &imageManager{}
@@ -62,7 +63,9 @@ func shouldPullImage(container *v1.Container, imagePresent bool) bool {
 	return false
 }
 
-func (m *imageManager) PullImageForContainer(container *v1.Container, podSandboxConfig *criv1.PodSandboxConfig) (*criv1.Image, error) {
+// PullImageForContainer pull container image if it's not present, credentials in pod pull secrets which match image registry are tried in order,
+// it return ErrImagePullAuth if registry rejected credentials
+func (m *imageManager) PullImageForContainer(container *v1.Container, podSandboxConfig *criv1.PodSandboxConfig, pullSecrets []*v1.Secret) (*criv1.Image, error) {
 	imageWithTag, err := applyDefaultImageTag(container.Image)
 	if err != nil {
 		klog.ErrorS(err, "Failed to apply default image tag", container.Image)
@@ -103,9 +106,25 @@ func (m *imageManager) PullImageForContainer(container *v1.Container, podSandbox
 		return image, nil
 	}
 
-	_, err = m.imageService.PullImage(imageSpec, m.authConfig, podSandboxConfig)
+	auths, err := ImagePullCredentials(imageWithTag, pullSecrets)
 	if err != nil {
-		klog.ErrorS(err, "Failed to pull image", "image", imageWithTag)
+		klog.ErrorS(err, "Failed to resolve image pull credentials", "image", imageWithTag)
+		return nil, fmt.Errorf("%w, %v", ErrImagePullAuth, err)
+	}
+	if len(auths) == 0 {
+		auths = append(auths, m.authConfig)
+	}
+	for _, auth := range auths {
+		_, err = m.imageService.PullImage(imageSpec, auth, podSandboxConfig)
+		if err == nil {
+			break
+		}
+		klog.ErrorS(err, "Failed to pull image", "image", imageWithTag, "registry", auth.ServerAddress)
+	}
+	if err != nil {
+		if isAuthError(err) {
+			return nil, fmt.Errorf("%w, %v", ErrImagePullAuth, err)
+		}
 		return nil, ErrImagePull
 	}
 
@@ -18,6 +18,8 @@ package images
 
 import (
 	"errors"
+
+	"centaurusinfra.io/fornax-serverless/pkg/util"
 )
 
 var (
@@ -28,7 +30,10 @@ var (
 	ErrImageInspect = errors.New("ImageInspectError")
 
 	// ErrImagePull - General image pull error
-	ErrImagePull = errors.New("ErrImagePull")
+	ErrImagePull = errors.New(util.PodReasonImagePullError)
+
+	// ErrImagePullAuth - Registry rejected image pull credentials, or credentials are required
+	ErrImagePullAuth = errors.New(util.PodReasonImagePullAuthError)
 
 	// ErrImageNeverPull - Required Image is absent on host and PullPolicy is NeverPullImage
 	ErrImageNeverPull = errors.New("ErrImageNeverPull")
@@ -21,6 +21,8 @@ import (
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+
+	v1 "k8s.io/api/core/v1"
 )
 
 type NodeUpdate struct{}
@@ -79,6 +81,12 @@ type PodContainerStopped struct {
 	Container *types.FornaxContainer
 }
 
+// when restart backoff of a exited container elapsed, pod recreate and start it
+type PodContainerRestart struct {
+	Pod       *types.FornaxPod
+	Container *types.FornaxContainer
+}
+
 // when runtime container is removed
 type PodContainerTerminated struct {
 	Pod       *types.FornaxPod
@@ -93,12 +101,28 @@ type PodContainerFailed struct {
 
 type PodTerminate struct{}
 
+// PodEvict terminate pod and set reason on pod and its open sessions
+type PodEvict struct {
+	Reason  string
+	Message string
+}
+
+// PodSharedCPUsUpdate pin running containers which do not have exclusive cpus to latest shared cpus
+type PodSharedCPUsUpdate struct {
+	CPUs string
+}
+
 type PodHibernate struct{}
 
 type PodCreate struct {
 	Pod *types.FornaxPod
 }
 
+// config map of a running pod is changed
+type PodConfigUpdate struct {
+	ConfigMap *v1.ConfigMap
+}
+
 type PodCleanup struct {
 	Pod *types.FornaxPod
 }
@@ -112,8 +136,9 @@ type PodOOM struct {
 }
 
 type SessionOpen struct {
-	SessionId string
-	Session   *fornaxv1.ApplicationSession
+	SessionId    string
+	Session      *fornaxv1.ApplicationSession
+	TraceContext map[string]string // trace context of node actor span, pod actor continue trace from it
 }
 
 type SessionClose struct {
@@ -0,0 +1,146 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/klog/v2"
+)
+
+const (
+	Namespace = "nodeagent"
+
+	DefaultMetricsPath = "/metrics"
+)
+
+var (
+	CRIOperationDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Namespace:      Namespace,
+			Subsystem:      "cri",
+			Name:           "operation_duration_seconds",
+			Help:           "Latency of container runtime operations in seconds by operation",
+			Buckets:        metrics.ExponentialBuckets(0.0005, 2, 16),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"operation"},
+	)
+
+	CRIOperationErrors = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      Namespace,
+			Subsystem:      "cri",
+			Name:           "operation_errors_total",
+			Help:           "Number of failed container runtime operations by operation",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"operation"},
+	)
+
+	SessionHeartbeats = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      Namespace,
+			Subsystem:      "session",
+			Name:           "heartbeats_total",
+			Help:           "Number of session states received from pods, each state is a heartbeat of session",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	SessionMissedHeartbeats = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      Namespace,
+			Subsystem:      "session",
+			Name:           "missed_heartbeats_total",
+			Help:           "Number of heartbeat periods in which no session state was received and session was pinged",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	DeadSessions = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      Namespace,
+			Subsystem:      "session",
+			Name:           "dead_sessions_total",
+			Help:           "Number of sessions considered dead after missing consecutive heartbeats",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	SessionTracked = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      Namespace,
+			Subsystem:      "session",
+			Name:           "tracked_sessions",
+			Help:           "Number of sessions whose heartbeat is tracked by session service",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+)
+
+var registerMetrics sync.Once
+
+// Register register node agent metrics and collectors into legacy registry,
+// collectors collect usage and state at scrape time, they are registered only once too
+func Register(collectors ...metrics.StableCollector) {
+	registerMetrics.Do(func() {
+		legacyregistry.MustRegister(CRIOperationDuration)
+		legacyregistry.MustRegister(CRIOperationErrors)
+		legacyregistry.MustRegister(SessionHeartbeats)
+		legacyregistry.MustRegister(SessionMissedHeartbeats)
+		legacyregistry.MustRegister(DeadSessions)
+		legacyregistry.MustRegister(SessionTracked)
+		legacyregistry.CustomMustRegister(collectors...)
+	})
+}
+
+// ObserveCRIOperation record latency of a container runtime operation started at st, and count it if it failed
+func ObserveCRIOperation(operation string, st time.Time, err error) {
+	CRIOperationDuration.WithLabelValues(operation).Observe(time.Since(st).Seconds())
+	if err != nil {
+		CRIOperationErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// Serve serve legacy registry metrics on address until context is done
+func Serve(ctx context.Context, address string) error {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		klog.ErrorS(err, "Node agent metrics server failed to listen", "address", address)
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(DefaultMetricsPath, legacyregistry.Handler())
+	server := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	go func() {
+		if err := server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			klog.ErrorS(err, "Node agent metrics server stopped to serve", "address", address)
+		}
+	}()
+	return nil
+}
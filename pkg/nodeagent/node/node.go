@@ -21,9 +21,11 @@ import (
 	"os"
 	goruntime "runtime"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	default_config "centaurusinfra.io/fornax-serverless/pkg/config"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/dependency"
@@ -136,6 +138,12 @@ func (n *FornaxNode) initV1Node(dependencies *dependency.Dependencies) (*v1.Node
 		},
 	}
 
+	for k, v := range n.NodeConfig.NodeLabels {
+		node.Labels[k] = v
+	}
+	// advertise runtime handlers, fornaxcore only schedule pods with a runtime class onto nodes which have its runtime handler
+	node.Annotations[fornaxv1.AnnotationFornaxCoreNodeRuntimeHandlers] = strings.Join(config.GetNodeRuntimeHandlers(n.NodeConfig), ",")
+
 	node.Status.Conditions = append(node.Status.Conditions, v1.NodeCondition{
 		Type:               v1.NodeReady,
 		Status:             v1.ConditionFalse,
@@ -266,11 +274,14 @@ func NodeSpecPodCidrChanged(myNode *v1.Node, apiNode *v1.Node) bool {
 		return true
 	}
 
-	sort.Strings(myNode.Spec.PodCIDRs)
-	sort.Strings(apiNode.Spec.PodCIDRs)
+	// sort copies, first cidr of PodCIDRs must be same as PodCIDR, do not reorder node spec
+	myCidrs := append([]string{}, myNode.Spec.PodCIDRs...)
+	apiCidrs := append([]string{}, apiNode.Spec.PodCIDRs...)
+	sort.Strings(myCidrs)
+	sort.Strings(apiCidrs)
 
-	for i := 0; i < len(myNode.Spec.PodCIDRs); i++ {
-		if myNode.Spec.PodCIDRs[i] != apiNode.Spec.PodCIDRs[i] {
+	for i := 0; i < len(myCidrs); i++ {
+		if myCidrs[i] != apiCidrs[i] {
 			return true
 		}
 	}
@@ -287,11 +298,19 @@ func ValidateNodeSpec(apiNode *v1.Node) []error {
 		errors = append(errors, fmt.Errorf("api node spec PodCIDR %s is invalid", apiNode.Spec.PodCIDR))
 	}
 
+	ipv4Cidrs, ipv6Cidrs := 0, 0
 	for i, v := range apiNode.Spec.PodCIDRs {
-		if _, _, err := net.ParseCIDR(apiNode.Spec.PodCIDR); err != nil {
+		if ip, _, err := net.ParseCIDR(v); err != nil {
 			errors = append(errors, fmt.Errorf("api node spec PodCIDRs[%d]: %s is invalid", i, v))
+		} else if ip.To4() != nil {
+			ipv4Cidrs++
+		} else {
+			ipv6Cidrs++
 		}
 	}
+	if ipv4Cidrs > 1 || ipv6Cidrs > 1 {
+		errors = append(errors, fmt.Errorf("api node spec PodCIDRs %v has more than one cidr of same ip family", apiNode.Spec.PodCIDRs))
+	}
 
 	if len(apiNode.Spec.PodCIDRs) > 0 && apiNode.Spec.PodCIDRs[0] != apiNode.Spec.PodCIDR {
 		errors = append(errors, fmt.Errorf("api node spec podcidrs[0] %s does not match podcidr %s", apiNode.Spec.PodCIDRs[0], apiNode.Spec.PodCIDR))
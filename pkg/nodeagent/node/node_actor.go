@@ -17,6 +17,7 @@ limitations under the License.
 package node
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -31,9 +32,11 @@ import (
 	podutil "centaurusinfra.io/fornax-serverless/pkg/nodeagent/pod"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/session"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -126,16 +129,26 @@ func (n *FornaxNodeActor) recreatePodStateFromRuntimeSummary(runtimeSummary Cont
 
 	for _, fpod := range runtimeSummary.runningPods {
 		klog.InfoS("Recover pod actor for a running pod", "pod", types.UniquePodName(fpod), "state", fpod.FornaxPodState)
+		// secrets are needed to create or restart containers using secret env or image pull secrets
+		secrets, err := n.dependencies.PodSecretStore.GetPodSecrets(fpod.Identifier)
+		if err != nil {
+			klog.ErrorS(err, "Failed to load pod secrets from store", "pod", types.UniquePodName(fpod))
+		}
+		fpod.Secrets = secrets
 		n.nodePortManager.initNodePortRangeSlot(fpod.Pod)
 		n.startPodActor(fpod)
 	}
+
+	n.dependencies.CPUManager.RemoveOrphanAssignments(func(podIdentifier string) bool {
+		return n.node.Pods.Get(podIdentifier) != nil
+	})
 }
 
 func (n *FornaxNodeActor) startStateReport() {
 	// start go routine to report node status forever
 	go wait.Until(func() {
 		n.notify(n.innerActor.Reference(), internal.NodeUpdate{})
-	}, 1*time.Minute, n.stopCh)
+	}, n.node.NodeConfig.NodeStateReportInterval, n.stopCh)
 }
 
 // https://www.sqlite.org/faq.html#q19, sqlite transaction is slow, so, call PutNode in go routine.
@@ -209,6 +222,10 @@ func (n *FornaxNodeActor) nodeHandler(msg message.ActorMessage) (interface{}, er
 		}
 	case internal.NodeUpdate:
 		SetNodeStatus(n.node, n.dependencies)
+		UpdatePodVolumeUsage(n.dependencies.VolumeManager, n.node.Pods.List())
+		UpdatePodResourceUsage(n.dependencies.CAdvisor, n.node.Pods.List())
+		n.evictPodExceedVolumeLimit()
+		n.evictPodUnderPressure()
 		n.notify(n.fornoxCoreRef, BuildFornaxGrpcNodeState(n.node, n.node.Revision))
 	default:
 		klog.InfoS("Received unknown message", "from", msg.Sender, "msg", msg.Body)
@@ -231,8 +248,10 @@ func (n *FornaxNodeActor) processFornaxCoreMessage(msg *fornaxgrpc.FornaxCoreMes
 		err = n.onPodTerminateCommand(msg.GetPodTerminate())
 	case fornaxgrpc.MessageType_POD_HIBERNATE:
 		err = n.onPodHibernateCommand(msg.GetPodHibernate())
+	case fornaxgrpc.MessageType_POD_CONFIG_UPDATE:
+		err = n.onPodConfigUpdateCommand(msg.GetPodConfigUpdate())
 	case fornaxgrpc.MessageType_SESSION_OPEN:
-		err = n.onSessionOpenCommand(msg.GetSessionOpen())
+		err = n.onSessionOpenCommand(msg.GetSessionOpen(), msg.GetTraceContext())
 	case fornaxgrpc.MessageType_SESSION_CLOSE:
 		err = n.onSessionCloseCommand(msg.GetSessionClose())
 	case fornaxgrpc.MessageType_SESSION_STATE, fornaxgrpc.MessageType_POD_STATE, fornaxgrpc.MessageType_NODE_STATE:
@@ -266,15 +285,20 @@ func (n *FornaxNodeActor) onNodeConfigurationCommand(msg *fornaxgrpc.NodeConfigu
 		return fmt.Errorf("api node spec is invalid, %v", errs)
 	}
 
-	n.node.V1Node.Spec = *apiNode.Spec.DeepCopy()
-
+	// check pod cidr before taking node spec from fornaxcore, fornaxcore could give a different cidr,
+	// e.g. its allocation is lost and the cidr node had is allocated to another node
 	if NodeSpecPodCidrChanged(n.node.V1Node, apiNode) {
+		if len(n.node.V1Node.Spec.PodCIDR) > 0 {
+			klog.InfoS("Pod cidr allocated by fornaxcore is different from node pod cidr", "node cidrs", n.node.V1Node.Spec.PodCIDRs, "allocated cidrs", apiNode.Spec.PodCIDRs)
+		}
 		if len(n.node.Pods.List()) > 0 {
-			return fmt.Errorf("change pod cidr when node has pods is not allowed, should not happen")
+			return fmt.Errorf("pod cidr conflict, node has pods in pod cidr %v, but fornaxcore allocated %v", n.node.V1Node.Spec.PodCIDRs, apiNode.Spec.PodCIDRs)
 		}
 		// TODO, set up pod cidr
 	}
 
+	n.node.V1Node.Spec = *apiNode.Spec.DeepCopy()
+
 	err := n.initializeNodeDaemons(msg.DaemonPods)
 	if err != nil {
 		klog.ErrorS(err, "Failed to initiaize daemons")
@@ -300,8 +324,8 @@ func (n *FornaxNodeActor) onNodeConfigurationCommand(msg *fornaxgrpc.NodeConfigu
 				n.node.V1Node.Status.Phase = v1.NodeRunning
 				n.notify(n.fornoxCoreRef, BuildFornaxGrpcNodeReady(n.node, revision))
 				n.state = NodeStateReady
-				// do not periodically report, fornaxcore will send syncup request when it determine resyncup is required
-				// n.startStateReport()
+				// periodically report node state as heartbeat, fornaxcore fail over node if it does not report within grace period
+				n.startStateReport()
 			} else {
 				time.Sleep(5 * time.Second)
 			}
@@ -313,22 +337,9 @@ func (n *FornaxNodeActor) onNodeConfigurationCommand(msg *fornaxgrpc.NodeConfigu
 func (n *FornaxNodeActor) initializeNodeDaemons(pods []*v1.Pod) error {
 	for _, p := range pods {
 		klog.Infof("Initialize daemon pod, %v", p)
-		errs := podutil.ValidatePodSpec(p)
-		if len(errs) != 0 {
-			return errors.Errorf("Pod spec is invalid %v", errs)
-		}
-
-		if len(p.Spec.Containers) != 1 {
-			return errors.Errorf("Daemon pod can only have one container, but this pod spec has %d container(s)", len(p.Spec.Containers))
-		}
-
-		if !p.Spec.HostNetwork {
-			return errors.Errorf("Daemon pod must use host network")
-		}
-
 		v := n.node.Pods.Get(util.Name(p))
 		if v == nil {
-			_, actor, err := n.createPodAndActor(types.PodStateCreating, p.DeepCopy(), nil, true)
+			_, actor, err := n.createPodAndActor(types.PodStateCreating, p.DeepCopy(), nil, nil, true)
 			if err != nil {
 				return err
 			} else {
@@ -341,13 +352,34 @@ func (n *FornaxNodeActor) initializeNodeDaemons(pods []*v1.Pod) error {
 	return nil
 }
 
+func validateDaemonPod(p *v1.Pod) error {
+	errs := podutil.ValidatePodSpec(p)
+	if len(errs) != 0 {
+		return errors.Errorf("Pod spec is invalid %v", errs)
+	}
+
+	if len(p.Spec.Containers) != 1 {
+		return errors.Errorf("Daemon pod can only have one container, but this pod spec has %d container(s)", len(p.Spec.Containers))
+	}
+
+	if !p.Spec.HostNetwork {
+		return errors.Errorf("Daemon pod must use host network")
+	}
+	return nil
+}
+
 // buildAFornaxPod validate pod spec, and allocate host port for pod container port, it also set pod lables,
 // modified pod spec will saved in store and return back to FornaxCore to make pod spec in sync
-func (n *FornaxNodeActor) buildAFornaxPod(state types.PodState, v1pod *v1.Pod, configMap *v1.ConfigMap, isDaemon bool) (*types.FornaxPod, error) {
+func (n *FornaxNodeActor) buildAFornaxPod(state types.PodState, v1pod *v1.Pod, configMap *v1.ConfigMap, secrets []*v1.Secret, isDaemon bool) (*types.FornaxPod, error) {
 	errs := podutil.ValidatePodSpec(v1pod)
 	if len(errs) > 0 {
 		return nil, errors.New("Pod spec is invalid")
 	}
+	if isDaemon {
+		if err := validateDaemonPod(v1pod); err != nil {
+			return nil, err
+		}
+	}
 	fornaxPod := &types.FornaxPod{
 		Identifier:              util.Name(v1pod),
 		FornaxPodState:          state,
@@ -367,6 +399,15 @@ func (n *FornaxNodeActor) buildAFornaxPod(state types.PodState, v1pod *v1.Pod, c
 			return nil, errors.New("ConfigMap spec is invalid")
 		}
 		fornaxPod.ConfigMap = configMap.DeepCopy()
+		podutil.SetPodConfigRevision(fornaxPod)
+	}
+
+	for _, secret := range secrets {
+		errs = podutil.ValidateSecretSpec(secret)
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("Secret %s spec is invalid, errors=%v", secret.Name, errs)
+		}
+		fornaxPod.Secrets = append(fornaxPod.Secrets, podutil.NormalizeSecret(secret))
 	}
 
 	// if fornax pod need to expose host port for containter port, there are chance port could be conflict between pods,
@@ -378,6 +419,13 @@ func (n *FornaxNodeActor) buildAFornaxPod(state types.PodState, v1pod *v1.Pod, c
 		return nil, err
 	}
 
+	// pin exclusive cpus to guaranteed pod with integer cpu limits, pod is rejected if there are not enough free cpus
+	if err = n.dependencies.CPUManager.Allocate(*fornaxPod.Pod); err != nil {
+		n.nodePortManager.DeallocatePodPortMapping(fornaxPod.Pod)
+		return nil, err
+	}
+	n.updateSharedCPUs(fornaxPod.Pod)
+
 	return fornaxPod, nil
 }
 
@@ -395,9 +443,9 @@ func (n *FornaxNodeActor) startPodActor(fpod *types.FornaxPod) (*types.FornaxPod
 	return fpod, fpActor
 }
 
-func (n *FornaxNodeActor) createPodAndActor(state types.PodState, v1Pod *v1.Pod, v1Config *v1.ConfigMap, isDaemon bool) (*types.FornaxPod, *podutil.PodActor, error) {
+func (n *FornaxNodeActor) createPodAndActor(state types.PodState, v1Pod *v1.Pod, v1Config *v1.ConfigMap, v1Secrets []*v1.Secret, isDaemon bool) (*types.FornaxPod, *podutil.PodActor, error) {
 	// create fornax pod obj
-	fpod, err := n.buildAFornaxPod(state, v1Pod, v1Config, isDaemon)
+	fpod, err := n.buildAFornaxPod(state, v1Pod, v1Config, v1Secrets, isDaemon)
 	if err != nil {
 		klog.ErrorS(err, "Failed to build a FornaxPod from pod spec", "namespace", v1Pod.Namespace, "name", v1Pod.Name)
 		return nil, nil, err
@@ -407,6 +455,12 @@ func (n *FornaxNodeActor) createPodAndActor(state types.PodState, v1Pod *v1.Pod,
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(fpod.Secrets) > 0 {
+		if err = n.dependencies.PodSecretStore.PutPodSecrets(fpod.Identifier, fpod.Secrets); err != nil {
+			n.dependencies.PodStore.DelObject(fpod.Identifier)
+			return nil, nil, err
+		}
+	}
 
 	// new pod actor and start it
 	fpod, fpodactor := n.startPodActor(fpod)
@@ -422,9 +476,28 @@ func (n *FornaxNodeActor) cleanupPodStoreAndActor(fppod *types.FornaxPod) error
 	}
 	n.node.Pods.Del(fppod.Identifier)
 	n.nodePortManager.DeallocatePodPortMapping(fppod.Pod)
+	if err := n.dependencies.CPUManager.Deallocate(*fppod.Pod); err != nil {
+		klog.ErrorS(err, "Failed to release exclusive cpus of pod", "pod", types.UniquePodName(fppod))
+	}
+	n.updateSharedCPUs(fppod.Pod)
+	if err := n.dependencies.PodSecretStore.DelObject(fppod.Identifier); err != nil {
+		klog.ErrorS(err, "Failed to delete pod secrets from store", "pod", types.UniquePodName(fppod))
+	}
 	return n.dependencies.PodStore.DelObject(fppod.Identifier)
 }
 
+// updateSharedCPUs notify pod actors to pin their shared containers to new shared cpus,
+// shared cpus change only when a pod with exclusive cpus is added or removed
+func (n *FornaxNodeActor) updateSharedCPUs(changedPod *v1.Pod) {
+	if util.GetPodExclusiveCPUs(changedPod) == 0 {
+		return
+	}
+	cpus := n.dependencies.CPUManager.SharedCPUs().String()
+	for _, v := range n.podActors.List() {
+		n.notify(v.Reference(), internal.PodSharedCPUsUpdate{CPUs: cpus})
+	}
+}
+
 // find pod actor and send a message to it, if pod actor does not exist, create one
 func (n *FornaxNodeActor) onPodCreateCommand(msg *fornaxgrpc.PodCreate) error {
 	if n.state != NodeStateReady {
@@ -444,7 +517,9 @@ func (n *FornaxNodeActor) onPodCreateCommand(msg *fornaxgrpc.PodCreate) error {
 	}
 	v := n.node.Pods.Get(msg.GetPodIdentifier())
 	if v == nil {
-		fpod, actor, err := n.createPodAndActor(types.PodStateCreating, msg.GetPod().DeepCopy(), msg.GetConfigMap().DeepCopy(), false)
+		// daemon pod is created on a ready node when a node daemon is created or rolled out
+		_, isDaemon := msg.GetPod().GetLabels()[fornaxv1.LabelFornaxCoreNodeDaemon]
+		fpod, actor, err := n.createPodAndActor(types.PodStateCreating, msg.GetPod().DeepCopy(), msg.GetConfigMap().DeepCopy(), msg.GetSecrets(), isDaemon)
 		if err != nil {
 			n.saveAndNotifyPodState(
 				&types.FornaxPod{
@@ -490,6 +565,47 @@ func (n *FornaxNodeActor) onPodTerminateCommand(msg *fornaxgrpc.PodTerminate) (e
 	return nil
 }
 
+// evictPodUnderPressure evict one pod on each node update when node is under memory or disk pressure,
+// pressure is checked again using new cadvisor info before next pod is evicted
+func (n *FornaxNodeActor) evictPodUnderPressure() {
+	if n.dependencies.EvictionManager == nil {
+		return
+	}
+	fpod, message := n.dependencies.EvictionManager.PodToEvict(n.node.Pods.List())
+	if fpod == nil {
+		return
+	}
+	podActor := n.podActors.Get(fpod.Identifier)
+	if podActor == nil {
+		_, podActor = n.startPodActor(fpod)
+	}
+	klog.InfoS("Evict pod under node pressure", "pod", types.UniquePodName(fpod), "state", fpod.FornaxPodState, "message", message)
+	n.notify(podActor.Reference(), internal.PodEvict{Reason: util.PodReasonEvicted, Message: message})
+}
+
+// evictPodExceedVolumeLimit evict pods whose disk emptyDir volume use more than its size limit like kubelet,
+// memory emptyDir is limited by size of its tmpfs
+func (n *FornaxNodeActor) evictPodExceedVolumeLimit() {
+	for _, fpod := range n.node.Pods.List() {
+		if types.PodInTerminating(fpod) || fpod.FornaxPodState == types.PodStateFailed {
+			continue
+		}
+		for _, v := range fpod.VolumeUsages {
+			if v.LimitBytes <= 0 || v.UsedBytes <= v.LimitBytes || v.Medium == string(v1.StorageMediumMemory) {
+				continue
+			}
+			podActor := n.podActors.Get(fpod.Identifier)
+			if podActor == nil {
+				_, podActor = n.startPodActor(fpod)
+			}
+			message := fmt.Sprintf("usage of emptyDir volume %s exceeds its size limit, used %d bytes, limit %d bytes", v.Name, v.UsedBytes, v.LimitBytes)
+			klog.InfoS("Evict pod exceeding volume size limit", "pod", types.UniquePodName(fpod), "volume", v.Name, "used", v.UsedBytes, "limit", v.LimitBytes)
+			n.notify(podActor.Reference(), internal.PodEvict{Reason: util.PodReasonEvicted, Message: message})
+			break
+		}
+	}
+}
+
 // find pod actor and send a message to it, if pod actor does not exist, return error
 func (n *FornaxNodeActor) onPodHibernateCommand(msg *fornaxgrpc.PodHibernate) error {
 	if n.state != NodeStateReady {
@@ -504,14 +620,34 @@ func (n *FornaxNodeActor) onPodHibernateCommand(msg *fornaxgrpc.PodHibernate) er
 	return nil
 }
 
-// build a session actor to start session and monitor session state
-func (n *FornaxNodeActor) onSessionOpenCommand(msg *fornaxgrpc.SessionOpen) error {
+// find pod actor and send new config map to it, pod actor rewrite config files of running pod
+func (n *FornaxNodeActor) onPodConfigUpdateCommand(msg *fornaxgrpc.PodConfigUpdate) error {
+	podActor := n.podActors.Get(msg.GetPodIdentifier())
+	if podActor == nil {
+		return fmt.Errorf("Pod: %s does not exist, Fornax core is not in sync", msg.GetPodIdentifier())
+	}
+	if errs := podutil.ValidateConfigMapSpec(msg.GetConfigMap()); len(errs) > 0 {
+		return fmt.Errorf("ConfigMap spec is invalid, errors=%v", errs)
+	}
+	n.notify(podActor.Reference(), internal.PodConfigUpdate{ConfigMap: msg.GetConfigMap().DeepCopy()})
+	return nil
+}
+
+// build a session actor to start session and monitor session state,
+// trace of session open is continued from trace context sent by fornaxcore
+func (n *FornaxNodeActor) onSessionOpenCommand(msg *fornaxgrpc.SessionOpen, traceContext map[string]string) (err error) {
+	ctx := tracing.ExtractTraceContext(context.Background(), traceContext)
+	ctx, span := tracing.StartSpan(ctx, "FornaxNodeActor.OpenSession",
+		attribute.String("session", msg.GetSessionIdentifier()),
+		attribute.String("pod", msg.GetPodIdentifier()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	s := msg.GetSession().DeepCopy()
 	podActor := n.podActors.Get(msg.GetPodIdentifier())
 	if podActor == nil || n.state != NodeStateReady {
 		return fmt.Errorf("Pod: %s does not exist, can not open session, or node not ready", msg.GetPodIdentifier())
 	} else {
-		n.notify(podActor.Reference(), internal.SessionOpen{SessionId: msg.GetSessionIdentifier(), Session: s})
+		n.notify(podActor.Reference(), internal.SessionOpen{SessionId: msg.GetSessionIdentifier(), Session: s, TraceContext: tracing.InjectTraceContext(ctx)})
 	}
 	return nil
 }
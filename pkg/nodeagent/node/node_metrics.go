@@ -0,0 +1,164 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"time"
+
+	"centaurusinfra.io/fornax-serverless/pkg/message"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/cadvisor"
+	nodeagentmetrics "centaurusinfra.io/fornax-serverless/pkg/nodeagent/metrics"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+
+	"k8s.io/component-base/metrics"
+)
+
+func newDesc(name, help string, labels ...string) *metrics.Desc {
+	return metrics.NewDesc(nodeagentmetrics.Namespace+"_"+name, help, labels, nil, metrics.ALPHA, "")
+}
+
+var (
+	nodeCPUUsageDesc         = newDesc("node_cpu_usage_seconds_total", "Cumulative cpu time consumed by node in core-seconds")
+	nodeMemoryWorkingSetDesc = newDesc("node_memory_working_set_bytes", "Current working set of node in bytes")
+	nodeNetworkRxDesc        = newDesc("node_network_receive_bytes_total", "Cumulative bytes received by node network interfaces")
+	nodeNetworkTxDesc        = newDesc("node_network_transmit_bytes_total", "Cumulative bytes transmitted by node network interfaces")
+	nodeFsAvailableDesc      = newDesc("node_fs_available_bytes", "Available bytes of file system node agent root path is on")
+	nodeFsCapacityDesc       = newDesc("node_fs_capacity_bytes", "Capacity bytes of file system node agent root path is on")
+
+	podCPUUsageDesc         = newDesc("pod_cpu_usage_seconds_total", "Cumulative cpu time consumed by pod containers in core-seconds", "namespace", "pod")
+	podMemoryWorkingSetDesc = newDesc("pod_memory_working_set_bytes", "Current working set of pod containers in bytes", "namespace", "pod")
+	podNetworkRxDesc        = newDesc("pod_network_receive_bytes_total", "Cumulative bytes received by pod network interfaces", "namespace", "pod")
+	podNetworkTxDesc        = newDesc("pod_network_transmit_bytes_total", "Cumulative bytes transmitted by pod network interfaces", "namespace", "pod")
+	podFsUsageDesc          = newDesc("pod_fs_usage_bytes", "Bytes used by writable layers of pod containers", "namespace", "pod")
+
+	containerCPUUsageDesc         = newDesc("container_cpu_usage_seconds_total", "Cumulative cpu time consumed by container in core-seconds", "namespace", "pod", "container")
+	containerMemoryWorkingSetDesc = newDesc("container_memory_working_set_bytes", "Current working set of container in bytes", "namespace", "pod", "container")
+	containerFsUsageDesc          = newDesc("container_fs_usage_bytes", "Bytes used by container writable layer", "namespace", "pod", "container")
+
+	actorMailboxDesc = newDesc("actor_mailbox_messages", "Number of messages waiting in actor mailbox", "kind", "name")
+)
+
+var _ metrics.StableCollector = &nodeMetricsCollector{}
+
+// nodeMetricsCollector collect node and pod resource usage from latest cadvisor info and actor mailbox depths at scrape time
+type nodeMetricsCollector struct {
+	metrics.BaseStableCollector
+	actor *FornaxNodeActor
+}
+
+// NewNodeMetricsCollector return a collector of node and pod resource usage and node actor mailboxes
+func NewNodeMetricsCollector(actor *FornaxNodeActor) metrics.StableCollector {
+	return &nodeMetricsCollector{actor: actor}
+}
+
+// DescribeWithStability implements metrics.StableCollector
+func (c *nodeMetricsCollector) DescribeWithStability(ch chan<- *metrics.Desc) {
+	for _, v := range []*metrics.Desc{
+		nodeCPUUsageDesc, nodeMemoryWorkingSetDesc, nodeNetworkRxDesc, nodeNetworkTxDesc, nodeFsAvailableDesc, nodeFsCapacityDesc,
+		podCPUUsageDesc, podMemoryWorkingSetDesc, podNetworkRxDesc, podNetworkTxDesc, podFsUsageDesc,
+		containerCPUUsageDesc, containerMemoryWorkingSetDesc, containerFsUsageDesc,
+		actorMailboxDesc,
+	} {
+		ch <- v
+	}
+}
+
+// CollectWithStability implements metrics.StableCollector
+func (c *nodeMetricsCollector) CollectWithStability(ch chan<- metrics.Metric) {
+	c.collectActorMailboxes(ch)
+	if c.actor.dependencies.CAdvisor == nil {
+		return
+	}
+	info, err := c.actor.dependencies.CAdvisor.GetNodeCAdvisorInfo()
+	if err != nil {
+		return
+	}
+	c.collectNodeUsage(ch, info)
+	c.collectPodUsage(ch, info)
+}
+
+func (c *nodeMetricsCollector) collectNodeUsage(ch chan<- metrics.Metric, info *cadvisor.NodeCAdvisorInfo) {
+	if stats := info.RootStats; stats != nil {
+		if stats.Cpu != nil {
+			ch <- metrics.NewLazyMetricWithTimestamp(stats.Timestamp,
+				metrics.NewLazyConstMetric(nodeCPUUsageDesc, metrics.CounterValue, float64(stats.Cpu.Usage.Total)/float64(time.Second)))
+		}
+		if stats.Memory != nil {
+			ch <- metrics.NewLazyMetricWithTimestamp(stats.Timestamp,
+				metrics.NewLazyConstMetric(nodeMemoryWorkingSetDesc, metrics.GaugeValue, float64(stats.Memory.WorkingSet)))
+		}
+		if stats.Network != nil {
+			rx, tx := uint64(0), uint64(0)
+			for _, i := range stats.Network.Interfaces {
+				rx += i.RxBytes
+				tx += i.TxBytes
+			}
+			ch <- metrics.NewLazyMetricWithTimestamp(stats.Timestamp, metrics.NewLazyConstMetric(nodeNetworkRxDesc, metrics.CounterValue, float64(rx)))
+			ch <- metrics.NewLazyMetricWithTimestamp(stats.Timestamp, metrics.NewLazyConstMetric(nodeNetworkTxDesc, metrics.CounterValue, float64(tx)))
+		}
+	}
+	if fs := info.RootFsInfo; fs != nil {
+		ch <- metrics.NewLazyConstMetric(nodeFsAvailableDesc, metrics.GaugeValue, float64(fs.Available))
+		ch <- metrics.NewLazyConstMetric(nodeFsCapacityDesc, metrics.GaugeValue, float64(fs.Capacity))
+	}
+}
+
+func (c *nodeMetricsCollector) collectPodUsage(ch chan<- metrics.Metric, info *cadvisor.NodeCAdvisorInfo) {
+	usages := cadvisor.PodResourceUsages(info)
+	for _, pod := range c.actor.node.Pods.List() {
+		if pod.Pod == nil {
+			continue
+		}
+		usage, found := usages[string(pod.Pod.UID)]
+		if !found {
+			continue
+		}
+		namespace, name := pod.Pod.Namespace, pod.Pod.Name
+		podMetric := func(desc *metrics.Desc, valueType metrics.ValueType, value float64) metrics.Metric {
+			return metrics.NewLazyMetricWithTimestamp(usage.Timestamp, metrics.NewLazyConstMetric(desc, valueType, value, namespace, name))
+		}
+		ch <- podMetric(podCPUUsageDesc, metrics.CounterValue, float64(usage.CPUUsageCoreNanoSeconds)/float64(time.Second))
+		ch <- podMetric(podMemoryWorkingSetDesc, metrics.GaugeValue, float64(usage.MemoryWorkingSetBytes))
+		ch <- podMetric(podNetworkRxDesc, metrics.CounterValue, float64(usage.NetworkRxBytes))
+		ch <- podMetric(podNetworkTxDesc, metrics.CounterValue, float64(usage.NetworkTxBytes))
+		ch <- podMetric(podFsUsageDesc, metrics.GaugeValue, float64(usage.FsUsedBytes))
+
+		for container, v := range usage.Containers {
+			containerMetric := func(desc *metrics.Desc, valueType metrics.ValueType, value float64) metrics.Metric {
+				return metrics.NewLazyMetricWithTimestamp(usage.Timestamp, metrics.NewLazyConstMetric(desc, valueType, value, namespace, name, container))
+			}
+			ch <- containerMetric(containerCPUUsageDesc, metrics.CounterValue, float64(v.CPUUsageCoreNanoSeconds)/float64(time.Second))
+			ch <- containerMetric(containerMemoryWorkingSetDesc, metrics.GaugeValue, float64(v.MemoryWorkingSetBytes))
+			ch <- containerMetric(containerFsUsageDesc, metrics.GaugeValue, float64(v.FsUsedBytes))
+		}
+	}
+}
+
+// collectActorMailboxes report mailbox depth of node actor, fornaxcore actor and pod actors,
+// container and session actors are owned by pod actors and are not collected
+func (c *nodeMetricsCollector) collectActorMailboxes(ch chan<- metrics.Metric) {
+	nodeName := c.actor.node.V1Node.GetName()
+	ch <- metrics.NewLazyConstMetric(actorMailboxDesc, metrics.GaugeValue, float64(message.MailboxLength(c.actor.innerActor.Reference())), "node", nodeName)
+	if c.actor.fornoxCoreRef != nil {
+		ch <- metrics.NewLazyConstMetric(actorMailboxDesc, metrics.GaugeValue, float64(message.MailboxLength(c.actor.fornoxCoreRef)), "fornaxcore", nodeName)
+	}
+	for _, pod := range c.actor.node.Pods.List() {
+		if actor := c.actor.podActors.Get(pod.Identifier); actor != nil {
+			ch <- metrics.NewLazyConstMetric(actorMailboxDesc, metrics.GaugeValue, float64(message.MailboxLength(actor.Reference())), "pod", types.UniquePodName(pod))
+		}
+	}
+}
@@ -21,9 +21,11 @@ import (
 	goruntime "runtime"
 	"time"
 
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/cadvisor"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/dependency"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/eviction"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/network"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/resource"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/runtime"
@@ -63,7 +65,7 @@ func SetNodeStatus(node *FornaxNode, dependencies *dependency.Dependencies) erro
 	}
 	conditions[condition.Type] = condition
 
-	condition, err = UpdateNodeMemoryStatus(dependencies.MemoryManager, node.V1Node)
+	condition, err = UpdateNodeMemoryStatus(dependencies.MemoryManager, dependencies.EvictionManager, node.V1Node)
 	if err != nil {
 		errs = append(errs, errors.New("can not update memory resource status"))
 	}
@@ -75,7 +77,7 @@ func SetNodeStatus(node *FornaxNode, dependencies *dependency.Dependencies) erro
 	}
 	conditions[condition.Type] = condition
 
-	condition, err = UpdateNodeVolumeStatus(dependencies.VolumeManager, node.V1Node)
+	condition, err = UpdateNodeVolumeStatus(dependencies.VolumeManager, dependencies.EvictionManager, node.V1Node)
 	if err != nil {
 		errs = append(errs, errors.New("can not update volume resource status"))
 	}
@@ -179,40 +181,100 @@ func UpdateNodeReadyStatus(criRuntime runtime.RuntimeService, node *v1.Node) (*v
 	return condition, nil
 }
 
-func UpdateNodeCPUStatus(cpuManager resource.CPUManager, node *v1.Node) (*v1.NodeCondition, error) {
+func UpdateNodeCPUStatus(cpuManager *resource.CPUManager, node *v1.Node) (*v1.NodeCondition, error) {
 	if node.Status.Allocatable == nil {
 		node.Status.Allocatable = make(v1.ResourceList)
 	}
+	if cpuManager == nil {
+		return &v1.NodeCondition{}, errors.New("cpu manager is not initialized")
+	}
 
 	UpdateAllocatableResourceQuantity(v1.ResourceCPU, node, cpuManager.GetReservedResource().Resources)
 
+	// all exclusive cpus are allocatable, fornaxcore subtract exclusive cpus of pods on node
+	exclusiveCPUs := util.ResourceQuantity(int64(cpuManager.ExclusiveCPUCapacity()), fornaxv1.ResourceFornaxCoreExclusiveCPU)
+	if node.Status.Capacity == nil {
+		node.Status.Capacity = v1.ResourceList{}
+	}
+	node.Status.Capacity[fornaxv1.ResourceFornaxCoreExclusiveCPU] = exclusiveCPUs
+	node.Status.Allocatable[fornaxv1.ResourceFornaxCoreExclusiveCPU] = exclusiveCPUs.DeepCopy()
+
 	condition := &v1.NodeCondition{}
 	return condition, nil
 }
 
-func UpdateNodeMemoryStatus(memoryManager resource.MemoryManager, node *v1.Node) (*v1.NodeCondition, error) {
+func UpdateNodeMemoryStatus(memoryManager resource.MemoryManager, evictionManager *eviction.EvictionManager, node *v1.Node) (*v1.NodeCondition, error) {
 	if node.Status.Allocatable == nil {
 		node.Status.Allocatable = make(v1.ResourceList)
 	}
 
 	UpdateAllocatableResourceQuantity(v1.ResourceMemory, node, memoryManager.GetReservedResource().Resources)
-	// TODO add condition
-	condition := &v1.NodeCondition{}
+	currentTime := metav1.NewTime(time.Now())
+	condition := &v1.NodeCondition{
+		Type:               v1.NodeMemoryPressure,
+		Status:             v1.ConditionFalse,
+		Reason:             "NodeHasSufficientMemory",
+		Message:            "node has sufficient memory available",
+		LastHeartbeatTime:  currentTime,
+		LastTransitionTime: currentTime,
+	}
+	if evictionManager != nil && evictionManager.IsUnderMemoryPressure() {
+		condition.Status = v1.ConditionTrue
+		condition.Reason = "NodeHasInsufficientMemory"
+		condition.Message = "node has insufficient memory available"
+	}
 	return condition, nil
 }
 
-func UpdateNodeVolumeStatus(volumeManager resource.VolumeManager, node *v1.Node) (*v1.NodeCondition, error) {
+func UpdateNodeVolumeStatus(volumeManager resource.VolumeManager, evictionManager *eviction.EvictionManager, node *v1.Node) (*v1.NodeCondition, error) {
 	if node.Status.Allocatable == nil {
 		node.Status.Allocatable = make(v1.ResourceList)
 	}
 
 	UpdateAllocatableResourceQuantity(v1.ResourceStorage, node, volumeManager.GetReservedResource().Resources)
-
-	// TODO add condition
-	condition := &v1.NodeCondition{}
+	currentTime := metav1.NewTime(time.Now())
+	condition := &v1.NodeCondition{
+		Type:               v1.NodeDiskPressure,
+		Status:             v1.ConditionFalse,
+		Reason:             "NodeHasNoDiskPressure",
+		Message:            "node has no disk pressure",
+		LastHeartbeatTime:  currentTime,
+		LastTransitionTime: currentTime,
+	}
+	if evictionManager != nil && evictionManager.IsUnderDiskPressure() {
+		condition.Status = v1.ConditionTrue
+		condition.Reason = "NodeHasDiskPressure"
+		condition.Message = "node has disk pressure"
+	}
 	return condition, nil
 }
 
+// UpdatePodVolumeUsage collect volume usage of created pods, usage is reported to fornaxcore with pod state
+func UpdatePodVolumeUsage(volumeManager resource.VolumeManager, pods []*fornaxtypes.FornaxPod) {
+	for _, v := range pods {
+		if v.Pod == nil || len(v.Pod.Spec.Volumes) == 0 || !fornaxtypes.PodCreated(v) || fornaxtypes.PodInTerminating(v) {
+			continue
+		}
+		v.VolumeUsages = volumeManager.GetPodVolumeUsage(v.Pod)
+	}
+}
+
+// UpdatePodResourceUsage set latest cpu, memory, network and file system usage of created pods collected from cadvisor,
+// usage is reported to fornaxcore with pod state
+func UpdatePodResourceUsage(cc cadvisor.CAdvisorInfoProvider, pods []*fornaxtypes.FornaxPod) {
+	info, err := cc.GetNodeCAdvisorInfo()
+	if err != nil {
+		return
+	}
+	usages := cadvisor.PodResourceUsages(info)
+	for _, v := range pods {
+		if v.Pod == nil || !fornaxtypes.PodCreated(v) {
+			continue
+		}
+		v.ResourceUsage = usages[string(v.Pod.UID)]
+	}
+}
+
 func UpdateNodeCapacity(cc cadvisor.CAdvisorInfoProvider, nodeConfig config.NodeConfiguration, node *v1.Node) error {
 	info, err := cc.GetNodeCAdvisorInfo()
 	if err != nil {
@@ -261,7 +323,7 @@ func UpdateAllocatableResourceQuantity(resourceName v1.ResourceName, node *v1.No
 	if ok {
 		value := capacity.DeepCopy()
 		var resValue k8sresource.Quantity
-		resValue, ok = reservedQuantity[v1.ResourceCPU]
+		resValue, ok = reservedQuantity[resourceName]
 		if !ok {
 			resValue = zeroQuanity
 		}
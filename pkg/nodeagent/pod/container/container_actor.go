@@ -111,8 +111,8 @@ func (a *PodContainerActor) startStartupProber() {
 	klog.InfoS("Starting container startup probers", "pod", types.UniquePodName(a.pod), "container", a.container.ContainerSpec.Name)
 	if !a.container.InitContainer && a.container.ContainerSpec.StartupProbe != nil {
 		startupProber := NewContainerProber(a.onContainerProbeResult,
-			a.pod.Pod.DeepCopy(),
-			a.container.RuntimeContainer.Id,
+			a.pod,
+			a.container,
 			a.container.ContainerSpec.StartupProbe.DeepCopy(),
 			StartupProbe,
 			a.dependencies.RuntimeService,
@@ -128,8 +128,8 @@ func (a *PodContainerActor) startStartupProber() {
 // start container runtime status prober
 func (a *PodContainerActor) startRuntimeProber() {
 	runtimeStatusProber := NewContainerProber(a.onContainerProbeResult,
-		a.pod.Pod.DeepCopy(),
-		a.container.RuntimeContainer.Id,
+		a.pod,
+		a.container,
 		NewRuntimeStatusProbeSpec(),
 		RuntimeStatusProbe,
 		a.dependencies.RuntimeService,
@@ -158,13 +158,16 @@ func (a *PodContainerActor) onContainerProbeResult(result PodContainerProbeResul
 			a.onContainerFailed()
 		}
 	case ReadinessProbe:
-		// readiness failure is treated as container unhealthy, stop probe
+		// readiness is probed until container stop, readiness failure is treated as container unhealthy,
+		// only report change of readiness, container turns ready again when probe succeed
 		if result.Result == ProbeResultFailed {
-			a.notify(internal.PodContainerUnhealthy{Pod: a.pod, Container: a.container})
-			a.probers[ReadinessProbe].Stop()
+			if a.container.State == types.ContainerStateRunning {
+				a.onContainerUnhealthy()
+			}
 		} else if result.Result == ProbeResultSuccess {
-			a.onContainerReady()
-			a.probers[ReadinessProbe].Stop()
+			if a.container.State == types.ContainerStateStarted {
+				a.onContainerReady()
+			}
 		}
 	case RuntimeStatusProbe:
 		if result.Result == ProbeResultFailed || probeStatus == nil {
@@ -185,13 +188,17 @@ func (a *PodContainerActor) onContainerProbeResult(result PodContainerProbeResul
 			}
 
 			// when container exit, report it
-			if runtime.ContainerExit(a.container.ContainerStatus) && !a.container.InitContainer {
+			if runtime.ContainerExit(a.container.ContainerStatus) {
 				klog.InfoS("Container exit", "pod", types.UniquePodName(a.pod), "container", a.container.ContainerSpec.Name, "exit code", a.container.ContainerStatus.RuntimeStatus.ExitCode, "finished at", a.container.ContainerStatus.RuntimeStatus.FinishedAt)
-				a.onContainerFailed()
+				if a.container.InitContainer {
+					a.onInitContainerExit()
+				} else {
+					a.onContainerFailed()
+				}
 			}
 
-			// use runtime status probe as readiness probe if it does not have it
-			if a.container.ContainerSpec.ReadinessProbe == nil && runtime.ContainerRunning(a.container.ContainerStatus) {
+			// use runtime status probe as readiness probe if it does not have it, init container is never ready, it's expected to exit
+			if !a.container.InitContainer && a.container.ContainerSpec.ReadinessProbe == nil && runtime.ContainerRunning(a.container.ContainerStatus) {
 				if a.container.State == types.ContainerStateStarted {
 					a.onContainerReady()
 				}
@@ -208,6 +215,19 @@ func (a *PodContainerActor) onContainerFailed() (interface{}, error) {
 	return nil, nil
 }
 
+// onInitContainerExit report init container exit once, normal exit is reported as stopped, pod actor then start next container
+func (a *PodContainerActor) onInitContainerExit() {
+	if a.inStoppingProcess() {
+		return
+	}
+	a.container.State = types.ContainerStateStopped
+	if runtime.ContainerExitNormal(a.container.ContainerStatus) {
+		a.notify(internal.PodContainerStopped{Pod: a.pod, Container: a.container})
+	} else {
+		a.notify(internal.PodContainerFailed{Pod: a.pod, Container: a.container})
+	}
+}
+
 func (a *PodContainerActor) onContainerReady() (interface{}, error) {
 	pod := a.pod
 	container := a.container
@@ -220,6 +240,15 @@ func (a *PodContainerActor) onContainerReady() (interface{}, error) {
 	return nil, nil
 }
 
+func (a *PodContainerActor) onContainerUnhealthy() {
+	// could be requested to stop when waiting for probe result
+	if !a.inStoppingProcess() {
+		a.container.State = types.ContainerStateStarted
+		klog.InfoS("Container unhealthy", "pod", a.pod.Identifier, "containerName", a.container.ContainerSpec.Name)
+		a.notify(internal.PodContainerUnhealthy{Pod: a.pod, Container: a.container})
+	}
+}
+
 // start liveness and readiness prober when container runtime status is ready
 func (a *PodContainerActor) onContainerStarted() error {
 	pod := a.pod
@@ -240,8 +269,8 @@ func (a *PodContainerActor) onContainerStarted() error {
 		klog.InfoS("Start pod liveness and readiness prober", "pod", pod.Identifier, "containerName", container.ContainerSpec.Name)
 		if a.container.ContainerSpec.LivenessProbe != nil {
 			prober := NewContainerProber(a.onContainerProbeResult,
-				a.pod.Pod.DeepCopy(),
-				a.container.RuntimeContainer.Id,
+				a.pod,
+				a.container,
 				a.container.ContainerSpec.LivenessProbe.DeepCopy(),
 				LivenessProbe,
 				a.dependencies.RuntimeService,
@@ -251,8 +280,8 @@ func (a *PodContainerActor) onContainerStarted() error {
 		}
 		if a.container.ContainerSpec.ReadinessProbe != nil {
 			prober := NewContainerProber(a.onContainerProbeResult,
-				a.pod.Pod.DeepCopy(),
-				a.container.RuntimeContainer.Id,
+				a.pod,
+				a.container,
 				a.container.ContainerSpec.ReadinessProbe.DeepCopy(),
 				ReadinessProbe,
 				a.dependencies.RuntimeService,
@@ -22,6 +22,7 @@ import (
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/runtime"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
 
@@ -58,7 +59,7 @@ type ContainerProber struct {
 	probeResultFunc ProbeResultFunc
 	stop            bool
 	containerId     string
-	podSpec         *v1.Pod
+	pod             *types.FornaxPod
 	runtimeService  runtime.RuntimeService
 	Probe           *v1.Probe
 	Container       *types.FornaxContainer
@@ -74,6 +75,11 @@ func (prober *ContainerProber) Stop() {
 
 func (prober *ContainerProber) Start() {
 	go func() {
+		// wait initial delay before first probe, an explicit zero delay probe immediately
+		if prober.Probe.InitialDelaySeconds > 0 {
+			time.Sleep(time.Duration(prober.Probe.InitialDelaySeconds) * time.Second)
+			prober.Ticker.Reset(time.Duration(prober.Probe.PeriodSeconds) * time.Second)
+		}
 		for {
 			if prober.stop {
 				prober.Ticker.Stop()
@@ -98,12 +104,7 @@ func (prober *ContainerProber) Start() {
 				}
 			}
 
-			if prober.LastProbeTime.Unix() == 0 {
-				// first probe, reset ticker to periodSeconds
-				if prober.Probe.PeriodSeconds > 0 {
-					prober.Ticker.Reset(time.Duration(prober.Probe.PeriodSeconds) * time.Second)
-				}
-			}
+			prober.LastProbeTime = time.Now()
 
 			var result ProbeResult
 			if prober.ProbeStat.ConsecutiveFailures == 0 && prober.ProbeStat.ConsecutiveSuccess >= prober.Probe.SuccessThreshold {
@@ -144,12 +145,13 @@ func (prober *ContainerProber) ExecProbe() (interface{}, error) {
 			prober.Ticker.Reset(time.Duration(RunningContainerProbeSeconds) * time.Second)
 		}
 		return status, nil
-	case LivenessProbe:
-		// TODO
-	case ReadinessProbe:
-		// TODO
-	case StartupProbe:
-		// TODO
+	case LivenessProbe, ReadinessProbe, StartupProbe:
+		msg, err := runProbeHandler(prober.runtimeService, prober.pod, prober.Container, &prober.Probe.ProbeHandler, probeTimeout(prober.Probe))
+		if err != nil {
+			klog.InfoS("Container probe failed", "pod", types.UniquePodName(prober.pod), "container", prober.Container.ContainerSpec.Name, "probeType", prober.ProbeType, "output", msg)
+			return nil, err
+		}
+		return msg, nil
 	default:
 	}
 	return nil, nil
@@ -157,18 +159,57 @@ func (prober *ContainerProber) ExecProbe() (interface{}, error) {
 
 type ProbeResultFunc func(PodContainerProbeResult, interface{})
 
-func NewContainerProber(probeResultFunc ProbeResultFunc, pod *v1.Pod, containerId string, probe *v1.Probe, probeType ProbeType, runtimeService runtime.RuntimeService) *ContainerProber {
+// setProbeDefaults fill probe fields which are not set using same default values as kubelet,
+// zero initial delay is a valid value and kept as it is
+func setProbeDefaults(probe *v1.Probe) {
+	if probe.PeriodSeconds <= 0 {
+		probe.PeriodSeconds = 10
+	}
+	if probe.SuccessThreshold <= 0 {
+		probe.SuccessThreshold = 1
+	}
+	if probe.FailureThreshold <= 0 {
+		probe.FailureThreshold = 3
+	}
+}
+
+// probeTarget copy pod and container fields used by probe handlers, prober run in its own goroutine,
+// pod actor change runtime pod and runtime container in place when it restart container
+func probeTarget(pod *types.FornaxPod, container *types.FornaxContainer) (*types.FornaxPod, *types.FornaxContainer) {
+	podCopy := &types.FornaxPod{
+		Identifier: pod.Identifier,
+		Pod: &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: pod.Pod.Namespace, Name: pod.Pod.Name, UID: pod.Pod.UID},
+		},
+	}
+	if pod.RuntimePod != nil {
+		podCopy.RuntimePod = &runtime.Pod{Id: pod.RuntimePod.Id, IPs: append([]string{}, pod.RuntimePod.IPs...)}
+	}
+	containerCopy := &types.FornaxContainer{
+		InitContainer: container.InitContainer,
+		ContainerSpec: container.ContainerSpec.DeepCopy(),
+	}
+	if container.RuntimeContainer != nil {
+		containerCopy.RuntimeContainer = &runtime.Container{Id: container.RuntimeContainer.Id}
+	}
+	return podCopy, containerCopy
+}
+
+func NewContainerProber(probeResultFunc ProbeResultFunc, pod *types.FornaxPod, container *types.FornaxContainer, probe *v1.Probe, probeType ProbeType, runtimeService runtime.RuntimeService) *ContainerProber {
+	setProbeDefaults(probe)
+	targetPod, targetContainer := probeTarget(pod, container)
 	prober := &ContainerProber{
 		stop:            false,
-		podSpec:         pod,
-		containerId:     containerId,
+		pod:             targetPod,
+		containerId:     container.RuntimeContainer.Id,
+		Container:       targetContainer,
 		probeResultFunc: probeResultFunc,
 		ProbeType:       probeType,
 		Probe:           probe,
 		runtimeService:  runtimeService,
 		LastProbeTime:   time.Unix(0, 0),
 		ProbeStat:       ProbeStat{ConsecutiveFailures: 0, ConsecutiveSuccess: 0},
-		Ticker:          time.NewTicker(time.Duration(probe.InitialDelaySeconds) * time.Second),
+		Ticker:          time.NewTicker(time.Duration(probe.PeriodSeconds) * time.Second),
 	}
 
 	return prober
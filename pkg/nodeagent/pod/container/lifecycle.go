@@ -18,7 +18,6 @@ package container
 
 import (
 	"fmt"
-	"net"
 	"net/http"
 	"strconv"
 
@@ -61,6 +60,15 @@ func (pl *PodContainerActor) runLifecycleHandler(pod *types.FornaxPod, container
 				"errMsg", msg)
 		}
 		return msg, err
+	case handler.TCPSocket != nil:
+		msg, err := runTCPSocketAction(pod, container, handler.TCPSocket, DefaultHandlerTimeout)
+		if err != nil {
+			klog.ErrorS(err, "TCP lifecycle hook for Container in Pod failed",
+				"port", handler.TCPSocket.Port.String(),
+				"containerName", container.ContainerSpec.Name,
+				"pod", pod.Pod.Name)
+		}
+		return msg, err
 	default:
 		err := fmt.Errorf("unknown handler: %v", handler)
 		msg := "Cannot run lifecycle handler as handler is unknown"
@@ -70,25 +78,7 @@ func (pl *PodContainerActor) runLifecycleHandler(pod *types.FornaxPod, container
 }
 
 func (pl *PodContainerActor) runHTTPHandler(pod *types.FornaxPod, container *types.FornaxContainer, handler *v1.LifecycleHandler) (string, error) {
-	host := handler.HTTPGet.Host
-	if len(host) == 0 {
-		if len(pod.RuntimePod.IPs) == 0 {
-			return "", fmt.Errorf("failed to find container ip: %v", pod.RuntimePod.IPs)
-		}
-		host = pod.RuntimePod.IPs[0]
-	}
-	var port int
-	if handler.HTTPGet.Port.Type == intstr.String && len(handler.HTTPGet.Port.StrVal) == 0 {
-		port = 80
-	} else {
-		var err error
-		port, err = resolvePort(handler.HTTPGet.Port, container.ContainerSpec)
-		if err != nil {
-			return "", err
-		}
-	}
-	url := fmt.Sprintf("http://%s/%s", net.JoinHostPort(host, strconv.Itoa(port)), handler.HTTPGet.Path)
-	resp, err := http.Get(url)
+	resp, err := runHTTPGetAction(pod, container, handler.HTTPGet, DefaultHandlerTimeout)
 	return getHTTPRespBody(resp), err
 }
 
@@ -0,0 +1,183 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/runtime"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// used when probe or lifecycle handler does not specify a timeout
+	DefaultHandlerTimeout = 10 * time.Second
+)
+
+// runProbeHandler run exec, httpGet, tcpSocket or grpc handler of a probe against container,
+// network handlers connect to pod ip, a nil error means probe succeeded
+func runProbeHandler(runtimeService runtime.RuntimeService, pod *types.FornaxPod, container *types.FornaxContainer, handler *v1.ProbeHandler, timeout time.Duration) (string, error) {
+	switch {
+	case handler.Exec != nil:
+		stdout, stderr, err := runtimeService.ExecCommand(container.RuntimeContainer.Id, handler.Exec.Command, timeout)
+		if err != nil {
+			return string(stderr), err
+		}
+		return string(stdout), nil
+	case handler.HTTPGet != nil:
+		resp, err := runHTTPGetAction(pod, container, handler.HTTPGet, timeout)
+		if err != nil {
+			return "", err
+		}
+		body := getHTTPRespBody(resp)
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+			return body, fmt.Errorf("http probe failed with status code %d", resp.StatusCode)
+		}
+		return body, nil
+	case handler.TCPSocket != nil:
+		return runTCPSocketAction(pod, container, handler.TCPSocket, timeout)
+	case handler.GRPC != nil:
+		return runGRPCAction(pod, handler.GRPC, timeout)
+	default:
+		return "", fmt.Errorf("unknown probe handler: %v", handler)
+	}
+}
+
+// podHost return host if it's specified, otherwise use pod ip
+func podHost(pod *types.FornaxPod, host string) (string, error) {
+	if len(host) > 0 {
+		return host, nil
+	}
+	if pod.RuntimePod == nil || len(pod.RuntimePod.IPs) == 0 {
+		return "", fmt.Errorf("failed to find pod ip of pod %s", types.UniquePodName(pod))
+	}
+	return pod.RuntimePod.IPs[0], nil
+}
+
+func runHTTPGetAction(pod *types.FornaxPod, container *types.FornaxContainer, action *v1.HTTPGetAction, timeout time.Duration) (*http.Response, error) {
+	host, err := podHost(pod, action.Host)
+	if err != nil {
+		return nil, err
+	}
+	port := 80
+	if action.Port.Type == intstr.Int || len(action.Port.StrVal) > 0 {
+		if port, err = resolvePort(action.Port, container.ContainerSpec); err != nil {
+			return nil, err
+		}
+	}
+	scheme := strings.ToLower(string(action.Scheme))
+	if len(scheme) == 0 {
+		scheme = "http"
+	}
+	path := action.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	u := &url.URL{Scheme: scheme, Host: net.JoinHostPort(host, strconv.Itoa(port))}
+	if u, err = u.Parse(path); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range action.HTTPHeaders {
+		if strings.EqualFold(h.Name, "host") {
+			req.Host = h.Value
+		} else {
+			req.Header.Add(h.Name, h.Value)
+		}
+	}
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+	}
+	return client.Do(req)
+}
+
+// runTCPSocketAction succeed if a tcp connection can be established to container port
+func runTCPSocketAction(pod *types.FornaxPod, container *types.FornaxContainer, action *v1.TCPSocketAction, timeout time.Duration) (string, error) {
+	host, err := podHost(pod, action.Host)
+	if err != nil {
+		return "", err
+	}
+	port, err := resolvePort(action.Port, container.ContainerSpec)
+	if err != nil {
+		return "", err
+	}
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return "", err
+	}
+	conn.Close()
+	return fmt.Sprintf("tcp connection to %s established", addr), nil
+}
+
+// runGRPCAction call standard grpc health service, it succeed only when service is serving
+func runGRPCAction(pod *types.FornaxPod, action *v1.GRPCAction, timeout time.Duration) (string, error) {
+	host, err := podHost(pod, "")
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(host, strconv.Itoa(int(action.Port)))
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		return "", fmt.Errorf("failed to connect grpc service %s, cause %v", addr, err)
+	}
+	defer conn.Close()
+
+	service := ""
+	if action.Service != nil {
+		service = *action.Service
+	}
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return "", err
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return resp.GetStatus().String(), fmt.Errorf("grpc service %s is %s", addr, resp.GetStatus().String())
+	}
+	return resp.GetStatus().String(), nil
+}
+
+func probeTimeout(probe *v1.Probe) time.Duration {
+	if probe.TimeoutSeconds > 0 {
+		return time.Duration(probe.TimeoutSeconds) * time.Second
+	}
+	return DefaultHandlerTimeout
+}
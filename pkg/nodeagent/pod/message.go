@@ -27,6 +27,7 @@ import (
 	fornaxtypes "centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func BuildFornaxcoreGrpcPodStateForFailedPod(nodeRevision int64, pod *v1.Pod) *grpc.FornaxCoreMessage {
@@ -73,8 +74,10 @@ func BuildFornaxcoreGrpcPodState(nodeRevision int64, pod *fornaxtypes.FornaxPod)
 		State:         PodStateToFornaxState(pod),
 		Pod:           podWithSession,
 		SessionStates: sessionStates,
-		// TODO
-		Resource: &grpc.PodResource{},
+		Resource: &grpc.PodResource{
+			ResourceQuotaStatus: BuildFornaxcoreGrpcResourceQuotaStatus(pod),
+			VolumeUsages:        BuildFornaxcoreGrpcVolumeUsages(pod.VolumeUsages),
+		},
 	}
 	messageType := grpc.MessageType_POD_STATE
 	return &grpc.FornaxCoreMessage{
@@ -85,6 +88,42 @@ func BuildFornaxcoreGrpcPodState(nodeRevision int64, pod *fornaxtypes.FornaxPod)
 	}
 }
 
+// BuildFornaxcoreGrpcResourceQuotaStatus use pod requested resources as hard limit and latest usage collected from cadvisor as used,
+// used ephemeral storage include container writable layers and volumes not backed by memory
+func BuildFornaxcoreGrpcResourceQuotaStatus(pod *fornaxtypes.FornaxPod) *v1.ResourceQuotaStatus {
+	status := &v1.ResourceQuotaStatus{
+		Hard: *util.GetPodResourceList(pod.Pod),
+		Used: v1.ResourceList{},
+	}
+	if pod.ResourceUsage == nil {
+		return status
+	}
+	fsUsed := int64(pod.ResourceUsage.FsUsedBytes)
+	for _, v := range pod.VolumeUsages {
+		if v.Medium != string(v1.StorageMediumMemory) {
+			fsUsed += v.UsedBytes
+		}
+	}
+	status.Used[v1.ResourceCPU] = *resource.NewMilliQuantity(int64(pod.ResourceUsage.CPUUsageNanoCores/1000000), resource.DecimalSI)
+	status.Used[v1.ResourceMemory] = *resource.NewQuantity(int64(pod.ResourceUsage.MemoryWorkingSetBytes), resource.BinarySI)
+	status.Used[v1.ResourceEphemeralStorage] = *resource.NewQuantity(fsUsed, resource.BinarySI)
+	return status
+}
+
+func BuildFornaxcoreGrpcVolumeUsages(usages []fornaxtypes.PodVolumeUsage) []*grpc.VolumeUsage {
+	volumes := []*grpc.VolumeUsage{}
+	for _, v := range usages {
+		volumes = append(volumes, &grpc.VolumeUsage{
+			Name:       v.Name,
+			HostPath:   v.HostPath,
+			Medium:     v.Medium,
+			UsedBytes:  v.UsedBytes,
+			LimitBytes: v.LimitBytes,
+		})
+	}
+	return volumes
+}
+
 func PodStateToFornaxState(pod *fornaxtypes.FornaxPod) grpc.PodState_State {
 	var grpcState grpc.PodState_State
 	switch pod.FornaxPodState {
@@ -26,6 +26,7 @@ import (
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/kubelet/metrics"
@@ -47,25 +48,32 @@ func ValidatePodSpec(apiPod *v1.Pod) []error {
 	return errors
 }
 
+// ValidateConfigMapSpec check config map keys, keys are used as file names when config map is mounted
 func ValidateConfigMapSpec(configMap *v1.ConfigMap) []error {
 
 	errors := []error{}
-	// opts := validation.PodValidationOptions{}
-	// out := &core.Pod{}
-	// s := conversion.
-	// corev1.Convert_v1_Pod_To_core_Pod(apiPod, out, s)
-	// validation.ValidatePodCreate(corePod, opts)
+	for k := range configMap.Data {
+		for _, msg := range validation.IsConfigMapKey(k) {
+			errors = append(errors, fmt.Errorf("invalid config map key %s: %s", k, msg))
+		}
+	}
 	return errors
 }
 
+// ValidateSecretSpec check secret keys, keys are used as file names when secret is mounted
 func ValidateSecretSpec(secret *v1.Secret) []error {
 
 	errors := []error{}
-	// opts := validation.PodValidationOptions{}
-	// out := &core.Pod{}
-	// s := conversion.
-	// corev1.Convert_v1_Pod_To_core_Pod(apiPod, out, s)
-	// validation.ValidatePodCreate(corePod, opts)
+	for k := range secret.Data {
+		for _, msg := range validation.IsConfigMapKey(k) {
+			errors = append(errors, fmt.Errorf("invalid secret key %s: %s", k, msg))
+		}
+	}
+	for k := range secret.StringData {
+		for _, msg := range validation.IsConfigMapKey(k) {
+			errors = append(errors, fmt.Errorf("invalid secret key %s: %s", k, msg))
+		}
+	}
 	return errors
 }
 
@@ -108,6 +116,18 @@ func (a *PodActor) CreatePod() (err error) {
 		return err
 	}
 
+	// Write config map and secret files before containers mount them
+	klog.InfoS("Project pod config map volumes", "pod", types.UniquePodName(a.pod))
+	if err := a.projectConfigMapVolumes(); err != nil {
+		klog.ErrorS(err, "Unable to project config map volumes for pod", "pod", types.UniquePodName(a.pod))
+		return err
+	}
+	klog.InfoS("Project pod secret volumes", "pod", types.UniquePodName(a.pod))
+	if err := a.projectSecretVolumes(); err != nil {
+		klog.ErrorS(err, "Unable to project secret volumes for pod", "pod", types.UniquePodName(a.pod))
+		return err
+	}
+
 	// TODO, Try to attach and mount volumes into pod, mounted vol will be mounted into container later, do not support volume for now
 	klog.InfoS("Prepare pod volumes", "pod", types.UniquePodName(a.pod))
 	if err := a.dependencies.VolumeManager.WaitForAttachAndMount(pod); err != nil {
@@ -115,11 +135,6 @@ func (a *PodActor) CreatePod() (err error) {
 		return err
 	}
 
-	// TODO, Fetch the pull secrets for the pod, for now assume no secrect required
-	// pullSecrets := GetPullSecretsForPod(pod)
-	klog.InfoS("Pull pod secret", "pod", types.UniquePodName(a.pod))
-	pullSecrets := []*v1.Secret{}
-
 	klog.InfoS("Create pod sandbox", "pod", types.UniquePodName(a.pod))
 	var runtimePod *runtime.Pod
 	runtimePod, err = a.dependencies.SandboxManger.CreatePodSandbox(a.pod.Pod)
@@ -136,34 +151,65 @@ func (a *PodActor) CreatePod() (err error) {
 		return err
 	}
 
-	klog.InfoS("Start pod init containers", "pod", types.UniquePodName(a.pod))
-	var runtimeContainer *runtime.Container
-	for _, v1InitContainer := range pod.Spec.InitContainers {
-		runtimeContainer, err = a.createContainer(runtimePod.SandboxConfig, &v1InitContainer, pullSecrets)
-		if err != nil {
-			klog.ErrorS(err, "Cannot create init container", "Pod", types.UniquePodName(a.pod), "Container", v1InitContainer.Name)
-			return err
+	// init containers are started one by one when previous one exit, application containers are started after all init containers finished
+	return a.startNextContainers()
+}
+
+// startNextContainers start first init container which is not started yet if all previous init containers exit normally,
+// or start application containers when all init containers are done, it does nothing if a init container is still running,
+// init containers are never restarted, so, they run only once for a pod even pod is hibernated and waked up later
+func (a *PodActor) startNextContainers() error {
+	pod := a.pod.Pod
+	for i := range pod.Spec.InitContainers {
+		container, found := a.pod.Containers[pod.Spec.InitContainers[i].Name]
+		if !found {
+			return a.startInitContainer(&pod.Spec.InitContainers[i])
 		}
-		container := &types.FornaxContainer{
-			State:            types.ContainerStateCreating,
-			InitContainer:    true,
-			ContainerSpec:    v1InitContainer.DeepCopy(),
-			RuntimeContainer: runtimeContainer,
-			ContainerStatus:  &runtime.ContainerStatus{},
+		if !runtime.ContainerExitNormal(container.ContainerStatus) {
+			return nil
 		}
-		a.pod.Containers[v1InitContainer.Name] = container
-		runtimePod.Containers[v1InitContainer.Name] = runtimeContainer.Container
+	}
 
-		klog.InfoS("New pod container actor", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name)
-		// start container actor, container actor will start runtime container, and start to probe it
-		containerActor := podcontainer.NewPodContainerActor(a.Reference(), a.pod, container, a.dependencies)
-		a.containerActors[v1InitContainer.Name] = containerActor
-		containerActor.Start()
+	for _, v := range pod.Spec.Containers {
+		if _, found := a.pod.Containers[v.Name]; found {
+			// application containers are already created
+			return nil
+		}
 	}
+	return a.startContainers()
+}
 
+func (a *PodActor) startInitContainer(v1InitContainer *v1.Container) error {
+	klog.InfoS("Start pod init container", "pod", types.UniquePodName(a.pod), "container", v1InitContainer.Name)
+	runtimeContainer, err := a.createContainer(a.pod.RuntimePod.SandboxConfig, v1InitContainer, a.podPullSecrets())
+	if err != nil {
+		klog.ErrorS(err, "Cannot create init container", "Pod", types.UniquePodName(a.pod), "Container", v1InitContainer.Name)
+		return err
+	}
+	container := &types.FornaxContainer{
+		State:            types.ContainerStateCreating,
+		InitContainer:    true,
+		ContainerSpec:    v1InitContainer.DeepCopy(),
+		RuntimeContainer: runtimeContainer,
+		ContainerStatus:  &runtime.ContainerStatus{},
+	}
+	a.pod.Containers[v1InitContainer.Name] = container
+	a.pod.RuntimePod.Containers[v1InitContainer.Name] = runtimeContainer.Container
+
+	klog.InfoS("New pod container actor", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name)
+	// start container actor, container actor will start runtime container, and report when it exit
+	containerActor := podcontainer.NewPodContainerActor(a.Reference(), a.pod, container, a.dependencies)
+	a.containerActors[v1InitContainer.Name] = containerActor
+	containerActor.Start()
+	return nil
+}
+
+func (a *PodActor) startContainers() error {
+	pod := a.pod.Pod
+	pullSecrets := a.podPullSecrets()
 	klog.InfoS("Start pod containers", "podName", types.UniquePodName(a.pod))
 	for _, v1Container := range pod.Spec.Containers {
-		runtimeContainer, err = a.createContainer(runtimePod.SandboxConfig, &v1Container, []*v1.Secret{})
+		runtimeContainer, err := a.createContainer(a.pod.RuntimePod.SandboxConfig, &v1Container, pullSecrets)
 		if err != nil {
 			klog.ErrorS(err, "cannot create container", "Pod", types.UniquePodName(a.pod), "Container", v1Container.Name)
 			return err
@@ -176,7 +222,7 @@ func (a *PodActor) CreatePod() (err error) {
 			ContainerStatus:  &runtime.ContainerStatus{},
 		}
 		a.pod.Containers[v1Container.Name] = container
-		runtimePod.Containers[v1Container.Name] = runtimeContainer.Container
+		a.pod.RuntimePod.Containers[v1Container.Name] = runtimeContainer.Container
 
 		// start container actor, container actor will start runtime container, and start to probe it
 		containerActor := podcontainer.NewPodContainerActor(a.Reference(), a.pod, container, a.dependencies)
@@ -270,6 +316,9 @@ func (a *PodActor) CleanupPod() (err error) {
 		pcm.UpdateQOSCgroups()
 	}
 
+	// no container will be restarted, drop secrets kept in memory
+	a.pod.Secrets = nil
+
 	// TODO
 	// update resource manager about resource usage
 	return nil
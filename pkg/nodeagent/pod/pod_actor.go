@@ -17,6 +17,7 @@ limitations under the License.
 package pod
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
@@ -31,7 +32,9 @@ import (
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/session"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
 	"k8s.io/klog/v2"
@@ -43,6 +46,9 @@ const (
 
 type HouseKeeping struct{}
 
+// StartNextContainers let recovered pod actor continue to start containers which were not started before node agent restart
+type StartNextContainers struct{}
+
 type PodActor struct {
 	supervisor        message.ActorRef
 	stop              bool
@@ -95,9 +101,19 @@ func (a *PodActor) Start() {
 }
 
 // when restart a pod actor, foreach stored pod container and sessions, restart actor to monitor them,
-// if container is terminated, skip it, if session is still pending, set it timeout
+// if container is terminated, skip it, if session is still pending, set it timeout,
+// if application containers are not created yet, node agent may stop after a init container exit, continue to start next containers
 func (a *PodActor) recoverContainerAndSessionActors() {
 	for k, cont := range a.pod.Containers {
+		if cont.InitContainer && runtime.ContainerExit(cont.ContainerStatus) {
+			// init container already finished, it's never restarted
+			continue
+		}
+		if cont.State == types.ContainerStateRestarting {
+			// exited container was waiting for restart backoff, no container actor is needed until it's restarted
+			a.resumeContainerRestart(cont)
+			continue
+		}
 		if cont.State != types.ContainerStateTerminated {
 			klog.InfoS("Recover container actor on pod", "pod", types.UniquePodName(a.pod), "container", cont.ContainerSpec.Name, "status", cont.State)
 			if _, found := a.containerActors[k]; !found {
@@ -108,6 +124,12 @@ func (a *PodActor) recoverContainerAndSessionActors() {
 		}
 	}
 
+	if a.pod.RuntimePod != nil && a.pod.RuntimePod.Sandbox != nil && !types.PodInTerminating(a.pod) &&
+		a.pod.FornaxPodState != types.PodStateFailed && !a.appContainersCreated() {
+		klog.InfoS("Continue to start pod containers", "pod", types.UniquePodName(a.pod))
+		a.notify(a.Reference(), StartNextContainers{})
+	}
+
 	for _, sess := range a.pod.Sessions {
 		if !util.SessionIsClosed(sess.Session) {
 			klog.InfoS("Recover session actor on pod", "pod", types.UniquePodName(a.pod), "session", sess.Identifier, "status", sess.Session.Status)
@@ -118,6 +140,15 @@ func (a *PodActor) recoverContainerAndSessionActors() {
 	}
 }
 
+func (a *PodActor) appContainersCreated() bool {
+	for _, v := range a.pod.Pod.Spec.Containers {
+		if _, found := a.pod.Containers[v.Name]; found {
+			return true
+		}
+	}
+	return false
+}
+
 func (n *PodActor) notify(receiver message.ActorRef, msg interface{}) error {
 	return message.Send(n.Reference(), receiver, msg)
 }
@@ -141,16 +172,28 @@ func (a *PodActor) podHandler(msg message.ActorMessage) (interface{}, error) {
 		err = a.hibernate()
 	case internal.PodTerminate:
 		err = a.terminate(false)
+	case internal.PodEvict:
+		err = a.evict(msg.Body.(internal.PodEvict))
+	case internal.PodSharedCPUsUpdate:
+		err = a.updateSharedCPUs(msg.Body.(internal.PodSharedCPUsUpdate).CPUs)
+	case internal.PodConfigUpdate:
+		err = a.updateConfig(msg.Body.(internal.PodConfigUpdate).ConfigMap)
 	case internal.PodContainerCreated:
 		err = a.onPodContainerCreated(msg.Body.(internal.PodContainerCreated))
 	case internal.PodContainerStarted:
 		err = a.onPodContainerStarted(msg.Body.(internal.PodContainerStarted))
 	case internal.PodContainerReady:
 		err = a.onPodContainerReady(msg.Body.(internal.PodContainerReady))
+	case internal.PodContainerUnhealthy:
+		err = a.onPodContainerUnhealthy(msg.Body.(internal.PodContainerUnhealthy))
 	case internal.PodContainerStopped:
 		err = a.onPodContainerStopped(msg.Body.(internal.PodContainerStopped))
 	case internal.PodContainerFailed:
 		err = a.onPodContainerFailed(msg.Body.(internal.PodContainerFailed))
+	case internal.PodContainerRestart:
+		if err = a.restartContainer(msg.Body.(internal.PodContainerRestart).Container); err != nil {
+			err = a.terminate(true)
+		}
 	case internal.SessionOpen:
 		err = a.onSessionOpenCommand(msg.Body.(internal.SessionOpen))
 	case internal.SessionClose:
@@ -161,6 +204,11 @@ func (a *PodActor) podHandler(msg message.ActorMessage) (interface{}, error) {
 			// when pod termination was requested, recheck if pod can be finally terminated after session closed
 			err = a.terminate(false)
 		}
+	case StartNextContainers:
+		if err = a.startNextContainers(); err != nil {
+			klog.ErrorS(err, "Failed to start pod containers", "pod", types.UniquePodName(a.pod))
+			err = a.terminate(true)
+		}
 	case HouseKeeping:
 		// calibarate pod error and cleanup, return if cleanup failed, do not change previous error state
 		if a.houseKeepingError != nil {
@@ -203,6 +251,7 @@ func (a *PodActor) create() error {
 	a.pod.FornaxPodState = types.PodStateCreating
 	err := a.CreatePod()
 	if err != nil {
+		setPodCreateFailureReason(a.pod, err)
 		return err
 	}
 
@@ -259,6 +308,24 @@ func (a *PodActor) terminate(forceTerminatePod bool) error {
 	return nil
 }
 
+// evict terminate pod when node is under pressure, eviction reason is set on pod and its open sessions,
+// so fornaxcore know why they are closed
+func (a *PodActor) evict(msg internal.PodEvict) error {
+	if types.PodInTerminating(a.pod) {
+		return nil
+	}
+	klog.InfoS("Evicting pod", "pod", types.UniquePodName(a.pod), "reason", msg.Reason, "message", msg.Message)
+	a.pod.Pod.Status.Reason = msg.Reason
+	a.pod.Pod.Status.Message = msg.Message
+	for _, v := range a.pod.Sessions {
+		if util.SessionIsOpen(v.Session) {
+			v.Session.Status.Reason = msg.Reason
+			v.Session.Status.Message = msg.Message
+		}
+	}
+	return a.terminate(false)
+}
+
 func (a *PodActor) cleanup() error {
 	klog.InfoS("Cleanup pod", "pod", types.UniquePodName(a.pod))
 	err := a.CleanupPod()
@@ -272,6 +339,10 @@ func (a *PodActor) cleanup() error {
 
 func (a *PodActor) hibernate() error {
 	for _, v := range a.pod.Containers {
+		// init containers already exit, they are not hibernated and not waked up
+		if v.InitContainer {
+			continue
+		}
 		// quark hibernate whole pod when it received hibernate call for any container, so, just need to call it once
 		err := a.hibernateContainer(v)
 		if err != nil {
@@ -375,13 +446,29 @@ func (a *PodActor) handlePodContainerExit(pod *types.FornaxPod, container *types
 		actor.Stop()
 		delete(a.containerActors, container.ContainerSpec.Name)
 	}
+	if container.State == types.ContainerStateRestarting {
+		// restart already scheduled, exit was reported again
+		return nil
+	}
 	if container.InitContainer {
+		if types.PodInTerminating(a.pod) || a.pod.FornaxPodState == types.PodStateFailed {
+			// init container is stopped by pod termination
+			return nil
+		}
 		if runtime.ContainerExitNormal(container.ContainerStatus) {
-			// init container is expected to run to end
-		} else if runtime.ContainerExitAbnormal(container.ContainerStatus) {
+			// init container is expected to run to end, start next one or application containers
+			if err := a.startNextContainers(); err != nil {
+				setInitContainerFailureReason(a.pod, container, err)
+				return a.terminate(true)
+			}
+		} else {
 			// init container failed, terminate pod
+			setInitContainerFailureReason(a.pod, container, nil)
 			return a.terminate(true)
 		}
+	} else if shouldRestartContainer(a.pod, container) {
+		// keep pod and its sessions, restart container in place according pod restart policy
+		a.scheduleContainerRestart(container)
 	} else {
 		return a.terminate(true)
 	}
@@ -394,25 +481,43 @@ func (a *PodActor) onPodContainerReady(msg internal.PodContainerReady) error {
 	container := msg.Container
 	klog.InfoS("Pod Container is ready", "Pod", types.UniquePodName(pod), "Container", container.ContainerSpec.Name)
 
-	allContainerReady := true
+	// application containers are created after init containers finished, pod is not ready until all of them are created
+	allContainerReady := len(a.pod.Containers) == len(pod.Pod.Spec.InitContainers)+len(pod.Pod.Spec.Containers)
 	for _, v := range a.pod.Containers {
 		if v.InitContainer {
 			allContainerReady = allContainerReady && runtime.ContainerExit(v.ContainerStatus)
 		} else {
-			allContainerReady = allContainerReady && runtime.ContainerRunning(v.ContainerStatus)
+			// container failed readiness probe is running but not ready
+			allContainerReady = allContainerReady && runtime.ContainerRunning(v.ContainerStatus) && (v.State == types.ContainerStateRunning || v.State == types.ContainerStateHibernated)
 		}
 	}
 
 	if allContainerReady {
 		pod.FornaxPodState = types.PodStateRunning
+		if container.RestartCount > 0 {
+			// restarted container lost session connections, ping sessions to let session service reconnect them
+			a.pingSessions()
+		}
 		// hibernate pod if pod spec has hibernate annotation
-		if util.PodHasHibernateAnnotation(pod.Pod) && (a.nodeConfig.RuntimeHandler == runtime.QuarkRuntime || a.nodeConfig.RuntimeHandler == runtime.QuarkRuntime_D) {
+		if runtimeHandler := config.GetPodRuntimeHandler(*a.nodeConfig, pod.Pod); util.PodHasHibernateAnnotation(pod.Pod) && (runtimeHandler == runtime.QuarkRuntime || runtimeHandler == runtime.QuarkRuntime_D) {
 			a.hibernateContainer(container)
 		}
 	}
 	return nil
 }
 
+// when a running container failed readiness probe, pod is not ready until container report it's ready again,
+// pod is not terminated, container liveness probe and pod restart policy decide if container need to restart
+func (a *PodActor) onPodContainerUnhealthy(msg internal.PodContainerUnhealthy) error {
+	pod := a.pod
+	container := msg.Container
+	klog.InfoS("Pod Container is unhealthy", "Pod", types.UniquePodName(pod), "Container", container.ContainerSpec.Name)
+	if pod.FornaxPodState == types.PodStateRunning {
+		pod.FornaxPodState = types.PodStateCreated
+	}
+	return nil
+}
+
 func (a *PodActor) NewSessionActor(sess *types.FornaxSession) *session.SessionActor {
 	var sessService sessionservice.SessionService
 	if util.PodHasSessionServiceAnnotation(a.pod.Pod) {
@@ -425,6 +530,13 @@ func (a *PodActor) NewSessionActor(sess *types.FornaxSession) *session.SessionAc
 
 // build a session actor to start session and monitor session state
 func (a *PodActor) onSessionOpenCommand(msg internal.SessionOpen) (err error) {
+	ctx := tracing.ExtractTraceContext(context.Background(), msg.TraceContext)
+	ctx, span := tracing.StartSpan(ctx, "PodActor.OpenSession",
+		attribute.String("session", msg.SessionId),
+		attribute.String("pod", a.pod.Identifier),
+		attribute.String("podState", string(a.pod.FornaxPodState)))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	klog.InfoS("Open session", "Pod", a.pod.Identifier, "session", msg.SessionId)
 	if a.pod.FornaxPodState == types.PodStateHibernated {
 		klog.InfoS("Wakeup Pod to open session", "Pod", a.pod.Identifier, "session", msg.SessionId)
@@ -457,7 +569,7 @@ func (a *PodActor) onSessionOpenCommand(msg internal.SessionOpen) (err error) {
 	sactor := a.NewSessionActor(sess)
 	a.pod.Sessions[msg.SessionId] = sess
 	a.sessionActors[msg.SessionId] = sactor
-	err = sactor.OpenSession()
+	err = sactor.OpenSession(ctx)
 	if err != nil {
 		klog.ErrorS(err, "Failed to open session", "session", msg.SessionId)
 	}
@@ -499,6 +611,17 @@ func (a *PodActor) handleSessionState(s internal.SessionState) error {
 		newStatus.SessionStatus = fornaxv1.SessionStatusClosed
 		newStatus.CloseTime = util.NewCurrentMetaTime()
 	case types.SessionStateNoHeartbeat:
+		if a.inSessionReconnectGracePeriod() {
+			// container is restarting, session may reconnect after container restarted, keep session open
+			klog.InfoS("Session lost heartbeat while pod container restarting, wait for it to reconnect", "session", s.SessionId)
+			if sactor, found := a.sessionActors[s.SessionId]; found {
+				// ping recreate session heartbeat, session is closed if it does not reconnect after grace period
+				if err := sactor.PingSession(); err != nil {
+					klog.ErrorS(err, "Failed to ping session", "session", s.SessionId)
+				}
+			}
+			return nil
+		}
 		newStatus.SessionStatus = fornaxv1.SessionStatusClosed
 		newStatus.CloseTime = util.NewCurrentMetaTime()
 	}
@@ -527,9 +650,13 @@ func (a *PodActor) handleSessionState(s internal.SessionState) error {
 
 	if util.SessionIsClosed(session.Session) {
 		delete(a.sessionActors, session.Identifier)
+		if a.hasOpenSession() {
+			// pod is still serving other sessions
+			return nil
+		}
 		if session.Session.Spec.KillInstanceWhenSessionClosed {
 			return a.terminate(false)
-		} else if util.PodHasHibernateAnnotation(a.pod.Pod) && a.nodeConfig.RuntimeHandler == runtime.QuarkRuntime {
+		} else if util.PodHasHibernateAnnotation(a.pod.Pod) && config.GetPodRuntimeHandler(*a.nodeConfig, a.pod.Pod) == runtime.QuarkRuntime {
 			// hibernate again when session is closed
 			return a.hibernate()
 		}
@@ -537,6 +664,16 @@ func (a *PodActor) handleSessionState(s internal.SessionState) error {
 	return nil
 }
 
+// hasOpenSession check if any session on this pod is still open, a pod can hold multiple sessions
+func (a *PodActor) hasOpenSession() bool {
+	for _, v := range a.pod.Sessions {
+		if util.SessionIsOpen(v.Session) {
+			return true
+		}
+	}
+	return false
+}
+
 func NewPodActor(supervisor message.ActorRef, pod *types.FornaxPod, nodeConfig *config.NodeConfiguration, dependencies *dependency.Dependencies, err error) *PodActor {
 	actor := &PodActor{
 		supervisor:        supervisor,
@@ -0,0 +1,152 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
+	internal "centaurusinfra.io/fornax-serverless/pkg/nodeagent/message"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/resource"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// SetPodConfigRevision copy config revision of pod config map into pod annotation, so fornaxcore know which config pod is using
+func SetPodConfigRevision(fppod *types.FornaxPod) {
+	if fppod.ConfigMap == nil {
+		return
+	}
+	if revision, found := fppod.ConfigMap.GetAnnotations()[fornaxv1.AnnotationFornaxCoreConfigRevision]; found {
+		if fppod.Pod.Annotations == nil {
+			fppod.Pod.Annotations = map[string]string{}
+		}
+		fppod.Pod.Annotations[fornaxv1.AnnotationFornaxCoreConfigRevision] = revision
+	}
+}
+
+// podConfigMaps return config maps which pod containers can reference in env and volumes
+func (a *PodActor) podConfigMaps() []*v1.ConfigMap {
+	if a.pod.ConfigMap == nil || len(a.pod.ConfigMap.Name) == 0 {
+		return []*v1.ConfigMap{}
+	}
+	return []*v1.ConfigMap{a.pod.ConfigMap}
+}
+
+func (a *PodActor) findConfigMap(name string) *v1.ConfigMap {
+	for _, v := range a.podConfigMaps() {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// projectConfigMapVolumes write config map data into host directory of each config map volume,
+// each key is a file, file is written into a temp file and renamed, so, containers never read a partially written file
+func (a *PodActor) projectConfigMapVolumes() error {
+	pod := a.pod.Pod
+	for _, vol := range pod.Spec.Volumes {
+		if vol.ConfigMap == nil {
+			continue
+		}
+		configMap := a.findConfigMap(vol.ConfigMap.Name)
+		if configMap == nil {
+			if vol.ConfigMap.Optional == nil || !*vol.ConfigMap.Optional {
+				return fmt.Errorf("config map %s of volume %s not found", vol.ConfigMap.Name, vol.Name)
+			}
+			configMap = &v1.ConfigMap{}
+		}
+		dir := config.GetPodVolumeDir(a.nodeConfig.RootPath, pod.UID, resource.ConfigMapVolumePluginName, vol.Name)
+		data := map[string][]byte{}
+		for k, v := range configMap.Data {
+			data[k] = []byte(v)
+		}
+		if err := writeVolumeFiles(dir, data, 0755, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeVolumeFiles make dir have exactly one file for each key, files of removed keys are deleted
+func writeVolumeFiles(dir string, data map[string][]byte, dirMode, fileMode os.FileMode) error {
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return err
+	}
+	if err := os.Chmod(dir, dirMode); err != nil {
+		return err
+	}
+	for k, v := range data {
+		tmp, err := os.CreateTemp(dir, ".tmp-")
+		if err != nil {
+			return err
+		}
+		_, err = tmp.Write(v)
+		if err == nil {
+			err = tmp.Chmod(fileMode)
+		}
+		if cerr := tmp.Close(); err == nil {
+			err = cerr
+		}
+		if err == nil {
+			err = os.Rename(tmp.Name(), filepath.Join(dir, k))
+		}
+		if err != nil {
+			os.Remove(tmp.Name())
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if _, found := data[e.Name()]; !found {
+			if err := os.RemoveAll(filepath.Join(dir, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateConfig replace pod config map and rewrite config map volume files of a running pod,
+// environment variables of running containers are not changed, they only take effect when container is recreated
+func (a *PodActor) updateConfig(configMap *v1.ConfigMap) error {
+	if types.PodInTerminating(a.pod) {
+		return nil
+	}
+	klog.InfoS("Updating pod config", "pod", types.UniquePodName(a.pod), "configMap", configMap.Name)
+	a.pod.ConfigMap = configMap
+	// pod not created yet will project latest config map when it's created
+	if types.PodCreated(a.pod) {
+		if err := a.projectConfigMapVolumes(); err != nil {
+			klog.ErrorS(err, "Failed to update config map volumes", "pod", types.UniquePodName(a.pod))
+			return err
+		}
+	}
+	SetPodConfigRevision(a.pod)
+	a.notify(a.supervisor, internal.PodStatusChange{Pod: a.pod})
+	return nil
+}
@@ -39,7 +39,7 @@ func (a *PodActor) createContainer(podSandboxConfig *criv1.PodSandboxConfig, con
 	klog.InfoS("Pull image for container", "pod", types.UniquePodName(a.pod), "container", containerSpec.Name)
 	pod := a.pod.Pod
 	// pull the image.
-	imageRef, err := a.dependencies.ImageManager.PullImageForContainer(containerSpec, podSandboxConfig)
+	imageRef, err := a.dependencies.ImageManager.PullImageForContainer(containerSpec, podSandboxConfig, pullSecrets)
 	if err != nil {
 		klog.ErrorS(err, "Failed to pull image", "pod", types.UniquePodName(a.pod), "container", containerSpec.Name)
 		return nil, err
@@ -74,18 +74,28 @@ func (a *PodActor) createContainer(podSandboxConfig *criv1.PodSandboxConfig, con
 		klog.ErrorS(err, "Failed to call runtime to create container", "pod", types.UniquePodName(a.pod), "container", containerSpec.Name)
 		return nil, ErrCreateContainer
 	}
+	runtimeContainer.ContainerConfig = scrubContainerConfig(containerConfig)
 	return runtimeContainer, nil
 }
 
+// scrubContainerConfig return a copy of container config without envs, command and args, they may have secret values,
+// saved config is written into pod store, container is restarted with a regenerated config
+func scrubContainerConfig(config *criv1.ContainerConfig) *criv1.ContainerConfig {
+	scrubbed := *config
+	scrubbed.Envs = nil
+	scrubbed.Command = nil
+	scrubbed.Args = nil
+	return &scrubbed
+}
+
 func (m *PodActor) generateContainerConfig(container *v1.Container, imageRef *criv1.Image) (*criv1.ContainerConfig, error) {
 	pod := m.pod.Pod
-	// TODO, comment it out until we have volume supported
-	// opts, cleanupAction, err := runtime.GenerateRunContainerOptions(pod, container, m.pod.RuntimePod.IPs[0], m.pod.RuntimePod.IPs)
-	// if err != nil {
-	//  return nil, nil, err
-	// }
+	mounts, err := m.dependencies.VolumeManager.GetContainerMounts(pod, container)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := kubelet.BuildContainerLogsDirectory(pod, container.Name)
+	_, err = kubelet.BuildContainerLogsDirectory(pod, container.Name)
 	if err != nil {
 		return nil, fmt.Errorf("create log directory for container %s failed: %v", container.Name, err)
 	}
@@ -94,7 +104,7 @@ func (m *PodActor) generateContainerConfig(container *v1.Container, imageRef *cr
 	if len(m.pod.RuntimePod.IPs) > 0 {
 		podIP = m.pod.RuntimePod.IPs[0]
 	}
-	envs, err := cruntime.MakeEnvironmentVariables(pod, container, []*v1.ConfigMap{}, []*v1.Secret{}, podIP, m.pod.RuntimePod.IPs)
+	envs, err := cruntime.MakeEnvironmentVariables(pod, container, m.podConfigMaps(), m.pod.Secrets, podIP, m.pod.RuntimePod.IPs)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +142,7 @@ func (m *PodActor) generateContainerConfig(container *v1.Container, imageRef *cr
 		Labels:      kubelet.NewContainerLabels(container, pod),
 		Annotations: kubelet.NewContainerAnnotations(container, pod, 0, map[string]string{}),
 		// Devices:     makeDevices(opts),
-		// Mounts:      makeMounts(opts, container),
+		Mounts:    mounts,
 		LogPath:   containerLogsPath,
 		Stdin:     container.Stdin,
 		StdinOnce: container.StdinOnce,
@@ -147,6 +157,7 @@ func (m *PodActor) generateContainerConfig(container *v1.Container, imageRef *cr
 	}
 	username := imageRef.GetUsername()
 	kubelet.GenerateLinuxContainerConfig(m.nodeConfig, container, pod, uid, username, true)
+	m.setContainerCPUSet(config, container.Name)
 
 	// set environment variables
 	criEnvs := make([]*criv1.KeyValue, len(envs))
@@ -0,0 +1,80 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/runtime"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+	"k8s.io/klog/v2"
+)
+
+// setContainerCPUSet pin container to its exclusive cpus or shared cpus in container linux resources
+func (a *PodActor) setContainerCPUSet(config *criv1.ContainerConfig, containerName string) {
+	for i, v := range a.pod.Pod.Spec.InitContainers {
+		if v.Name == containerName {
+			config.Linux = linuxConfigWithCPUSet(config.Linux, a.dependencies.CPUManager.GetContainerCPUSet(a.pod.Pod, &a.pod.Pod.Spec.InitContainers[i]))
+			return
+		}
+	}
+	for i, v := range a.pod.Pod.Spec.Containers {
+		if v.Name == containerName {
+			config.Linux = linuxConfigWithCPUSet(config.Linux, a.dependencies.CPUManager.GetContainerCPUSet(a.pod.Pod, &a.pod.Pod.Spec.Containers[i]))
+			return
+		}
+	}
+}
+
+// linuxConfigWithCPUSet return a copy of linux config with cpuset, original config is not changed as it may be shared by a old container config
+func linuxConfigWithCPUSet(linux *criv1.LinuxContainerConfig, cpus string) *criv1.LinuxContainerConfig {
+	config := &criv1.LinuxContainerConfig{}
+	if linux != nil {
+		*config = *linux
+	}
+	resources := &criv1.LinuxContainerResources{}
+	if config.Resources != nil {
+		*resources = *config.Resources
+	}
+	resources.CpusetCpus = cpus
+	config.Resources = resources
+	return config
+}
+
+// updateSharedCPUs pin running containers without exclusive cpus to new shared cpus,
+// a failed update is only logged, container keep using old cpus and get new cpus when it's restarted
+func (a *PodActor) updateSharedCPUs(cpus string) error {
+	if !types.PodCreated(a.pod) || types.PodInTerminating(a.pod) {
+		return nil
+	}
+	for _, container := range a.pod.Containers {
+		if container.RuntimeContainer == nil || container.RuntimeContainer.ContainerConfig == nil || runtime.ContainerExit(container.ContainerStatus) {
+			continue
+		}
+		if _, exclusive := a.dependencies.CPUManager.GetContainerExclusiveCPUSet(a.pod.Pod, container.ContainerSpec); exclusive {
+			continue
+		}
+		config := container.RuntimeContainer.ContainerConfig
+		linux := linuxConfigWithCPUSet(config.Linux, cpus)
+		if err := a.dependencies.RuntimeService.UpdateContainerResources(container.RuntimeContainer.Id, linux.Resources); err != nil {
+			klog.ErrorS(err, "Failed to update container shared cpus", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name, "cpus", cpus)
+			continue
+		}
+		config.Linux = linux
+	}
+	return nil
+}
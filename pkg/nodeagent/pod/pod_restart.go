@@ -0,0 +1,187 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"fmt"
+	"time"
+
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/kubelet"
+	internal "centaurusinfra.io/fornax-serverless/pkg/nodeagent/message"
+	podcontainer "centaurusinfra.io/fornax-serverless/pkg/nodeagent/pod/container"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/runtime"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	DefaultContainerRestartBackoff    = 10 * time.Second
+	DefaultContainerMaxRestartBackoff = 5 * time.Minute
+	// a container ran longer than this before it exit is treated as recovered, its restart backoff start from beginning again
+	DefaultContainerBackoffResetDuration = 2 * DefaultContainerMaxRestartBackoff
+	// open sessions are kept if session service lose heartbeat of them while a crashed container is being restarted within this period
+	DefaultSessionReconnectGracePeriod = 5 * time.Minute
+)
+
+// shouldRestartContainer check pod restart policy, OnFailure only restart container which did not exit normally,
+// e.g. container failed to start, exit with non zero code, or failed liveness probe
+func shouldRestartContainer(pod *types.FornaxPod, container *types.FornaxContainer) bool {
+	if container.InitContainer || types.PodInTerminating(pod) || pod.FornaxPodState == types.PodStateFailed {
+		return false
+	}
+	switch pod.Pod.Spec.RestartPolicy {
+	case v1.RestartPolicyAlways:
+		return true
+	case v1.RestartPolicyOnFailure:
+		return !runtime.ContainerExitNormal(container.ContainerStatus)
+	default:
+		return false
+	}
+}
+
+// containerRestartBackoff double previous backoff like kubelet crash loop backoff, backoff is reset if container ran long enough
+func containerRestartBackoff(container *types.FornaxContainer) time.Duration {
+	if container.RestartBackoff == 0 {
+		return DefaultContainerRestartBackoff
+	}
+	if runtime.ContainerExit(container.ContainerStatus) {
+		status := container.ContainerStatus.RuntimeStatus
+		if time.Duration(status.FinishedAt-status.StartedAt) > DefaultContainerBackoffResetDuration {
+			return DefaultContainerRestartBackoff
+		}
+	}
+	backoff := 2 * container.RestartBackoff
+	if backoff > DefaultContainerMaxRestartBackoff {
+		backoff = DefaultContainerMaxRestartBackoff
+	}
+	return backoff
+}
+
+// scheduleContainerRestart record exited container state, and notify pod actor itself to restart container after backoff
+func (a *PodActor) scheduleContainerRestart(container *types.FornaxContainer) {
+	backoff := containerRestartBackoff(container)
+	container.RestartBackoff = backoff
+	container.State = types.ContainerStateRestarting
+	container.LastTerminationState = &v1.ContainerStateTerminated{
+		FinishedAt: metav1.Now(),
+	}
+	if status := container.ContainerStatus; status != nil && status.RuntimeStatus != nil {
+		container.LastTerminationState = &v1.ContainerStateTerminated{
+			ExitCode:    status.RuntimeStatus.ExitCode,
+			Reason:      status.RuntimeStatus.Reason,
+			Message:     status.RuntimeStatus.Message,
+			StartedAt:   metav1.NewTime(time.Unix(0, status.RuntimeStatus.StartedAt)),
+			FinishedAt:  metav1.NewTime(time.Unix(0, status.RuntimeStatus.FinishedAt)),
+			ContainerID: status.RuntimeStatus.Id,
+		}
+		if status.RuntimeStatus.FinishedAt == 0 {
+			container.LastTerminationState.FinishedAt = metav1.Now()
+		}
+	}
+
+	klog.InfoS("Restart container after backoff", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name, "restartCount", container.RestartCount, "backoff", backoff)
+	a.notifyContainerRestartAfter(container, backoff)
+}
+
+// resumeContainerRestart schedule a restart saved before node agent restart again, backoff already passed is not waited again
+func (a *PodActor) resumeContainerRestart(container *types.FornaxContainer) {
+	backoff := container.RestartBackoff
+	if container.LastTerminationState != nil {
+		backoff -= time.Since(container.LastTerminationState.FinishedAt.Time)
+	}
+	if backoff < 0 {
+		backoff = 0
+	}
+	klog.InfoS("Resume container restart", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name, "restartCount", container.RestartCount, "backoff", backoff)
+	a.notifyContainerRestartAfter(container, backoff)
+}
+
+func (a *PodActor) notifyContainerRestartAfter(container *types.FornaxContainer, backoff time.Duration) {
+	time.AfterFunc(backoff, func() {
+		a.notify(a.Reference(), internal.PodContainerRestart{Pod: a.pod, Container: container})
+	})
+}
+
+// restartContainer remove exited runtime container and create a new one using image pulled before,
+// container config is regenerated since saved config does not have envs, command and args
+func (a *PodActor) restartContainer(container *types.FornaxContainer) error {
+	if container.State != types.ContainerStateRestarting || types.PodInTerminating(a.pod) || a.pod.FornaxPodState == types.PodStateFailed {
+		return nil
+	}
+	klog.InfoS("Restarting container", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name, "restartCount", container.RestartCount+1)
+	if err := a.terminateContainer(container); err != nil {
+		return err
+	}
+
+	restartCount := container.RestartCount + 1
+	oldConfig := container.RuntimeContainer.ContainerConfig
+	if oldConfig == nil || oldConfig.Image == nil {
+		return fmt.Errorf("container %s does not have saved image to restart", container.ContainerSpec.Name)
+	}
+	config, err := a.generateContainerConfig(container.ContainerSpec, &criv1.Image{Id: oldConfig.Image.Image})
+	if err != nil {
+		klog.ErrorS(err, "Failed to generate container runtime config", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name)
+		return err
+	}
+	config.Metadata = &criv1.ContainerMetadata{Name: container.ContainerSpec.Name, Attempt: uint32(restartCount)}
+	config.LogPath = kubelet.ContainerLogFileName(container.ContainerSpec.Name, int(restartCount))
+	runtimeContainer, err := a.dependencies.RuntimeService.CreateContainer(a.pod.RuntimePod.Sandbox.Id, config, a.pod.RuntimePod.SandboxConfig)
+	if err != nil {
+		klog.ErrorS(err, "Failed to recreate container", "pod", types.UniquePodName(a.pod), "container", container.ContainerSpec.Name)
+		return err
+	}
+	runtimeContainer.ContainerConfig = scrubContainerConfig(config)
+
+	container.RestartCount = restartCount
+	container.State = types.ContainerStateCreating
+	container.RuntimeContainer = runtimeContainer
+	container.ContainerStatus = &runtime.ContainerStatus{}
+	a.pod.RuntimePod.Containers[container.ContainerSpec.Name] = runtimeContainer.Container
+
+	containerActor := podcontainer.NewPodContainerActor(a.Reference(), a.pod, container, a.dependencies)
+	a.containerActors[container.ContainerSpec.Name] = containerActor
+	containerActor.Start()
+	return nil
+}
+
+// inSessionReconnectGracePeriod return true if a container crashed within grace period is waiting for restart or restarted but not running yet,
+// session service can not reach sessions until container restarted and reconnected
+func (a *PodActor) inSessionReconnectGracePeriod() bool {
+	for _, v := range a.pod.Containers {
+		if v.InitContainer || v.LastTerminationState == nil {
+			continue
+		}
+		restarting := v.State == types.ContainerStateRestarting || (v.RestartCount > 0 && !runtime.ContainerRunning(v.ContainerStatus))
+		if restarting && time.Since(v.LastTerminationState.FinishedAt.Time) < DefaultSessionReconnectGracePeriod {
+			return true
+		}
+	}
+	return false
+}
+
+// pingSessions let session service send ping to open sessions, so restarted container report session state again
+func (a *PodActor) pingSessions() {
+	for _, v := range a.sessionActors {
+		if err := v.PingSession(); err != nil {
+			klog.ErrorS(err, "Failed to ping session after container restarted", "pod", types.UniquePodName(a.pod))
+		}
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"fmt"
+	"os"
+
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/resource"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// NormalizeSecret return a copy of secret whose string data is merged into data, string data win if a key exist in both
+func NormalizeSecret(secret *v1.Secret) *v1.Secret {
+	s := secret.DeepCopy()
+	if len(s.StringData) == 0 {
+		return s
+	}
+	if s.Data == nil {
+		s.Data = map[string][]byte{}
+	}
+	for k, v := range s.StringData {
+		s.Data[k] = []byte(v)
+	}
+	s.StringData = nil
+	return s
+}
+
+func (a *PodActor) findSecret(name string) *v1.Secret {
+	for _, v := range a.pod.Secrets {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// podPullSecrets return image pull secrets of pod, pull secrets not delivered with pod are skipped
+func (a *PodActor) podPullSecrets() []*v1.Secret {
+	secrets := []*v1.Secret{}
+	for _, ref := range a.pod.Pod.Spec.ImagePullSecrets {
+		if secret := a.findSecret(ref.Name); secret != nil {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+// projectSecretVolumes write secret data into host directory of each secret volume, directory is only accessible by its owner,
+// file mode is volume default mode or 0400
+func (a *PodActor) projectSecretVolumes() error {
+	pod := a.pod.Pod
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Secret == nil {
+			continue
+		}
+		secret := a.findSecret(vol.Secret.SecretName)
+		if secret == nil {
+			if vol.Secret.Optional == nil || !*vol.Secret.Optional {
+				return fmt.Errorf("secret %s of volume %s not found", vol.Secret.SecretName, vol.Name)
+			}
+			secret = &v1.Secret{}
+		}
+		dir := config.GetPodVolumeDir(a.nodeConfig.RootPath, pod.UID, resource.SecretVolumePluginName, vol.Name)
+		mode := os.FileMode(0400)
+		if vol.Secret.DefaultMode != nil {
+			mode = os.FileMode(*vol.Secret.DefaultMode)
+		}
+		if err := writeVolumeFiles(dir, secret.Data, 0700, mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
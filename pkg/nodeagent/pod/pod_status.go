@@ -18,13 +18,17 @@ package pod
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/images"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/runtime"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
 var (
@@ -36,6 +40,36 @@ var (
 	ErrRecoverPod            = errors.New("RecoverPodError")
 )
 
+// setPodCreateFailureReason set image pull failure as pod status reason, so fornaxcore can tell user why application instance failed
+func setPodCreateFailureReason(fppod *types.FornaxPod, err error) {
+	for _, reason := range []error{images.ErrImagePullAuth, images.ErrImagePull} {
+		if errors.Is(err, reason) {
+			fppod.Pod.Status.Reason = reason.Error()
+			fppod.Pod.Status.Message = err.Error()
+			return
+		}
+	}
+}
+
+// setInitContainerFailureReason set init container failure as pod status reason, err is nil if init container exit abnormally,
+// or it's the error of starting next container
+func setInitContainerFailureReason(fppod *types.FornaxPod, container *types.FornaxContainer, err error) {
+	fppod.Pod.Status.Reason = util.PodReasonInitContainerError
+	if err != nil {
+		setPodCreateFailureReason(fppod, err)
+		if fppod.Pod.Status.Reason == util.PodReasonInitContainerError {
+			fppod.Pod.Status.Message = fmt.Sprintf("Failed to start next container after init container %s, %v", container.ContainerSpec.Name, err)
+		}
+		return
+	}
+	message := fmt.Sprintf("Init container %s exited abnormally", container.ContainerSpec.Name)
+	if container.ContainerStatus != nil && container.ContainerStatus.RuntimeStatus != nil {
+		status := container.ContainerStatus.RuntimeStatus
+		message = fmt.Sprintf("Init container %s exited with code %d, reason: %s, %s", container.ContainerSpec.Name, status.ExitCode, status.Reason, status.Message)
+	}
+	fppod.Pod.Status.Message = message
+}
+
 func SetPodStatus(fppod *types.FornaxPod, node *v1.Node) {
 
 	// pod phase
@@ -76,12 +110,78 @@ func SetPodStatus(fppod *types.FornaxPod, node *v1.Node) {
 		// post metrics pod start time - pod create time
 	}
 
+	// container status
+	podStatus.InitContainerStatuses, podStatus.ContainerStatuses = GetPodContainerStatuses(fppod)
+
 	//TODO
 	// add resource status
 
 	fppod.Pod.Status = *podStatus
 }
 
+// GetPodContainerStatuses return v1 container status of init containers and application containers,
+// container waiting for restart is reported as waiting with CrashLoopBackOff reason
+func GetPodContainerStatuses(fppod *types.FornaxPod) (initContainerStatuses, containerStatuses []v1.ContainerStatus) {
+	for _, spec := range fppod.Pod.Spec.InitContainers {
+		if v, found := fppod.Containers[spec.Name]; found && v.InitContainer {
+			initContainerStatuses = append(initContainerStatuses, toV1ContainerStatus(v))
+		}
+	}
+	for _, spec := range fppod.Pod.Spec.Containers {
+		if v, found := fppod.Containers[spec.Name]; found && !v.InitContainer {
+			containerStatuses = append(containerStatuses, toV1ContainerStatus(v))
+		}
+	}
+	return initContainerStatuses, containerStatuses
+}
+
+func toV1ContainerStatus(container *types.FornaxContainer) v1.ContainerStatus {
+	status := v1.ContainerStatus{
+		Name:         container.ContainerSpec.Name,
+		Image:        container.ContainerSpec.Image,
+		RestartCount: container.RestartCount,
+		Ready:        container.State == types.ContainerStateRunning || container.State == types.ContainerStateHibernated,
+	}
+	if container.RuntimeContainer != nil {
+		status.ContainerID = container.RuntimeContainer.Id
+	}
+	if container.LastTerminationState != nil {
+		status.LastTerminationState.Terminated = container.LastTerminationState.DeepCopy()
+	}
+
+	var runtimeStatus *criv1.ContainerStatus
+	if container.ContainerStatus != nil {
+		runtimeStatus = container.ContainerStatus.RuntimeStatus
+	}
+	switch {
+	case container.State == types.ContainerStateRestarting:
+		status.State.Waiting = &v1.ContainerStateWaiting{
+			Reason:  "CrashLoopBackOff",
+			Message: fmt.Sprintf("back-off %s restarting failed container", container.RestartBackoff),
+		}
+	case runtimeStatus == nil:
+		status.State.Waiting = &v1.ContainerStateWaiting{Reason: "ContainerCreating"}
+	case runtime.ContainerExit(container.ContainerStatus):
+		status.State.Terminated = &v1.ContainerStateTerminated{
+			ExitCode:    runtimeStatus.ExitCode,
+			Reason:      runtimeStatus.Reason,
+			Message:     runtimeStatus.Message,
+			StartedAt:   metav1.NewTime(time.Unix(0, runtimeStatus.StartedAt)),
+			FinishedAt:  metav1.NewTime(time.Unix(0, runtimeStatus.FinishedAt)),
+			ContainerID: runtimeStatus.Id,
+		}
+	case runtime.ContainerRunning(container.ContainerStatus):
+		started := true
+		status.Started = &started
+		status.State.Running = &v1.ContainerStateRunning{
+			StartedAt: metav1.NewTime(time.Unix(0, runtimeStatus.StartedAt)),
+		}
+	default:
+		status.State.Waiting = &v1.ContainerStateWaiting{Reason: runtimeStatus.Reason}
+	}
+	return status
+}
+
 func ToV1PodPhase(fppod *types.FornaxPod) v1.PodPhase {
 	var podPhase v1.PodPhase
 
@@ -116,7 +216,8 @@ func ToV1PodPhase(fppod *types.FornaxPod) v1.PodPhase {
 
 	// check container runtime state
 	for _, v := range fppod.Containers {
-		if runtime.ContainerExitAbnormal(v.ContainerStatus) {
+		// container waiting for restart does not fail pod
+		if v.State != types.ContainerStateRestarting && runtime.ContainerExitAbnormal(v.ContainerStatus) {
 			podPhase = v1.PodFailed
 			break
 		}
@@ -156,8 +257,20 @@ func GetPodConditions(fppod *types.FornaxPod) []v1.PodCondition {
 	}
 	conditions[v1.PodScheduled] = &podScheduledCondition
 
-	// check init container runtime status
+	// check init container runtime status, init containers are created one by one, missing init container is not finished yet
 	allInitContainerNormal := true
+	initContainers := 0
+	for _, v := range fppod.Containers {
+		if v.InitContainer {
+			initContainers += 1
+		}
+	}
+	if initContainers != len(fppod.Pod.Spec.InitContainers) {
+		initReadyCondition.Status = v1.ConditionFalse
+		initReadyCondition.Message = "init container not finished yet"
+		initReadyCondition.Reason = "init container not finished yet"
+		allInitContainerNormal = false
+	}
 	for _, v := range fppod.Containers {
 		if v.InitContainer {
 			if !runtime.ContainerExit(v.ContainerStatus) {
@@ -17,73 +17,194 @@ limitations under the License.
 package resource
 
 import (
+	"fmt"
+	"sync"
+
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/cadvisor"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/store"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
 	cadvisorinfov1 "github.com/google/cadvisor/info/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
 )
 
 var _ ResoureManager = &CPUManager{}
 
+// CPUManager implement kubelet static cpu policy, containers of guaranteed pods with integer cpu limits are pinned to exclusive cpus,
+// all other containers share cpus which are not assigned exclusively, reserved system cpus are always in shared pool.
+// assignments are saved in node agent store, so pinned containers keep their cpus after node agent restart
 type CPUManager struct {
-	channel               chan cadvisor.NodeCAdvisorInfo
-	advisor               cadvisor.CAdvisorInfoProvider
-	MachineInfo           cadvisorinfov1.MachineInfo
-	ReservedSystemCPUs    resource.Quantity
-	ReservedNodeAgentCPUs resource.Quantity
+	mu                 sync.RWMutex
+	store              *store.CPUAssignmentStore
+	MachineInfo        cadvisorinfov1.MachineInfo
+	ReservedSystemCPUs cpuset.CPUSet
+	// cpus sorted by numa node and core, hyper threads of a core are next to each other, so they are assigned together
+	cpus []int
+	// cpus never assigned exclusively, it's reserved system cpus, or first cpu if no cpu is reserved, so shared pool is never empty
+	sharedOnlyCPUs cpuset.CPUSet
+	assignments    map[string]*types.CPUAssignment
 }
 
-func NewCpuManager(nodeConfig config.NodeConfiguration, advisor cadvisor.CAdvisorInfoProvider) *CPUManager {
+func NewCpuManager(nodeConfig config.NodeConfiguration, advisor cadvisor.CAdvisorInfoProvider, assignmentStore *store.CPUAssignmentStore) (*CPUManager, error) {
+	nodeCAdvisorInfo, err := advisor.GetNodeCAdvisorInfo()
+	if err != nil {
+		return nil, err
+	}
+
 	manager := &CPUManager{
-		channel: make(chan cadvisor.NodeCAdvisorInfo),
-		advisor: advisor,
+		store:       assignmentStore,
+		MachineInfo: *nodeCAdvisorInfo.MachineInfo.Clone(),
+		assignments: map[string]*types.CPUAssignment{},
+	}
+	for _, node := range manager.MachineInfo.Topology {
+		for _, core := range node.Cores {
+			manager.cpus = append(manager.cpus, core.Threads...)
+		}
+	}
+	if len(manager.cpus) == 0 {
+		for i := 0; i < manager.MachineInfo.NumCores; i++ {
+			manager.cpus = append(manager.cpus, i)
+		}
+	}
+	allCPUs := cpuset.NewCPUSet(manager.cpus...)
+	if !nodeConfig.ReservedSystemCPUs.IsSubsetOf(allCPUs) {
+		return nil, fmt.Errorf("reserved system cpus %s are not subset of node cpus %s", nodeConfig.ReservedSystemCPUs.String(), allCPUs.String())
+	}
+	manager.ReservedSystemCPUs = nodeConfig.ReservedSystemCPUs
+	manager.sharedOnlyCPUs = nodeConfig.ReservedSystemCPUs
+	if manager.sharedOnlyCPUs.IsEmpty() && len(manager.cpus) > 0 {
+		manager.sharedOnlyCPUs = cpuset.NewCPUSet(manager.cpus[0])
 	}
 
-	nodeCAdvisorInfo, err := advisor.GetNodeCAdvisorInfo()
+	if err := manager.loadAssignments(); err != nil {
+		return nil, err
+	}
+	return manager, nil
+}
+
+// loadAssignments restore cpu assignments saved before node agent restart, assignment using cpus which can not be assigned any more is dropped
+func (m *CPUManager) loadAssignments() error {
+	assignments, err := m.store.ListCPUAssignments()
 	if err != nil {
-		return nil
-	} else {
-		manager.MachineInfo = *nodeCAdvisorInfo.MachineInfo.Clone()
+		return err
 	}
+	assigned := cpuset.NewCPUSet()
+	for _, v := range assignments {
+		cpus, err := cpuset.Parse(v.CPUs)
+		if err != nil || !cpus.IsSubsetOf(m.exclusiveCPUs()) || !cpus.Intersection(assigned).IsEmpty() {
+			klog.InfoS("Drop invalid cpu assignment", "pod", v.PodIdentifier, "cpus", v.CPUs)
+			m.store.DelObject(v.PodIdentifier)
+			continue
+		}
+		assigned = assigned.Union(cpus)
+		m.assignments[v.PodIdentifier] = v
+	}
+	klog.InfoS("Loaded exclusive cpu assignments", "pods", len(m.assignments), "cpus", assigned.String())
+	return nil
+}
 
-	advisor.ReceiveCAdvisorInfo("CPUManager", &manager.channel)
-	return manager
+// exclusiveCPUs return cpus which can be assigned exclusively
+func (m *CPUManager) exclusiveCPUs() cpuset.CPUSet {
+	return cpuset.NewCPUSet(m.cpus...).Difference(m.sharedOnlyCPUs)
 }
 
-func (m *CPUManager) Start() error {
-	go func() {
-		// update memory information with received machineInfo
+func (m *CPUManager) assignedCPUs() cpuset.CPUSet {
+	assigned := cpuset.NewCPUSet()
+	for _, v := range m.assignments {
+		assigned = assigned.Union(cpuset.MustParse(v.CPUs))
+	}
+	return assigned
+}
 
-	}()
-	return nil
+// ExclusiveCPUCapacity return number of cpus which can be pinned to pods, node report it as extended resource to let fornaxcore schedule pods
+func (m *CPUManager) ExclusiveCPUCapacity() int {
+	return m.exclusiveCPUs().Size()
+}
+
+// SharedCPUs return cpus which are not assigned exclusively, containers without exclusive cpus are pinned to them
+func (m *CPUManager) SharedCPUs() cpuset.CPUSet {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return cpuset.NewCPUSet(m.cpus...).Difference(m.assignedCPUs())
+}
+
+// GetContainerCPUSet return exclusive cpus of container if it has, or shared cpus
+func (m *CPUManager) GetContainerCPUSet(pod *v1.Pod, container *v1.Container) string {
+	if cpus, found := m.GetContainerExclusiveCPUSet(pod, container); found {
+		return cpus
+	}
+	return m.SharedCPUs().String()
+}
+
+// GetContainerExclusiveCPUSet return cpus pinned to container exclusively
+func (m *CPUManager) GetContainerExclusiveCPUSet(pod *v1.Pod, container *v1.Container) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if assignment, found := m.assignments[util.Name(pod)]; found {
+		cpus, found := assignment.Containers[container.Name]
+		return cpus, found
+	}
+	return "", false
+}
+
+// RemoveOrphanAssignments release cpus of pods which are not on node any more,
+// e.g. node agent crashed after pod was removed but before its cpus were released
+func (m *CPUManager) RemoveOrphanAssignments(podExist func(podIdentifier string) bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, v := range m.assignments {
+		if !podExist(k) {
+			klog.InfoS("Release cpus of orphan pod", "pod", k, "cpus", v.CPUs)
+			if err := m.store.DelObject(k); err != nil {
+				klog.ErrorS(err, "Failed to delete cpu assignment", "pod", k)
+				continue
+			}
+			delete(m.assignments, k)
+		}
+	}
 }
 
 // GetReservedResource implements ResoureManager
-func (*CPUManager) GetReservedResource() NodeResource {
+func (m *CPUManager) GetReservedResource() NodeResource {
 	return NodeResource{
-		Resources: map[v1.ResourceName]resource.Quantity{},
+		Resources: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU: util.ResourceQuantity(int64(m.ReservedSystemCPUs.Size()*1000), v1.ResourceCPU),
+		},
 	}
 }
 
-// GetAllocatedResource implements ResoureManager
-func (*CPUManager) GetAllocatedResource() NodeResource {
+// GetAllocatedResource implements ResoureManager, it's cpus assigned exclusively, in same unit as node capacity, one cpu is 1000
+func (m *CPUManager) GetAllocatedResource() NodeResource {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return NodeResource{
-		Resources: map[v1.ResourceName]resource.Quantity{},
+		Resources: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU: util.ResourceQuantity(int64(m.assignedCPUs().Size()*1000), v1.ResourceCPU),
+		},
 	}
 }
 
-// GetAvailableResource implements ResoureManager
-func (*CPUManager) GetAvailableResource() NodeResource {
+// GetAvailableResource implements ResoureManager, it's cpus can be assigned exclusively, one cpu is 1000
+func (m *CPUManager) GetAvailableResource() NodeResource {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return NodeResource{
-		Resources: map[v1.ResourceName]resource.Quantity{},
+		Resources: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU: util.ResourceQuantity(int64(m.exclusiveCPUs().Difference(m.assignedCPUs()).Size()*1000), v1.ResourceCPU),
+		},
 	}
 }
 
-// GetPodResource implements ResoureManager
-func (*CPUManager) GetPodResource(v1.Pod) PodResource {
+// GetPodResource implements ResoureManager, it's exclusive cpus pod need, one cpu is 1000
+func (*CPUManager) GetPodResource(pod v1.Pod) PodResource {
 	return PodResource{
-		Resources: map[v1.ResourceName]resource.Quantity{},
+		Resources: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU: util.ResourceQuantity(util.GetPodExclusiveCPUs(&pod)*1000, v1.ResourceCPU),
+		},
 	}
 }
 
@@ -92,24 +213,101 @@ func (*CPUManager) ReserveResource(NodeResource) bool {
 	panic("unimplemented")
 }
 
-// Admit implements ResoureManager
-func (*CPUManager) DryRunAdmit(v1.Pod) error {
-	panic("unimplemented")
+// DryRunAdmit implements ResoureManager, it check if there are enough free cpus for pod
+func (m *CPUManager) DryRunAdmit(pod v1.Pod) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.admit(&pod)
+}
+
+func (m *CPUManager) admit(pod *v1.Pod) error {
+	request := util.GetPodExclusiveCPUs(pod)
+	if request == 0 {
+		return nil
+	}
+	if _, found := m.assignments[util.Name(pod)]; found {
+		return nil
+	}
+	free := m.exclusiveCPUs().Difference(m.assignedCPUs())
+	if int64(free.Size()) < request {
+		return fmt.Errorf("not enough exclusive cpus for pod %s, request %d, available %d", util.Name(pod), request, free.Size())
+	}
+	return nil
 }
 
 // Admit implements ResoureManager
-func (*CPUManager) Admit(v1.Pod) error {
-	panic("unimplemented")
+func (m *CPUManager) Admit(pod v1.Pod) error {
+	return m.DryRunAdmit(pod)
 }
 
-// Allocate implements ResoureManager
-func (*CPUManager) Allocate(v1.Pod) error {
-	panic("unimplemented")
+// Allocate implements ResoureManager, it pin free cpus to pod containers and save assignment,
+// application containers get disjoint cpus, init containers reuse cpus from beginning of pod cpus
+func (m *CPUManager) Allocate(pod v1.Pod) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.admit(&pod); err != nil {
+		return err
+	}
+	request := util.GetPodExclusiveCPUs(&pod)
+	if request == 0 {
+		return nil
+	}
+	identifier := util.Name(&pod)
+	if _, found := m.assignments[identifier]; found {
+		return nil
+	}
+
+	free := m.exclusiveCPUs().Difference(m.assignedCPUs())
+	cpus := []int{}
+	for _, v := range m.cpus {
+		if int64(len(cpus)) == request {
+			break
+		}
+		if free.Contains(v) {
+			cpus = append(cpus, v)
+		}
+	}
+	assignment := &types.CPUAssignment{
+		PodIdentifier: identifier,
+		CPUs:          cpuset.NewCPUSet(cpus...).String(),
+		Containers:    map[string]string{},
+	}
+	next := int64(0)
+	for i, v := range pod.Spec.Containers {
+		if n := util.GetContainerExclusiveCPUs(&pod, &pod.Spec.Containers[i]); n > 0 {
+			assignment.Containers[v.Name] = cpuset.NewCPUSet(cpus[next : next+n]...).String()
+			next += n
+		}
+	}
+	for i, v := range pod.Spec.InitContainers {
+		if n := util.GetContainerExclusiveCPUs(&pod, &pod.Spec.InitContainers[i]); n > 0 {
+			assignment.Containers[v.Name] = cpuset.NewCPUSet(cpus[:n]...).String()
+		}
+	}
+
+	if err := m.store.PutCPUAssignment(assignment); err != nil {
+		return err
+	}
+	m.assignments[identifier] = assignment
+	klog.InfoS("Assigned exclusive cpus to pod", "pod", identifier, "cpus", assignment.CPUs)
+	return nil
 }
 
-// Deallocate implements ResoureManager
-func (*CPUManager) Deallocate(v1.Pod) error {
-	panic("unimplemented")
+// Deallocate implements ResoureManager, cpus of pod return to shared pool
+func (m *CPUManager) Deallocate(pod v1.Pod) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	identifier := util.Name(&pod)
+	assignment, found := m.assignments[identifier]
+	if !found {
+		return nil
+	}
+	if err := m.store.DelObject(identifier); err != nil {
+		return err
+	}
+	delete(m.assignments, identifier)
+	klog.InfoS("Released exclusive cpus of pod", "pod", identifier, "cpus", assignment.CPUs)
+	return nil
 }
 
 // reference
@@ -0,0 +1,192 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/cadvisor"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/store"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/store/storage/sqlite"
+	cadvisorinfov1 "github.com/google/cadvisor/info/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+)
+
+// fakeCAdvisor return a machine with given number of cpus without topology
+type fakeCAdvisor struct {
+	numCores int
+}
+
+func (f *fakeCAdvisor) Start() error { return nil }
+
+func (f *fakeCAdvisor) Stop() error { return nil }
+
+func (f *fakeCAdvisor) ReceiveCAdvisorInfo(id string, receiver *chan cadvisor.NodeCAdvisorInfo) {}
+
+func (f *fakeCAdvisor) GetNodeCAdvisorInfo() (*cadvisor.NodeCAdvisorInfo, error) {
+	return &cadvisor.NodeCAdvisorInfo{MachineInfo: &cadvisorinfov1.MachineInfo{NumCores: f.numCores}}, nil
+}
+
+func newTestAssignmentStore(t *testing.T, dir string) *store.CPUAssignmentStore {
+	assignmentStore, err := store.NewCPUAssignmentSqliteStore(&sqlite.SQLiteStoreOptions{ConnUrl: filepath.Join(dir, "cpu.db")})
+	if err != nil {
+		t.Fatalf("Failed to create cpu assignment store, err %v", err)
+	}
+	return assignmentStore
+}
+
+func newTestCPUManager(t *testing.T, assignmentStore *store.CPUAssignmentStore) *CPUManager {
+	nodeConfig := config.NodeConfiguration{ReservedSystemCPUs: cpuset.MustParse("0")}
+	manager, err := NewCpuManager(nodeConfig, &fakeCAdvisor{numCores: 8}, assignmentStore)
+	if err != nil {
+		t.Fatalf("Failed to create cpu manager, err %v", err)
+	}
+	return manager
+}
+
+func newTestContainer(name string, cpus int64) v1.Container {
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewQuantity(cpus, resource.DecimalSI),
+		v1.ResourceMemory: resource.MustParse("64Mi"),
+	}
+	return v1.Container{
+		Name:      name,
+		Resources: v1.ResourceRequirements{Limits: resources, Requests: resources.DeepCopy()},
+	}
+}
+
+// newTestPod return a guaranteed pod with one init container and application containers using given number of cpus
+func newTestPod(name string, initContainerCPUs int64, containerCPUs ...int64) v1.Pod {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{newTestContainer("init", initContainerCPUs)},
+		},
+	}
+	for i, v := range containerCPUs {
+		pod.Spec.Containers = append(pod.Spec.Containers, newTestContainer(fmt.Sprintf("container%d", i), v))
+	}
+	return pod
+}
+
+func assertContainerCPUs(t *testing.T, manager *CPUManager, pod *v1.Pod, container *v1.Container, expected string) {
+	cpus, found := manager.GetContainerExclusiveCPUSet(pod, container)
+	if !found || cpus != expected {
+		t.Errorf("Container %s is pinned to cpus %s, found %v, expected %s", container.Name, cpus, found, expected)
+	}
+}
+
+func assertCPUQuantity(t *testing.T, name string, nodeResource NodeResource, expected int64) {
+	quantity := nodeResource.Resources[v1.ResourceCPU]
+	if quantity.Value() != expected {
+		t.Errorf("%s cpu is %s, expected %d", name, quantity.String(), expected)
+	}
+}
+
+func TestAllocateExclusiveCPUs(t *testing.T) {
+	manager := newTestCPUManager(t, newTestAssignmentStore(t, t.TempDir()))
+
+	// init containers run before application containers, they reuse cpus from beginning of pod cpus
+	pod1 := newTestPod("pod1", 1, 2, 1)
+	if err := manager.Allocate(pod1); err != nil {
+		t.Fatalf("Failed to allocate cpus to pod, err %v", err)
+	}
+	assertContainerCPUs(t, manager, &pod1, &pod1.Spec.Containers[0], "1-2")
+	assertContainerCPUs(t, manager, &pod1, &pod1.Spec.Containers[1], "3")
+	assertContainerCPUs(t, manager, &pod1, &pod1.Spec.InitContainers[0], "1")
+
+	// init container need more cpus than application containers, pod get cpus init container need
+	pod2 := newTestPod("pod2", 3, 1)
+	if err := manager.Allocate(pod2); err != nil {
+		t.Fatalf("Failed to allocate cpus to pod, err %v", err)
+	}
+	assertContainerCPUs(t, manager, &pod2, &pod2.Spec.Containers[0], "4")
+	assertContainerCPUs(t, manager, &pod2, &pod2.Spec.InitContainers[0], "4-6")
+
+	if shared := manager.SharedCPUs().String(); shared != "0,7" {
+		t.Errorf("Shared cpus are %s, expected 0,7", shared)
+	}
+	assertCPUQuantity(t, "Reserved", manager.GetReservedResource(), 1000)
+	assertCPUQuantity(t, "Allocated", manager.GetAllocatedResource(), 6000)
+	assertCPUQuantity(t, "Available", manager.GetAvailableResource(), 1000)
+	assertCPUQuantity(t, "Pod", NodeResource(manager.GetPodResource(pod2)), 3000)
+
+	if err := manager.Allocate(newTestPod("pod3", 1, 2)); err == nil {
+		t.Errorf("Pod is allocated more cpus than available")
+	}
+
+	if err := manager.Deallocate(pod1); err != nil {
+		t.Fatalf("Failed to deallocate cpus of pod, err %v", err)
+	}
+	if shared := manager.SharedCPUs().String(); shared != "0-3,7" {
+		t.Errorf("Shared cpus are %s after pod deallocated, expected 0-3,7", shared)
+	}
+	if _, found := manager.GetContainerExclusiveCPUSet(&pod1, &pod1.Spec.Containers[0]); found {
+		t.Errorf("Deallocated pod still has exclusive cpus")
+	}
+}
+
+func TestRestoreCPUAssignments(t *testing.T) {
+	assignmentStore := newTestAssignmentStore(t, t.TempDir())
+	manager := newTestCPUManager(t, assignmentStore)
+	pod1 := newTestPod("pod1", 1, 2)
+	pod2 := newTestPod("pod2", 1, 1)
+	for _, pod := range []v1.Pod{pod1, pod2} {
+		if err := manager.Allocate(pod); err != nil {
+			t.Fatalf("Failed to allocate cpus to pod, err %v", err)
+		}
+	}
+
+	// assignments using shared only cpus or cpus not on node are dropped
+	for _, v := range []*types.CPUAssignment{
+		{PodIdentifier: "test/reserved", CPUs: "0", Containers: map[string]string{"container0": "0"}},
+		{PodIdentifier: "test/missing", CPUs: "8-9", Containers: map[string]string{"container0": "8-9"}},
+	} {
+		if err := assignmentStore.PutCPUAssignment(v); err != nil {
+			t.Fatalf("Failed to save cpu assignment, err %v", err)
+		}
+	}
+
+	restored := newTestCPUManager(t, assignmentStore)
+	assertContainerCPUs(t, restored, &pod1, &pod1.Spec.Containers[0], "1-2")
+	assertContainerCPUs(t, restored, &pod2, &pod2.Spec.Containers[0], "3")
+	assertCPUQuantity(t, "Allocated", restored.GetAllocatedResource(), 3000)
+	assignments, err := assignmentStore.ListCPUAssignments()
+	if err != nil {
+		t.Fatalf("Failed to list cpu assignments, err %v", err)
+	}
+	if len(assignments) != 2 {
+		t.Errorf("Invalid cpu assignments are not deleted from store, got %d assignments", len(assignments))
+	}
+
+	// pod2 was removed before node agent restart, its cpus are released
+	restored.RemoveOrphanAssignments(func(podIdentifier string) bool { return podIdentifier == "test/pod1" })
+	if _, found := restored.GetContainerExclusiveCPUSet(&pod2, &pod2.Spec.Containers[0]); found {
+		t.Errorf("Orphan pod still has exclusive cpus")
+	}
+	assertCPUQuantity(t, "Available", restored.GetAvailableResource(), 5000)
+	if assignments, _ := assignmentStore.ListCPUAssignments(); len(assignments) != 1 || assignments[0].PodIdentifier != "test/pod1" {
+		t.Errorf("Orphan cpu assignment is not deleted from store, got %v", assignments)
+	}
+}
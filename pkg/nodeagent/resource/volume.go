@@ -17,23 +17,341 @@ limitations under the License.
 package resource
 
 import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/config"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/mount-utils"
+)
+
+const (
+	EmptyDirVolumePluginName  = "kubernetes.io~empty-dir"
+	ConfigMapVolumePluginName = "kubernetes.io~configmap"
+	SecretVolumePluginName    = "kubernetes.io~secret"
 )
 
 var _ ResoureManager = &VolumeManager{}
 
+// VolumeManager set up pod volumes under pod volumes dir created by MakePodDataDirs, so, they are removed by CleanupPodDataDirs,
+// emptyDir volume is a plain directory, or a tmpfs mount if medium is memory,
+// hostPath volume is mounted directly, host path must be under one of operator allowed host paths,
+// configMap and secret volumes are directories which pod actor write config map and secret data into
 type VolumeManager struct {
+	rootPath         string
+	allowedHostPaths []string
+	mounter          mount.Interface
+}
+
+func NewVolumeManager(rootPath string, allowedHostPaths []string, mounter mount.Interface) VolumeManager {
+	return VolumeManager{
+		rootPath:         rootPath,
+		allowedHostPaths: allowedHostPaths,
+		mounter:          mounter,
+	}
+}
+
+// UnmountPodVolume unmount tmpfs of memory emptyDir volumes, volume dirs are removed with pod data dirs
+func (m *VolumeManager) UnmountPodVolume(pod *v1.Pod) error {
+	for _, vol := range pod.Spec.Volumes {
+		if vol.EmptyDir == nil || vol.EmptyDir.Medium != v1.StorageMediumMemory {
+			continue
+		}
+		dir := config.GetPodVolumeDir(m.rootPath, pod.UID, EmptyDirVolumePluginName, vol.Name)
+		notMnt, err := m.mounter.IsLikelyNotMountPoint(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if !notMnt {
+			if err := m.mounter.Unmount(dir); err != nil {
+				return fmt.Errorf("failed to unmount tmpfs of volume %s: %v", vol.Name, err)
+			}
+		}
+	}
+	return nil
 }
 
-func (*VolumeManager) UnmountPodVolume(*v1.Pod) error {
+// WaitForAttachAndMount create emptyDir volume dirs and mount tmpfs for memory emptyDir, and check host path volumes are allowed,
+// it return error if pod use a volume type which is not supported
+func (m *VolumeManager) WaitForAttachAndMount(pod *v1.Pod) error {
+	for _, vol := range pod.Spec.Volumes {
+		switch {
+		case vol.EmptyDir != nil:
+			if err := m.setupEmptyDir(pod, vol); err != nil {
+				return err
+			}
+		case vol.HostPath != nil:
+			if err := m.setupHostPath(vol); err != nil {
+				return err
+			}
+		case vol.ConfigMap != nil, vol.Secret != nil:
+			// written by pod actor, it has config map and secret data
+		default:
+			return fmt.Errorf("volume %s of pod %s use a unsupported volume type", vol.Name, pod.Name)
+		}
+	}
 	return nil
 }
 
-func (*VolumeManager) WaitForAttachAndMount(*v1.Pod) error {
+func (m *VolumeManager) setupEmptyDir(pod *v1.Pod, vol v1.Volume) error {
+	dir := config.GetPodVolumeDir(m.rootPath, pod.UID, EmptyDirVolumePluginName, vol.Name)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+	// emptyDir is writable by any user in container like kubelet does
+	if err := os.Chmod(dir, 0777); err != nil {
+		return err
+	}
+	if vol.EmptyDir.Medium != v1.StorageMediumMemory {
+		return nil
+	}
+
+	notMnt, err := m.mounter.IsLikelyNotMountPoint(dir)
+	if err != nil {
+		return err
+	}
+	if !notMnt {
+		// pod is recreated after node agent restart
+		return nil
+	}
+	options := []string{}
+	if vol.EmptyDir.SizeLimit != nil && !vol.EmptyDir.SizeLimit.IsZero() {
+		options = append(options, fmt.Sprintf("size=%d", vol.EmptyDir.SizeLimit.Value()))
+	}
+	klog.InfoS("Mount tmpfs for emptyDir volume", "pod", pod.Name, "volume", vol.Name, "options", options)
+	return m.mounter.Mount("tmpfs", dir, "tmpfs", options)
+}
+
+// IsHostPathAllowed return true if path is one of allowed host paths or a sub path of them after symlinks are resolved,
+// so, a symlink under allowed host path can not point to a path outside
+func (m *VolumeManager) IsHostPathAllowed(path string) bool {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range m.allowedHostPaths {
+		resolvedAllowed, err := resolvePath(allowed)
+		if err != nil {
+			continue
+		}
+		if isPathWithin(resolved, resolvedAllowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePath return absolute path with all symlinks resolved, tail of path which does not exist yet is appended to resolved existing part,
+// a dangling symlink is not allowed since what it point to could be created later
+func resolvePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	rest := ""
+	for {
+		resolved, err := filepath.EvalSymlinks(path)
+		if err == nil {
+			return filepath.Join(resolved, rest), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if _, lerr := os.Lstat(path); lerr == nil {
+			return "", fmt.Errorf("path %s is a dangling symlink", path)
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return "", err
+		}
+		rest = filepath.Join(filepath.Base(path), rest)
+		path = parent
+	}
+}
+
+// isPathWithin return true if path is root or under root, both paths must be clean
+func isPathWithin(path, root string) bool {
+	return path == root || strings.HasPrefix(path, strings.TrimSuffix(root, "/")+"/")
+}
+
+func (m *VolumeManager) setupHostPath(vol v1.Volume) error {
+	path := vol.HostPath.Path
+	if !filepath.IsAbs(path) || !m.IsHostPathAllowed(path) {
+		return fmt.Errorf("host path %s of volume %s is not allowed on this node", path, vol.Name)
+	}
+
+	hostPathType := v1.HostPathUnset
+	if vol.HostPath.Type != nil {
+		hostPathType = *vol.HostPath.Type
+	}
+	info, err := os.Stat(path)
+	switch hostPathType {
+	case v1.HostPathUnset:
+		return nil
+	case v1.HostPathDirectoryOrCreate:
+		if os.IsNotExist(err) {
+			return os.MkdirAll(path, 0755)
+		}
+	case v1.HostPathFileOrCreate:
+		if os.IsNotExist(err) {
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(path, os.O_CREATE, 0644)
+			if err != nil {
+				return err
+			}
+			return f.Close()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("host path %s of volume %s is not available, %v", path, vol.Name, err)
+	}
+	switch hostPathType {
+	case v1.HostPathDirectory, v1.HostPathDirectoryOrCreate:
+		if !info.IsDir() {
+			return fmt.Errorf("host path %s of volume %s is not a directory", path, vol.Name)
+		}
+	case v1.HostPathFile, v1.HostPathFileOrCreate:
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("host path %s of volume %s is not a file", path, vol.Name)
+		}
+	case v1.HostPathSocket:
+		if info.Mode()&fs.ModeSocket == 0 {
+			return fmt.Errorf("host path %s of volume %s is not a socket", path, vol.Name)
+		}
+	case v1.HostPathCharDev:
+		if info.Mode()&fs.ModeCharDevice == 0 {
+			return fmt.Errorf("host path %s of volume %s is not a char device", path, vol.Name)
+		}
+	case v1.HostPathBlockDev:
+		if info.Mode()&fs.ModeDevice == 0 || info.Mode()&fs.ModeCharDevice != 0 {
+			return fmt.Errorf("host path %s of volume %s is not a block device", path, vol.Name)
+		}
+	}
 	return nil
 }
 
+// GetPodVolumeHostPath return directory on host which a pod volume is mounted from
+func (m *VolumeManager) GetPodVolumeHostPath(pod *v1.Pod, vol v1.Volume) string {
+	switch {
+	case vol.EmptyDir != nil:
+		return config.GetPodVolumeDir(m.rootPath, pod.UID, EmptyDirVolumePluginName, vol.Name)
+	case vol.ConfigMap != nil:
+		return config.GetPodVolumeDir(m.rootPath, pod.UID, ConfigMapVolumePluginName, vol.Name)
+	case vol.Secret != nil:
+		return config.GetPodVolumeDir(m.rootPath, pod.UID, SecretVolumePluginName, vol.Name)
+	case vol.HostPath != nil:
+		return vol.HostPath.Path
+	}
+	return ""
+}
+
+// GetContainerMounts return runtime mounts of volumes mounted by container, configMap and secret volumes are always read-only,
+// volume and sub paths are mounted using their symlink resolved paths, a sub path resolved to outside of volume is rejected,
+// e.g. a symlink created by a init container in a shared emptyDir which point to a host path
+func (m *VolumeManager) GetContainerMounts(pod *v1.Pod, container *v1.Container) ([]*criv1.Mount, error) {
+	mounts := []*criv1.Mount{}
+	for _, vm := range container.VolumeMounts {
+		var volume *v1.Volume
+		for i := range pod.Spec.Volumes {
+			if pod.Spec.Volumes[i].Name == vm.Name {
+				volume = &pod.Spec.Volumes[i]
+				break
+			}
+		}
+		if volume == nil {
+			return nil, fmt.Errorf("volume %s mounted by container %s not found", vm.Name, container.Name)
+		}
+		hostPath := m.GetPodVolumeHostPath(pod, *volume)
+		if len(hostPath) == 0 {
+			return nil, fmt.Errorf("volume %s mounted by container %s use a unsupported volume type", vm.Name, container.Name)
+		}
+		root, err := resolvePath(hostPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve path of volume %s, %v", vm.Name, err)
+		}
+		if volume.HostPath != nil && !m.IsHostPathAllowed(root) {
+			return nil, fmt.Errorf("host path %s of volume %s is not allowed on this node", hostPath, vm.Name)
+		}
+		hostPath = root
+		if len(vm.SubPath) > 0 {
+			subPath := filepath.Clean(vm.SubPath)
+			if filepath.IsAbs(subPath) || subPath == ".." || strings.HasPrefix(subPath, "../") {
+				return nil, fmt.Errorf("sub path %s of volume mount %s must be a relative path in volume", vm.SubPath, vm.Name)
+			}
+			hostPath, err = resolvePath(filepath.Join(root, subPath))
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve sub path %s of volume mount %s, %v", vm.SubPath, vm.Name, err)
+			}
+			if !isPathWithin(hostPath, root) {
+				return nil, fmt.Errorf("sub path %s of volume mount %s is outside of volume", vm.SubPath, vm.Name)
+			}
+		}
+		mounts = append(mounts, &criv1.Mount{
+			ContainerPath: vm.MountPath,
+			HostPath:      hostPath,
+			Readonly:      vm.ReadOnly || volume.ConfigMap != nil || volume.Secret != nil,
+		})
+	}
+	return mounts, nil
+}
+
+// GetPodVolumeUsage return bytes used by emptyDir, configMap and secret volumes of pod, host path usage is not collected,
+// emptyDir size limit is reported as limit, node actor evict pod whose disk emptyDir usage exceed limit
+func (m *VolumeManager) GetPodVolumeUsage(pod *v1.Pod) []types.PodVolumeUsage {
+	usages := []types.PodVolumeUsage{}
+	for _, vol := range pod.Spec.Volumes {
+		usage := types.PodVolumeUsage{
+			Name:     vol.Name,
+			HostPath: m.GetPodVolumeHostPath(pod, vol),
+		}
+		if vol.EmptyDir != nil {
+			usage.Medium = string(vol.EmptyDir.Medium)
+			if vol.EmptyDir.SizeLimit != nil {
+				usage.LimitBytes = vol.EmptyDir.SizeLimit.Value()
+			}
+		}
+		if vol.HostPath == nil && len(usage.HostPath) > 0 {
+			used, err := diskUsage(usage.HostPath)
+			if err != nil && !os.IsNotExist(err) {
+				klog.ErrorS(err, "Failed to get volume usage", "pod", pod.Name, "volume", vol.Name)
+			}
+			usage.UsedBytes = used
+		}
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// diskUsage sum size of regular files in dir
+func diskUsage(dir string) (int64, error) {
+	var used int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				used += info.Size()
+			}
+		}
+		return nil
+	})
+	return used, err
+}
+
 // GetReservedResource implements ResoureManager
 func (*VolumeManager) GetReservedResource() NodeResource {
 	return NodeResource{
@@ -41,6 +41,11 @@ func (*FakeRuntimeService) StopContainer(containerID string, gracePeriod time.Du
 	panic("unimplemented")
 }
 
+// UpdateContainerResources implements RuntimeService
+func (*FakeRuntimeService) UpdateContainerResources(containerID string, resources *criv1.LinuxContainerResources) error {
+	panic("unimplemented")
+}
+
 // GetPodSandbox implements RuntimeService
 func (*FakeRuntimeService) GetPodSandbox(podSandboxID string) (*criv1.PodSandbox, error) {
 	panic("unimplemented")
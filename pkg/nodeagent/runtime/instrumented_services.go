@@ -0,0 +1,134 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"time"
+
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/metrics"
+
+	criapi "k8s.io/cri-api/pkg/apis"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// instrumentedRuntimeService wrap a cri runtime service and record latency and errors of operations node agent use,
+// other operations are passed through without metrics
+type instrumentedRuntimeService struct {
+	criapi.RuntimeService
+}
+
+func newInstrumentedRuntimeService(service criapi.RuntimeService) criapi.RuntimeService {
+	return &instrumentedRuntimeService{RuntimeService: service}
+}
+
+func (in instrumentedRuntimeService) Status(verbose bool) (*criv1.StatusResponse, error) {
+	st := time.Now()
+	out, err := in.RuntimeService.Status(verbose)
+	metrics.ObserveCRIOperation("status", st, err)
+	return out, err
+}
+
+func (in instrumentedRuntimeService) RunPodSandbox(config *criv1.PodSandboxConfig, runtimeHandler string) (string, error) {
+	st := time.Now()
+	out, err := in.RuntimeService.RunPodSandbox(config, runtimeHandler)
+	metrics.ObserveCRIOperation("run_podsandbox", st, err)
+	return out, err
+}
+
+func (in instrumentedRuntimeService) StopPodSandbox(podSandboxID string) error {
+	st := time.Now()
+	err := in.RuntimeService.StopPodSandbox(podSandboxID)
+	metrics.ObserveCRIOperation("stop_podsandbox", st, err)
+	return err
+}
+
+func (in instrumentedRuntimeService) RemovePodSandbox(podSandboxID string) error {
+	st := time.Now()
+	err := in.RuntimeService.RemovePodSandbox(podSandboxID)
+	metrics.ObserveCRIOperation("remove_podsandbox", st, err)
+	return err
+}
+
+func (in instrumentedRuntimeService) PodSandboxStatus(podSandboxID string, verbose bool) (*criv1.PodSandboxStatusResponse, error) {
+	st := time.Now()
+	out, err := in.RuntimeService.PodSandboxStatus(podSandboxID, verbose)
+	metrics.ObserveCRIOperation("podsandbox_status", st, err)
+	return out, err
+}
+
+func (in instrumentedRuntimeService) ListPodSandbox(filter *criv1.PodSandboxFilter) ([]*criv1.PodSandbox, error) {
+	st := time.Now()
+	out, err := in.RuntimeService.ListPodSandbox(filter)
+	metrics.ObserveCRIOperation("list_podsandbox", st, err)
+	return out, err
+}
+
+func (in instrumentedRuntimeService) CreateContainer(podSandboxID string, config *criv1.ContainerConfig, sandboxConfig *criv1.PodSandboxConfig) (string, error) {
+	st := time.Now()
+	out, err := in.RuntimeService.CreateContainer(podSandboxID, config, sandboxConfig)
+	metrics.ObserveCRIOperation("create_container", st, err)
+	return out, err
+}
+
+func (in instrumentedRuntimeService) StartContainer(containerID string) error {
+	st := time.Now()
+	err := in.RuntimeService.StartContainer(containerID)
+	metrics.ObserveCRIOperation("start_container", st, err)
+	return err
+}
+
+func (in instrumentedRuntimeService) StopContainer(containerID string, timeout int64) error {
+	st := time.Now()
+	err := in.RuntimeService.StopContainer(containerID, timeout)
+	metrics.ObserveCRIOperation("stop_container", st, err)
+	return err
+}
+
+func (in instrumentedRuntimeService) RemoveContainer(containerID string) error {
+	st := time.Now()
+	err := in.RuntimeService.RemoveContainer(containerID)
+	metrics.ObserveCRIOperation("remove_container", st, err)
+	return err
+}
+
+func (in instrumentedRuntimeService) ListContainers(filter *criv1.ContainerFilter) ([]*criv1.Container, error) {
+	st := time.Now()
+	out, err := in.RuntimeService.ListContainers(filter)
+	metrics.ObserveCRIOperation("list_containers", st, err)
+	return out, err
+}
+
+func (in instrumentedRuntimeService) ContainerStatus(containerID string, verbose bool) (*criv1.ContainerStatusResponse, error) {
+	st := time.Now()
+	out, err := in.RuntimeService.ContainerStatus(containerID, verbose)
+	metrics.ObserveCRIOperation("container_status", st, err)
+	return out, err
+}
+
+func (in instrumentedRuntimeService) UpdateContainerResources(containerID string, resources *criv1.LinuxContainerResources) error {
+	st := time.Now()
+	err := in.RuntimeService.UpdateContainerResources(containerID, resources)
+	metrics.ObserveCRIOperation("update_container", st, err)
+	return err
+}
+
+func (in instrumentedRuntimeService) ExecSync(containerID string, cmd []string, timeout time.Duration) ([]byte, []byte, error) {
+	st := time.Now()
+	stdout, stderr, err := in.RuntimeService.ExecSync(containerID, cmd, timeout)
+	metrics.ObserveCRIOperation("exec_sync", st, err)
+	return stdout, stderr, err
+}
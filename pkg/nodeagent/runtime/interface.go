@@ -47,6 +47,8 @@ type RuntimeService interface {
 
 	StopContainer(containerID string, gracePeriod time.Duration) error
 
+	UpdateContainerResources(containerID string, resources *criv1.LinuxContainerResources) error
+
 	TerminatePod(podSandboxID string, containerIDs []string) error
 
 	TerminateContainer(containerID string) error
@@ -285,6 +285,12 @@ func (r *remoteRuntimeManager) StopContainer(containerID string, timeout time.Du
 
 }
 
+// UpdateContainerResources implements cri.RuntimeService
+func (r *remoteRuntimeManager) UpdateContainerResources(containerID string, resources *criv1.LinuxContainerResources) error {
+	klog.InfoS("Update container resources", "ContainerID", containerID, "CpusetCpus", resources.CpusetCpus)
+	return r.criService.UpdateContainerResources(containerID, resources)
+}
+
 // StartContainer implements cri.RuntimeService
 func (r *remoteRuntimeManager) StartContainer(containerID string) error {
 	r.podConcurrency.Acquire(context.Background(), 1)
@@ -457,7 +463,7 @@ func NewRemoteRuntimeService(endpoint string, connectionTimeout time.Duration, c
 		return nil, err
 	}
 	service := &remoteRuntimeManager{
-		criService:        remoteService,
+		criService:        newInstrumentedRuntimeService(remoteService),
 		containerdService: containerdClient,
 		podConcurrency:    semaphore.NewWeighted(int64(concurrency)),
 	}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
 	"k8s.io/klog/v2"
 	netutils "k8s.io/utils/net"
@@ -94,8 +95,11 @@ func (a *SandboxManager) CreatePodSandbox(pod *v1.Pod) (*runtime.Pod, error) {
 		return nil, err
 	}
 
-	runtimeHandler := a.nodeConfig.RuntimeHandler
-	klog.InfoS("Call runtime to create sandbox", "pod", util.Name(pod), "sandboxConfig", podSandboxConfig)
+	runtimeHandler := config.GetPodRuntimeHandler(*a.nodeConfig, pod)
+	if !sets.NewString(config.GetNodeRuntimeHandlers(*a.nodeConfig)...).Has(runtimeHandler) {
+		return nil, fmt.Errorf("runtime handler %s of pod %s is not supported by node", runtimeHandler, util.Name(pod))
+	}
+	klog.InfoS("Call runtime to create sandbox", "pod", util.Name(pod), "runtimeHandler", runtimeHandler, "sandboxConfig", podSandboxConfig)
 	runtimepod, err := a.runtimeService.CreateSandbox(podSandboxConfig, runtimeHandler)
 	if err != nil {
 		message := fmt.Sprintf("Failed to create sandbox for pod %q: %v", util.Name(pod), err)
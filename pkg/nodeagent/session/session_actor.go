@@ -17,13 +17,16 @@ limitations under the License.
 package session
 
 import (
+	"context"
 	"time"
 
 	"centaurusinfra.io/fornax-serverless/pkg/message"
 	internal "centaurusinfra.io/fornax-serverless/pkg/nodeagent/message"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type SessionActor struct {
@@ -49,9 +52,12 @@ func NewSessionActor(pod *types.FornaxPod, session *types.FornaxSession, session
 }
 
 // try to open a session with session service, if it failed, send a session closed message
-func (a *SessionActor) OpenSession() error {
-	err := util.BackoffExec(1*time.Millisecond, 10*time.Millisecond, 2*time.Second, 2, func() error {
-		return a.sessionService.OpenSession(a.pod, a.session, a.notifySessionState)
+func (a *SessionActor) OpenSession(ctx context.Context) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "SessionActor.OpenSession", attribute.String("session", a.session.Identifier))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	err = util.BackoffExec(1*time.Millisecond, 10*time.Millisecond, 2*time.Second, 2, func() error {
+		return a.sessionService.OpenSession(ctx, a.pod, a.session, a.notifySessionState)
 	})
 
 	if err != nil {
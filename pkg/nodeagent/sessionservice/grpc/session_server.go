@@ -24,10 +24,13 @@ import (
 	"time"
 
 	internal "centaurusinfra.io/fornax-serverless/pkg/nodeagent/message"
+	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/metrics"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"k8s.io/klog/v2"
@@ -148,11 +151,13 @@ func (g *GrpcSessionService) checkAndCleanSessionHeartbeat() {
 				ClientSessions: []types.ClientSession{},
 			})
 			deadSession = append(deadSession, s.session.Identifier)
+			metrics.DeadSessions.Inc()
 			continue
 		}
 		if time.Now().After(heartbeatLastSeenCutoff) {
 			// did not receive heartbeat of this session in past heartbeat duration, ping it
 			s.consectuivePingFailures += 1
+			metrics.SessionMissedHeartbeats.Inc()
 			g.PingSession(s.pod, s.session, s.stateCallback)
 		}
 	}
@@ -263,8 +268,11 @@ func (g *GrpcSessionService) CloseSession(pod *types.FornaxPod, session *types.F
 	return nil
 }
 
-// OpenSession dispatch a SessionOpen event to pod
-func (g *GrpcSessionService) OpenSession(pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error {
+// OpenSession dispatch a SessionOpen event to pod, session wrapper in pod continue trace using trace context in message
+func (g *GrpcSessionService) OpenSession(ctx context.Context, pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "SessionService.OpenSession", attribute.String("session", session.Identifier))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	podId := pod.Identifier
 	sessionId := session.Identifier
 	sessionData := session.Session.Spec.SessionData
@@ -277,15 +285,16 @@ func (g *GrpcSessionService) OpenSession(pod *types.FornaxPod, session *types.Fo
 		},
 	}
 	m := &SessionMessage{
-		MessageType: messageType,
-		MessageBody: &body,
+		MessageType:  messageType,
+		MessageBody:  &body,
+		TraceContext: tracing.InjectTraceContext(ctx),
 		SessionIdentifier: &SessionIdentifier{
 			PodId:      podId,
 			Identifier: sessionId,
 		},
 	}
 
-	err := g.sendGrpcMessageToPod(podId, m)
+	err = g.sendGrpcMessageToPod(podId, m)
 	if err != nil {
 		klog.ErrorS(err, "Failed to dispatch open session message to pod", "pod", podId, "session", sessionId)
 		return err
@@ -338,6 +347,7 @@ func (g *GrpcSessionService) forwardSessionStateToPod(sessionId string, sessionS
 	if stateHeartbeat := g.getSessionHeartbeat(sessionId); stateHeartbeat != nil {
 		stateHeartbeat.lastSeen = time.Now()
 		stateHeartbeat.consectuivePingFailures = 0
+		metrics.SessionHeartbeats.Inc()
 		stateHeartbeat.stateCallback(sessionState)
 	} else {
 		// open session should be found, but when session server restart, it lost state callback,
@@ -350,6 +360,7 @@ func (g *GrpcSessionService) removeClosedSession(sessionId string) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 	delete(g.sessionHeartbeats, sessionId)
+	metrics.SessionTracked.Set(float64(len(g.sessionHeartbeats)))
 }
 
 func (g *GrpcSessionService) createHeartBeat(pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) *SessionStateHeartbeat {
@@ -363,6 +374,7 @@ func (g *GrpcSessionService) createHeartBeat(pod *types.FornaxPod, session *type
 		lastSeen:                time.Now(),
 	}
 	g.sessionHeartbeats[session.Identifier] = heartbeat
+	metrics.SessionTracked.Set(float64(len(g.sessionHeartbeats)))
 	return heartbeat
 }
 func (g *GrpcSessionService) enlistPod(pod string, ch chan<- *SessionMessage) error {
@@ -17,6 +17,7 @@ limitations under the License.
 package sessionservice
 
 import (
+	"context"
 	"errors"
 
 	internal "centaurusinfra.io/fornax-serverless/pkg/nodeagent/message"
@@ -31,7 +32,7 @@ var (
 )
 
 type SessionService interface {
-	OpenSession(pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error
+	OpenSession(ctx context.Context, pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error
 	CloseSession(pod *types.FornaxPod, session *types.FornaxSession, graceSeconds uint32) error
 	PingSession(pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error
 }
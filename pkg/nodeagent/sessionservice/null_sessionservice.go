@@ -17,6 +17,8 @@ limitations under the License.
 package sessionservice
 
 import (
+	"context"
+
 	internal "centaurusinfra.io/fornax-serverless/pkg/nodeagent/message"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
 )
@@ -43,7 +45,7 @@ func (f *NullSessionService) CloseSession(pod *types.FornaxPod, session *types.F
 }
 
 // OpenSession implements SessionService
-func (f *NullSessionService) OpenSession(pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error {
+func (f *NullSessionService) OpenSession(ctx context.Context, pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error {
 	f.stateCallbackFuncs[session.Identifier] = stateCallbackFunc
 	stateCallbackFunc(internal.SessionState{
 		SessionId:      session.Identifier,
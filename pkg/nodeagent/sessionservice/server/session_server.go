@@ -17,6 +17,8 @@ limitations under the License.
 package server
 
 import (
+	"context"
+
 	internal "centaurusinfra.io/fornax-serverless/pkg/nodeagent/message"
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice"
 	session_grpc "centaurusinfra.io/fornax-serverless/pkg/nodeagent/sessionservice/grpc"
@@ -36,7 +38,7 @@ func (*sessionServer) CloseSession(pod *types.FornaxPod, session *types.FornaxSe
 }
 
 // OpenSession implements sessionservice.SessionService
-func (*sessionServer) OpenSession(pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error {
+func (*sessionServer) OpenSession(ctx context.Context, pod *types.FornaxPod, session *types.FornaxSession, stateCallbackFunc func(internal.SessionState)) error {
 	panic("unimplemented")
 }
 
@@ -23,6 +23,7 @@ import (
 	"centaurusinfra.io/fornax-serverless/pkg/nodeagent/types"
 	"centaurusinfra.io/fornax-serverless/pkg/store/storage"
 	"centaurusinfra.io/fornax-serverless/pkg/store/storage/sqlite"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 )
 
@@ -38,6 +39,14 @@ type SessionStore struct {
 	storage.Store
 }
 
+type CPUAssignmentStore struct {
+	storage.Store
+}
+
+type PodSecretStore struct {
+	storage.Store
+}
+
 func NewNodeSqliteStore(options *sqlite.SQLiteStoreOptions) (*NodeStore, error) {
 	if store, err := sqlite.NewSqliteStore("Node", options,
 		func(text []byte) (interface{}, error) { return JsonToNode(text) },
@@ -138,6 +147,86 @@ func (s *SessionStore) PutSession(session *types.FornaxSession, revision int64)
 	return nil
 }
 
+func NewCPUAssignmentSqliteStore(options *sqlite.SQLiteStoreOptions) (*CPUAssignmentStore, error) {
+	if store, err := sqlite.NewSqliteStore("CPUAssignment", options,
+		func(text []byte) (interface{}, error) { return JsonToCPUAssignment(text) },
+		func(obj interface{}) ([]byte, error) { return JsonFromCPUAssignment(obj.(*types.CPUAssignment)) }); err != nil {
+		return nil, err
+	} else {
+		return &CPUAssignmentStore{store}, nil
+	}
+}
+
+func (s *CPUAssignmentStore) ListCPUAssignments() ([]*types.CPUAssignment, error) {
+	objs, err := s.ListObject()
+	if err != nil {
+		return nil, err
+	}
+	assignments := []*types.CPUAssignment{}
+	for _, obj := range objs {
+		if v, ok := obj.(*types.CPUAssignment); !ok {
+			return nil, fmt.Errorf("%v not a CPUAssignment object", obj)
+		} else {
+			assignments = append(assignments, v)
+		}
+	}
+	return assignments, nil
+}
+
+func (s *CPUAssignmentStore) PutCPUAssignment(assignment *types.CPUAssignment) error {
+	if assignment == nil {
+		return fmt.Errorf("nil cpu assignment is passed")
+	}
+	err := s.PutObject(assignment.PodIdentifier, assignment, 0)
+	if err != nil {
+		klog.ErrorS(err, "Failed to save CPUAssignment", "pod", assignment.PodIdentifier)
+		return err
+	}
+	return nil
+}
+
+// NewPodSecretSqliteStore create a store which encrypt pod secrets using aes-256 key before writing them into sqlite
+func NewPodSecretSqliteStore(options *sqlite.SQLiteStoreOptions, key []byte) (*PodSecretStore, error) {
+	toFunc, err := storage.EncryptedTextToObjectFunc(key, func(text []byte) (interface{}, error) { return JsonToPodSecrets(text) })
+	if err != nil {
+		return nil, err
+	}
+	fromFunc, err := storage.EncryptedTextFromObjectFunc(key, func(obj interface{}) ([]byte, error) { return JsonFromPodSecrets(obj.(*types.PodSecrets)) })
+	if err != nil {
+		return nil, err
+	}
+	if store, err := sqlite.NewSqliteStore("PodSecret", options, toFunc, fromFunc); err != nil {
+		return nil, err
+	} else {
+		return &PodSecretStore{store}, nil
+	}
+}
+
+// GetPodSecrets return secrets of a pod, nil is returned if pod does not have saved secrets
+func (s *PodSecretStore) GetPodSecrets(podIdentifier string) ([]*v1.Secret, error) {
+	obj, err := s.GetObject(podIdentifier)
+	if err == storage.ObjectNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := obj.(*types.PodSecrets); !ok {
+		return nil, fmt.Errorf("%v not a PodSecrets object", obj)
+	} else {
+		return v.Secrets, nil
+	}
+}
+
+func (s *PodSecretStore) PutPodSecrets(podIdentifier string, secrets []*v1.Secret) error {
+	err := s.PutObject(podIdentifier, &types.PodSecrets{PodIdentifier: podIdentifier, Secrets: secrets}, 0)
+	if err != nil {
+		klog.ErrorS(err, "Failed to save PodSecrets", "pod", podIdentifier)
+		return err
+	}
+	return nil
+}
+
 // use json to store node agent store object for now, consider using protobuf if meet performance issue
 func JsonToPod(data []byte) (*types.FornaxPod, error) {
 	res := types.FornaxPod{}
@@ -190,3 +279,37 @@ func JsonFromSession(obj *types.FornaxSession) ([]byte, error) {
 	}
 	return bytes, nil
 }
+
+func JsonToCPUAssignment(text []byte) (*types.CPUAssignment, error) {
+	res := types.CPUAssignment{}
+	if err := json.Unmarshal([]byte(text), &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func JsonFromCPUAssignment(obj *types.CPUAssignment) ([]byte, error) {
+	var bytes []byte
+	var err error
+	if bytes, err = json.Marshal(obj); err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+func JsonToPodSecrets(text []byte) (*types.PodSecrets, error) {
+	res := types.PodSecrets{}
+	if err := json.Unmarshal([]byte(text), &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func JsonFromPodSecrets(obj *types.PodSecrets) ([]byte, error) {
+	var bytes []byte
+	var err error
+	if bytes, err = json.Marshal(obj); err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
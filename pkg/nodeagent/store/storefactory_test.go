@@ -403,3 +403,36 @@ func TestSessionStore_PutSession_NotUpdated(t *testing.T) {
 		t.Error("session is updated although revision is not changed")
 	}
 }
+
+func TestPodSecretStore_GetPodSecrets(t *testing.T) {
+	key := make([]byte, 32)
+	store, err := NewPodSecretSqliteStore(&sqlite.SQLiteStoreOptions{
+		ConnUrl: "./secret_test.db",
+	}, key)
+	if err != nil {
+		t.Fatalf("Failed to create pod secret store, err %v", err)
+	}
+	defer os.Remove("./secret_test.db")
+
+	secrets := []*v1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "secret1", Namespace: "test"},
+		Data:       map[string][]byte{"password": []byte("secret value")},
+	}}
+	if err := store.PutPodSecrets("testPod1", secrets); err != nil {
+		t.Fatalf("Failed to save pod secrets, err %v", err)
+	}
+	got, err := store.GetPodSecrets("testPod1")
+	if err != nil {
+		t.Fatalf("Failed to get pod secrets, err %v", err)
+	}
+	if !reflect.DeepEqual(got, secrets) {
+		t.Errorf("GetPodSecrets() = %v, want %v", got, secrets)
+	}
+
+	if err := store.DelObject("testPod1"); err != nil {
+		t.Fatalf("Failed to delete pod secrets, err %v", err)
+	}
+	if got, err := store.GetPodSecrets("testPod1"); err != nil || got != nil {
+		t.Errorf("GetPodSecrets() of deleted pod = %v, err %v, want nil", got, err)
+	}
+}
@@ -60,6 +60,7 @@ const (
 	ContainerStateRunning     ContainerState = "Running"
 	ContainerStateHibernated  ContainerState = "Hibernated"
 	ContainerStateStarted     ContainerState = "Started"
+	ContainerStateRestarting  ContainerState = "Restarting"
 )
 
 type FornaxContainer struct {
@@ -68,6 +69,12 @@ type FornaxContainer struct {
 	ContainerSpec    *v1.Container            `json:"containerSpec,omitempty"`
 	RuntimeContainer *runtime.Container       `json:"runtimeContainer,omitempty"`
 	ContainerStatus  *runtime.ContainerStatus `json:"containerStatus,omitempty"`
+	// how many times container is restarted in place by pod restart policy
+	RestartCount int32 `json:"restartCount,omitempty"`
+	// backoff of latest restart, it's doubled on each restart until container run long enough
+	RestartBackoff time.Duration `json:"restartBackoff,omitempty"`
+	// runtime status of container before latest restart
+	LastTerminationState *v1.ContainerStateTerminated `json:"lastTerminationState,omitempty"`
 }
 
 type FornaxNodeWithRevision struct {
@@ -82,12 +89,57 @@ type FornaxPod struct {
 	Daemon                  bool                        `json:"daemon,omitempty"`
 	Pod                     *v1.Pod                     `json:"pod,omitempty"`
 	ConfigMap               *v1.ConfigMap               `json:"configMap,omitempty"`
+	Secrets                 []*v1.Secret                `json:"-"` // saved encrypted in pod secret store, never saved in pod store
 	RuntimePod              *runtime.Pod                `json:"runtimePod,omitempty"`
 	Containers              map[string]*FornaxContainer `json:"containers"`
 	Sessions                map[string]*FornaxSession   `json:"sessions"`
+	VolumeUsages            []PodVolumeUsage            `json:"volumeUsages,omitempty"`
+	ResourceUsage           *PodResourceUsage           `json:"resourceUsage,omitempty"`
 	LastStateTransitionTime time.Time                   `json:"lastStateTransitionTime,omitempty"`
 }
 
+// PodSecrets are secrets delivered with a pod, they are keyed by pod identifier and saved encrypted in node agent store,
+// so containers using secrets can be created or restarted after node agent restart
+type PodSecrets struct {
+	PodIdentifier string       `json:"podIdentifier,omitempty"`
+	Secrets       []*v1.Secret `json:"secrets,omitempty"`
+}
+
+// CPUAssignment is cpus pinned to containers of a pod exclusively, cpus are saved as cpuset string like 2-3,6,
+// it's keyed by pod identifier in node agent store, so assignments survive node agent restart
+type CPUAssignment struct {
+	PodIdentifier string            `json:"podIdentifier,omitempty"`
+	CPUs          string            `json:"cpus,omitempty"`
+	Containers    map[string]string `json:"containers,omitempty"`
+}
+
+// PodVolumeUsage is bytes used by a pod volume, limit is zero if volume has no size limit
+type PodVolumeUsage struct {
+	Name       string `json:"name,omitempty"`
+	HostPath   string `json:"hostPath,omitempty"`
+	Medium     string `json:"medium,omitempty"`
+	UsedBytes  int64  `json:"usedBytes,omitempty"`
+	LimitBytes int64  `json:"limitBytes,omitempty"`
+}
+
+// ContainerResourceUsage is latest resource usage of a container collected from cadvisor,
+// cpu usage seconds is cumulative, cpu nano cores is usage rate between latest two stats
+type ContainerResourceUsage struct {
+	CPUUsageCoreNanoSeconds uint64 `json:"cpuUsageCoreNanoSeconds,omitempty"`
+	CPUUsageNanoCores       uint64 `json:"cpuUsageNanoCores,omitempty"`
+	MemoryWorkingSetBytes   uint64 `json:"memoryWorkingSetBytes,omitempty"`
+	FsUsedBytes             uint64 `json:"fsUsedBytes,omitempty"`
+}
+
+// PodResourceUsage is sum of resource usage of pod containers, network usage is collected from pod sandbox
+type PodResourceUsage struct {
+	ContainerResourceUsage `json:",inline"`
+	NetworkRxBytes         uint64                            `json:"networkRxBytes,omitempty"`
+	NetworkTxBytes         uint64                            `json:"networkTxBytes,omitempty"`
+	Containers             map[string]ContainerResourceUsage `json:"containers,omitempty"`
+	Timestamp              time.Time                         `json:"timestamp,omitempty"`
+}
+
 // +enum
 type SessionState string
 
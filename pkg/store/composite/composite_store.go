@@ -27,8 +27,8 @@ import (
 	"k8s.io/klog/v2"
 
 	"centaurusinfra.io/fornax-serverless/pkg/store"
-	"centaurusinfra.io/fornax-serverless/pkg/store/inmemory"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -54,11 +54,19 @@ import (
 
 // then when api client call watch api to watch resources, we need to watch memory store not persist store, if client changed spec, spec are replicated into memory store also, so, client can still get spec change, not only status change.
 
+// StatusStore save Resource Status, it's a inmemory.MemoryStore, or a persistent.PersistentStore when status should survive restart
+type StatusStore interface {
+	storage.Interface
+	CreateOrUpdate(ctx context.Context, key string, obj runtime.Object, out runtime.Object, mergeFunc func(from runtime.Object, to runtime.Object) error) error
+	GetOrCreate(ctx context.Context, key string, objToCreate runtime.Object, out runtime.Object) error
+	CreateOrReplace(ctx context.Context, key string, objToCreate runtime.Object, out runtime.Object) error
+}
+
 type CompositeStore struct {
 	mu                sync.Mutex
 	groupResourceKey  string
 	specPersistStore  storage.Interface
-	statusMemoryStore *inmemory.MemoryStore
+	statusMemoryStore StatusStore
 	mergeFunc         func(from runtime.Object, to runtime.Object) error
 	keyFunc           func(obj runtime.Object) (string, error)
 	newFunc           func() runtime.Object
@@ -255,7 +263,7 @@ func (c *CompositeStore) Watch(ctx context.Context, key string, opts storage.Lis
 func NewCompositeStore(
 	groupResource schema.GroupResource,
 	persistStore storage.Interface,
-	memoryStore *inmemory.MemoryStore,
+	memoryStore StatusStore,
 	mergeFunc func(from runtime.Object, to runtime.Object) error,
 	newFunc func() runtime.Object,
 	newListFunc func() runtime.Object,
@@ -388,6 +396,10 @@ func (c *CompositeStore) Run(ctx context.Context) {
 				}
 			}()
 			if err != nil {
+				if apierrors.IsResourceExpired(err) {
+					// memory store history is compacted, watch from now on
+					rev = 0
+				}
 				time.Sleep(1 * time.Second)
 				continue
 			}
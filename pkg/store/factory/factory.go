@@ -20,7 +20,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,25 +39,96 @@ import (
 	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
 	"centaurusinfra.io/fornax-serverless/pkg/store/composite"
 	"centaurusinfra.io/fornax-serverless/pkg/store/inmemory"
+	"centaurusinfra.io/fornax-serverless/pkg/store/persistent"
 	"centaurusinfra.io/fornax-serverless/pkg/store/storage"
+	"centaurusinfra.io/fornax-serverless/pkg/store/storage/sqlite"
 	"centaurusinfra.io/fornax-serverless/pkg/util"
 )
 
 var (
-	_FornaxInMemoryStoresMutex  = &sync.RWMutex{}
-	_InMemoryResourceStores     = map[string]*inmemory.MemoryStore{}
-	_FornaxCompositeStoresMutex = &sync.RWMutex{}
-	_CompositedResourceStores   = map[string]*composite.CompositeStore{}
+	_FornaxInMemoryStoresMutex   = &sync.RWMutex{}
+	_InMemoryResourceStores      = map[string]*inmemory.MemoryStore{}
+	_FornaxPersistentStoresMutex = &sync.RWMutex{}
+	_PersistentResourceStores    = map[string]*persistent.PersistentStore{}
+	_FornaxCompositeStoresMutex  = &sync.RWMutex{}
+	_CompositedResourceStores    = map[string]*composite.CompositeStore{}
 )
 
+const (
+	DefaultFornaxStorageSqliteFile        = "/var/lib/fornaxcore/fornaxcore.db"
+	DefaultFornaxStorageEncryptionKeyFile = "/var/lib/fornaxcore/secret.key"
+)
+
+// FornaxStorage is a resource store shared by api server and fornaxcore managers,
+// it's a inmemory.MemoryStore, or a persistent.PersistentStore if resource is persisted
+type FornaxStorage interface {
+	fornaxstore.ApiStorageInterface
+	composite.StatusStore
+	CompleteWithFunctions(
+		keyFunc func(obj runtime.Object) (string, error),
+		newFunc func() runtime.Object,
+		newListFunc func() runtime.Object,
+		getAttrsFunc apistorage.AttrFunc,
+		triggerFuncs apistorage.IndexerFuncs,
+		indexers *cache.Indexers,
+	) error
+	Stop() error
+}
+
+// FornaxStoragePolicy define which resources are persisted into sqlite besides memory, persisted resources survive fornaxcore restart,
+// other resources only live in memory and are rebuilt from node agents after restart
+type FornaxStoragePolicy struct {
+	// sqlite database file, all resources only live in memory if it's empty
+	SqliteFile string
+	// persisted resources, e.g. applicationsessions.core.fornax-serverless.centaurusinfra.io
+	PersistedResources []string
+	// directory of memory store wal and snapshots, each resource which is not persisted in sqlite use a sub directory,
+	// memory stores do not recover after restart if it's empty
+	WalDir string
+	// how often memory store take a snapshot and truncate wal
+	SnapshotInterval time.Duration
+	// aes-256 key file used to encrypt secrets persisted in sqlite, a random key is generated if file does not exist
+	EncryptionKeyFile string
+}
+
+func (p *FornaxStoragePolicy) walOptions(groupResource schema.GroupResource, objectFunc func() runtime.Object) *inmemory.WalOptions {
+	if p == nil || len(p.WalDir) == 0 {
+		return nil
+	}
+	return &inmemory.WalOptions{
+		Dir:              filepath.Join(p.WalDir, groupResource.String()),
+		SnapshotInterval: p.SnapshotInterval,
+		NewFunc:          objectFunc,
+	}
+}
+
+// IsPersisted return true if resource is persisted in sqlite, secrets are always persisted encrypted when sqlite is enabled
+func (p *FornaxStoragePolicy) IsPersisted(groupResource schema.GroupResource) bool {
+	if p == nil || len(p.SqliteFile) == 0 {
+		return false
+	}
+	if groupResource == fornaxk8sv1.FornaxSecretGrv.GroupResource() {
+		return true
+	}
+	for _, v := range p.PersistedResources {
+		if v == groupResource.String() {
+			return true
+		}
+	}
+	return false
+}
+
 type FornaxRestOptionsFactory struct {
 	OptionsGetter generic.RESTOptionsGetter
+	StoragePolicy *FornaxStoragePolicy
 }
 
 func (f *FornaxRestOptionsFactory) GetRESTOptions(resource schema.GroupResource) (generic.RESTOptions, error) {
 	options, err := f.OptionsGetter.GetRESTOptions(resource)
 	if resource == fornaxv1.ApplicationGrv.GroupResource() {
 		options.Decorator = CompositedFornaxApplicationStorageFunc
+	} else if f.StoragePolicy.IsPersisted(resource) {
+		options.Decorator = FornaxPersistentResourceStorageFunc
 	} else {
 		options.Decorator = FornaxInMemoryResourceStorageFunc
 	}
@@ -90,32 +164,141 @@ func JsonFromApplication(obj interface{}) ([]byte, error) {
 	return bytes, nil
 }
 
-func NewFornaxNodeStorage(ctx context.Context) *inmemory.MemoryStore {
-	return newFornaxStorage(ctx, fornaxk8sv1.FornaxNodeGrv.GroupResource(), fornaxk8sv1.FornaxNodeGrvKey, nil, nil)
+// use json to store fornax resource in sqlite, persisted object is decoded into object returned by newFunc
+func jsonToObjectFunc(newFunc func() runtime.Object) storage.TextToObjectFunc {
+	return func(text []byte) (interface{}, error) {
+		res := newFunc()
+		if err := json.Unmarshal(text, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+}
+
+func jsonFromObject(obj interface{}) ([]byte, error) {
+	if _, ok := obj.(runtime.Object); !ok {
+		return nil, storage.InvalidObjectType
+	}
+
+	var bytes []byte
+	var err error
+	if bytes, err = json.Marshal(obj); err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+func NewFornaxNodeStorage(ctx context.Context, policy *FornaxStoragePolicy) (FornaxStorage, error) {
+	return newFornaxResourceStorage(ctx, policy, fornaxk8sv1.FornaxNodeGrv.GroupResource(), fornaxk8sv1.FornaxNodeGrvKey, func() runtime.Object { return &corev1.Node{} })
+}
+
+func NewFornaxPodStorage(ctx context.Context, policy *FornaxStoragePolicy) (FornaxStorage, error) {
+	return newFornaxResourceStorage(ctx, policy, fornaxk8sv1.FornaxPodGrv.GroupResource(), fornaxk8sv1.FornaxPodGrvKey, func() runtime.Object { return &corev1.Pod{} })
+}
+
+func NewFornaxApplicationStatusStorage(ctx context.Context, policy *FornaxStoragePolicy) (FornaxStorage, error) {
+	return newFornaxResourceStorage(ctx, policy, fornaxv1.ApplicationGrv.GroupResource(), fornaxv1.ApplicationGrvKey, func() runtime.Object { return &fornaxv1.Application{} })
+}
+
+func NewFornaxApplicationSessionStorage(ctx context.Context, policy *FornaxStoragePolicy) (FornaxStorage, error) {
+	return newFornaxResourceStorage(ctx, policy, fornaxv1.ApplicationSessionGrv.GroupResource(), fornaxv1.ApplicationSessionGrvKey, func() runtime.Object { return &fornaxv1.ApplicationSession{} })
+}
+
+func NewFornaxNodeDaemonStorage(ctx context.Context, policy *FornaxStoragePolicy) (FornaxStorage, error) {
+	return newFornaxResourceStorage(ctx, policy, fornaxv1.NodeDaemonGrv.GroupResource(), fornaxv1.NodeDaemonGrvKey, func() runtime.Object { return &fornaxv1.NodeDaemon{} })
+}
+
+func NewFornaxEventStorage(ctx context.Context, policy *FornaxStoragePolicy) (FornaxStorage, error) {
+	return newFornaxResourceStorage(ctx, policy, fornaxk8sv1.FornaxEventGrv.GroupResource(), fornaxk8sv1.FornaxEventGrvKey, func() runtime.Object { return &corev1.Event{} })
+}
+
+// NewFornaxSecretStorage create a persistent store which encrypt secrets before writing them into sqlite,
+// if sqlite is disabled, secrets only live in memory and are never written into wal, they need to be recreated after restart
+func NewFornaxSecretStorage(ctx context.Context, policy *FornaxStoragePolicy) (FornaxStorage, error) {
+	groupResource := fornaxk8sv1.FornaxSecretGrv.GroupResource()
+	if !policy.IsPersisted(groupResource) {
+		return newFornaxStorage(ctx, groupResource, fornaxk8sv1.FornaxSecretGrvKey, nil, nil, nil)
+	}
+
+	key, err := storage.LoadOrCreateEncryptionKey(policy.EncryptionKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	toFunc, err := storage.EncryptedTextToObjectFunc(key, jsonToObjectFunc(func() runtime.Object { return &corev1.Secret{} }))
+	if err != nil {
+		return nil, err
+	}
+	fromFunc, err := storage.EncryptedTextFromObjectFunc(key, jsonFromObject)
+	if err != nil {
+		return nil, err
+	}
+	return newFornaxPersistentStorage(ctx, policy, groupResource, fornaxk8sv1.FornaxSecretGrvKey, toFunc, fromFunc)
 }
 
-func NewFornaxPodStorage(ctx context.Context) *inmemory.MemoryStore {
-	return newFornaxStorage(ctx, fornaxk8sv1.FornaxPodGrv.GroupResource(), fornaxk8sv1.FornaxPodGrvKey, nil, nil)
+// newFornaxResourceStorage create a persistent store if policy persist this resource, otherwise a memory store,
+// objectFunc is used to decode persisted objects
+func newFornaxResourceStorage(ctx context.Context, policy *FornaxStoragePolicy, groupResource schema.GroupResource, grvKey string, objectFunc func() runtime.Object) (FornaxStorage, error) {
+	if policy.IsPersisted(groupResource) {
+		return newFornaxPersistentStorage(ctx, policy, groupResource, grvKey, jsonToObjectFunc(objectFunc), jsonFromObject)
+	}
+	return newFornaxStorage(ctx, groupResource, grvKey, nil, nil, policy.walOptions(groupResource, objectFunc))
 }
 
-func NewFornaxApplicationStatusStorage(ctx context.Context) *inmemory.MemoryStore {
-	return newFornaxStorage(ctx, fornaxv1.ApplicationGrv.GroupResource(), fornaxv1.ApplicationGrvKey, nil, nil)
+func newFornaxPersistentStorage(ctx context.Context, policy *FornaxStoragePolicy, groupResource schema.GroupResource, grvKey string, toFunc storage.TextToObjectFunc, fromFunc storage.TextFromObjectFunc) (*persistent.PersistentStore, error) {
+	_FornaxPersistentStoresMutex.Lock()
+	defer _FornaxPersistentStoresMutex.Unlock()
+	key := groupResource.String()
+	if si, found := _PersistentResourceStores[key]; found {
+		return si, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(policy.SqliteFile), os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+	backend, err := sqlite.NewSqliteStore(groupResource.Resource, &sqlite.SQLiteStoreOptions{ConnUrl: policy.SqliteFile}, toFunc, fromFunc)
+	if err != nil {
+		return nil, err
+	}
+	si, err := persistent.NewPersistentStore(ctx, groupResource, grvKey, nil, nil, backend)
+	if err != nil {
+		return nil, err
+	}
+	_PersistentResourceStores[key] = si
+	RegisteredBackEndStorage[key] = backend
+	return si, nil
 }
 
-func NewFornaxApplicationSessionStorage(ctx context.Context) *inmemory.MemoryStore {
-	return newFornaxStorage(ctx, fornaxv1.ApplicationSessionGrv.GroupResource(), fornaxv1.ApplicationSessionGrvKey, nil, nil)
+// getFornaxStorage find a store created for a resource, persistent store is looked up firstly
+func getFornaxStorage(groupResource schema.GroupResource) (FornaxStorage, error) {
+	key := groupResource.String()
+	_FornaxPersistentStoresMutex.RLock()
+	ps, found := _PersistentResourceStores[key]
+	_FornaxPersistentStoresMutex.RUnlock()
+	if found {
+		return ps, nil
+	}
+
+	_FornaxInMemoryStoresMutex.RLock()
+	defer _FornaxInMemoryStoresMutex.RUnlock()
+	if ms, found := _InMemoryResourceStores[key]; found {
+		return ms, nil
+	}
+	return nil, fmt.Errorf("Can not find a regisgered store for %s", key)
 }
 
-func newFornaxStorage(ctx context.Context, groupResource schema.GroupResource, grvKey string, newFunc func() runtime.Object, newListFunc func() runtime.Object) *inmemory.MemoryStore {
+func newFornaxStorage(ctx context.Context, groupResource schema.GroupResource, grvKey string, newFunc func() runtime.Object, newListFunc func() runtime.Object, walOptions *inmemory.WalOptions) (*inmemory.MemoryStore, error) {
 	_FornaxInMemoryStoresMutex.Lock()
 	defer _FornaxInMemoryStoresMutex.Unlock()
 	key := groupResource.String()
 	if si, found := _InMemoryResourceStores[key]; found {
-		return si
+		return si, nil
 	} else {
-		si = inmemory.NewMemoryStore(ctx, groupResource, grvKey, newFunc, newListFunc)
+		si, err := inmemory.NewMemoryStore(ctx, groupResource, grvKey, newFunc, newListFunc, walOptions)
+		if err != nil {
+			return nil, err
+		}
 		_InMemoryResourceStores[key] = si
-		return si
+		return si, nil
 	}
 }
 
@@ -141,7 +324,11 @@ func CompositedFornaxApplicationStorageFunc(
 	if err != nil {
 		return specStore, persistStoreDestroyFunc, err
 	}
-	statusStore := NewFornaxApplicationStatusStorage(context.Background())
+	statusStore, err := getFornaxStorage(storageConfig.GroupResource)
+	if err != nil {
+		persistStoreDestroyFunc()
+		return nil, nil, err
+	}
 	statusStore.CompleteWithFunctions(keyFunc, newFunc, newListFunc, getAttrsFunc, triggerFuncs, indexers)
 	destroyFunc := func() {
 		persistStoreDestroyFunc()
@@ -212,6 +399,34 @@ func FornaxInMemoryResourceStorageFunc(
 	return storage, destroyFunc, nil
 }
 
+// this function is provided to k8s api server to get storage.Interface of a resource persisted by FornaxStoragePolicy
+func FornaxPersistentResourceStorageFunc(
+	storageConfig *storagebackend.ConfigForResource,
+	resourcePrefix string,
+	keyFunc func(obj runtime.Object) (string, error),
+	newFunc func() runtime.Object,
+	newListFunc func() runtime.Object,
+	getAttrsFunc apistorage.AttrFunc,
+	triggerFuncs apistorage.IndexerFuncs,
+	indexers *cache.Indexers) (apistorage.Interface, factory.DestroyFunc, error) {
+	var storage *persistent.PersistentStore
+	_FornaxPersistentStoresMutex.Lock()
+	key := storageConfig.GroupResource.String()
+	defer _FornaxPersistentStoresMutex.Unlock()
+	if b, f := _PersistentResourceStores[key]; !f {
+		return nil, nil, fmt.Errorf("Can not find a regisgered persistent store for %s", key)
+	} else {
+		storage = b
+	}
+
+	storage.CompleteWithFunctions(keyFunc, newFunc, newListFunc, getAttrsFunc, triggerFuncs, indexers)
+	destroyFunc := func() {
+		storage.Stop()
+	}
+
+	return storage, destroyFunc, nil
+}
+
 func GetApplicationCache(store fornaxstore.ApiStorageInterface, applicationName string) (*fornaxv1.Application, error) {
 	out := &fornaxv1.Application{}
 	key := fmt.Sprintf("%s/%s", fornaxv1.ApplicationGrvKey, applicationName)
@@ -267,6 +482,29 @@ func UpdateApplicationSession(ctx context.Context, store fornaxstore.ApiStorageI
 	return out, nil
 }
 
+func GetNodeDaemonCache(store fornaxstore.ApiStorageInterface, daemonName string) (*fornaxv1.NodeDaemon, error) {
+	out := &fornaxv1.NodeDaemon{}
+	key := fmt.Sprintf("%s/%s", fornaxv1.NodeDaemonGrvKey, daemonName)
+	err := store.Get(context.Background(), key, apistorage.GetOptions{IgnoreNotFound: false}, out)
+	if err != nil {
+		if fornaxstore.IsObjectNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+func UpdateNodeDaemon(ctx context.Context, store fornaxstore.ApiStorageInterface, daemon *fornaxv1.NodeDaemon) (*fornaxv1.NodeDaemon, error) {
+	out := &fornaxv1.NodeDaemon{}
+	key := fmt.Sprintf("%s/%s", fornaxv1.NodeDaemonGrvKey, util.Name(daemon))
+	err := store.EnsureUpdateAndDelete(ctx, key, true, nil, daemon, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func GetFornaxPodCache(store fornaxstore.ApiStorageInterface, podName string) (*corev1.Pod, error) {
 	out := &corev1.Pod{}
 	key := fmt.Sprintf("%s/%s", fornaxk8sv1.FornaxPodGrvKey, podName)
@@ -345,3 +583,49 @@ func UpdateFornaxNode(ctx context.Context, store fornaxstore.ApiStorageInterface
 	}
 	return out, nil
 }
+
+func GetFornaxSecretCache(store fornaxstore.ApiStorageInterface, secretName string) (*corev1.Secret, error) {
+	out := &corev1.Secret{}
+	key := fmt.Sprintf("%s/%s", fornaxk8sv1.FornaxSecretGrvKey, secretName)
+	err := store.Get(context.Background(), key, apistorage.GetOptions{IgnoreNotFound: false}, out)
+	if err != nil {
+		if fornaxstore.IsObjectNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+func CreateFornaxEvent(ctx context.Context, store fornaxstore.ApiStorageInterface, event *corev1.Event) (*corev1.Event, error) {
+	out := &corev1.Event{}
+	key := fmt.Sprintf("%s/%s", fornaxk8sv1.FornaxEventGrvKey, util.Name(event))
+	err := store.Create(ctx, key, event, out, uint64(0))
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func UpdateFornaxEvent(ctx context.Context, store fornaxstore.ApiStorageInterface, event *corev1.Event) (*corev1.Event, error) {
+	out := &corev1.Event{}
+	key := fmt.Sprintf("%s/%s", fornaxk8sv1.FornaxEventGrvKey, util.Name(event))
+	err := store.EnsureUpdateAndDelete(ctx, key, true, nil, event, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func DeleteFornaxEvent(ctx context.Context, store fornaxstore.ApiStorageInterface, eventName string) (*corev1.Event, error) {
+	out := &corev1.Event{}
+	key := fmt.Sprintf("%s/%s", fornaxk8sv1.FornaxEventGrvKey, eventName)
+	err := store.Delete(ctx, key, out, nil, nil, nil)
+	if err != nil {
+		if fornaxstore.IsObjectNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,452 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inmemory
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"centaurusinfra.io/fornax-serverless/pkg/store"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+)
+
+const (
+	DefaultSnapshotInterval = 10 * time.Minute
+	walSegmentPrefix        = "wal-"
+	walSegmentSuffix        = ".log"
+	snapshotFileName        = "snapshot"
+	maxWalRecordSize        = 64 * 1024 * 1024
+)
+
+// WalOptions define where a memory store save its write ahead log and snapshots,
+// every object event is appended into wal, a snapshot of revision sorted object list is taken periodically and older wal segments are removed,
+// when store is created, latest snapshot and wal segments after it are replayed to restore objects, resource versions and watch cache
+type WalOptions struct {
+	// directory of snapshot and wal segment files, it should be used by only one store
+	Dir string
+	// how often to take a snapshot and truncate wal
+	SnapshotInterval time.Duration
+	// return a object to decode object saved in snapshot and wal
+	NewFunc func() runtime.Object
+}
+
+type walRecord struct {
+	Key     string          `json:"key"`
+	Rev     uint64          `json:"rev"`
+	Deleted bool            `json:"deleted,omitempty"`
+	Object  json.RawMessage `json:"object"`
+}
+
+// snapshotHeader is first line of snapshot file, wal segments from WalSegment are replayed after snapshot
+type snapshotHeader struct {
+	CompactedRev uint64 `json:"compactedRev"`
+	WalSegment   string `json:"walSegment"`
+}
+
+// memoryStoreWal append records into current segment file, records are synced to disk in group,
+// a writer wait until a sync covering its record is done, concurrent writers share one sync
+type memoryStoreWal struct {
+	// syncMu serialize syncs, it is acquired before mu
+	syncMu  sync.Mutex
+	synced  uint64
+	mu      sync.Mutex
+	options *WalOptions
+	segment string
+	file    *os.File
+	written uint64
+}
+
+func newMemoryStoreWal(options *WalOptions) (*memoryStoreWal, error) {
+	if options.NewFunc == nil {
+		return nil, fmt.Errorf("NewFunc is not provided to decode objects in wal %s", options.Dir)
+	}
+	if err := os.MkdirAll(options.Dir, os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+	return &memoryStoreWal{
+		syncMu:  sync.Mutex{},
+		mu:      sync.Mutex{},
+		options: options,
+	}, nil
+}
+
+func walSegmentName(rev uint64) string {
+	return fmt.Sprintf("%s%020d%s", walSegmentPrefix, rev, walSegmentSuffix)
+}
+
+// walSegmentRev return revision when a segment was opened, all records in segment have larger revision
+func walSegmentRev(segment string) (uint64, error) {
+	return strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(segment, walSegmentPrefix), walSegmentSuffix), 10, 64)
+}
+
+func (w *memoryStoreWal) snapshotInterval() time.Duration {
+	if w.options.SnapshotInterval <= 0 {
+		return DefaultSnapshotInterval
+	}
+	return w.options.SnapshotInterval
+}
+
+// rotate close current segment and open a new segment named by rev, records appended after are saved in new segment
+func (w *memoryStoreWal) rotate(rev uint64) (string, error) {
+	w.syncMu.Lock()
+	defer w.syncMu.Unlock()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	segment := walSegmentName(rev)
+	file, err := os.OpenFile(filepath.Join(w.options.Dir, segment), os.O_CREATE|os.O_APPEND|os.O_WRONLY, os.FileMode(0644))
+	if err != nil {
+		return "", err
+	}
+	if w.file != nil {
+		// records in old segment must be on disk before new segment is used
+		if err := w.file.Sync(); err != nil {
+			file.Close()
+			return "", err
+		}
+		w.synced = w.written
+		w.file.Close()
+	}
+	w.file = file
+	w.segment = segment
+	return segment, nil
+}
+
+func (w *memoryStoreWal) append(event *objEvent) error {
+	obj := event.obj
+	if event.isDeleted {
+		obj = event.oldObj
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(&walRecord{Key: event.key, Rev: event.rev, Deleted: event.isDeleted, Object: data})
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	if w.file == nil {
+		w.mu.Unlock()
+		return fmt.Errorf("wal segment is not opened in %s", w.options.Dir)
+	}
+	if _, err := w.file.Write(append(line, '\n')); err != nil {
+		w.mu.Unlock()
+		return err
+	}
+	w.written += 1
+	seq := w.written
+	w.mu.Unlock()
+
+	// wait until record is synced, so a change returned to client survives a crash
+	return w.sync(seq)
+}
+
+// sync make sure records written until seq are on disk, if another writer already synced them, it return immediately,
+// otherwise it sync all records written so far, writers arrived during a sync share next sync
+func (w *memoryStoreWal) sync(seq uint64) error {
+	w.syncMu.Lock()
+	defer w.syncMu.Unlock()
+	if w.synced >= seq {
+		return nil
+	}
+	w.mu.Lock()
+	file := w.file
+	written := w.written
+	w.mu.Unlock()
+	if file == nil {
+		return fmt.Errorf("wal segment is not opened in %s", w.options.Dir)
+	}
+	if err := file.Sync(); err != nil {
+		return err
+	}
+	w.synced = written
+	return nil
+}
+
+func (w *memoryStoreWal) close() {
+	w.syncMu.Lock()
+	defer w.syncMu.Unlock()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file != nil {
+		w.file.Close()
+		w.file = nil
+	}
+}
+
+// segments return wal segment names sorted by revision
+func (w *memoryStoreWal) segments() ([]string, error) {
+	entries, err := os.ReadDir(w.options.Dir)
+	if err != nil {
+		return nil, err
+	}
+	segments := []string{}
+	for _, v := range entries {
+		if !v.IsDir() && strings.HasPrefix(v.Name(), walSegmentPrefix) && strings.HasSuffix(v.Name(), walSegmentSuffix) {
+			segments = append(segments, v.Name())
+		}
+	}
+	sort.Strings(segments)
+	return segments, nil
+}
+
+// removeSegmentsBefore remove segments replaced by a snapshot
+func (w *memoryStoreWal) removeSegmentsBefore(segment string) error {
+	segments, err := w.segments()
+	if err != nil {
+		return err
+	}
+	for _, v := range segments {
+		if v >= segment {
+			break
+		}
+		if err := os.Remove(filepath.Join(w.options.Dir, v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeSnapshot write objects into a temp file and rename it as snapshot, so a crash never leave a partial snapshot
+func (w *memoryStoreWal) writeSnapshot(header *snapshotHeader, objs []*objWithIndex) error {
+	tempFile := filepath.Join(w.options.Dir, snapshotFileName+".tmp")
+	file, err := os.OpenFile(tempFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(0644))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	if err := encoder.Encode(header); err != nil {
+		return err
+	}
+	for _, v := range objs {
+		data, err := json.Marshal(v.obj)
+		if err != nil {
+			return err
+		}
+		rev, err := store.GetObjectResourceVersion(v.obj)
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(&walRecord{Key: v.key, Rev: rev, Deleted: v.deleted, Object: data}); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		return err
+	}
+	return os.Rename(tempFile, filepath.Join(w.options.Dir, snapshotFileName))
+}
+
+// readSnapshot return nil header if no snapshot was taken
+func (w *memoryStoreWal) readSnapshot() (*snapshotHeader, []*walRecord, error) {
+	file, err := os.Open(filepath.Join(w.options.Dir, snapshotFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxWalRecordSize)
+	if !scanner.Scan() {
+		return nil, nil, fmt.Errorf("snapshot in %s does not have header, err %v", w.options.Dir, scanner.Err())
+	}
+	header := &snapshotHeader{}
+	if err := json.Unmarshal(scanner.Bytes(), header); err != nil {
+		return nil, nil, err
+	}
+	records := []*walRecord{}
+	for scanner.Scan() {
+		record := &walRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, nil, err
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return header, records, nil
+}
+
+// readSegment read all records in a segment, a broken record at end of last segment is what a crash left when it was being written,
+// it's dropped, a broken record anywhere else means wal is corrupted
+func (w *memoryStoreWal) readSegment(segment string, lastSegment bool) ([]*walRecord, error) {
+	file, err := os.Open(filepath.Join(w.options.Dir, segment))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records := []*walRecord{}
+	var brokenRecordErr error
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxWalRecordSize)
+	for scanner.Scan() {
+		if brokenRecordErr != nil {
+			return nil, fmt.Errorf("wal segment %s is corrupted, err %v", segment, brokenRecordErr)
+		}
+		record := &walRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			brokenRecordErr = err
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if brokenRecordErr != nil {
+		if !lastSegment {
+			return nil, fmt.Errorf("wal segment %s is corrupted, err %v", segment, brokenRecordErr)
+		}
+		klog.InfoS("Drop broken record at end of wal", "segment", segment, "err", brokenRecordErr)
+	}
+	return records, nil
+}
+
+func (w *memoryStoreWal) decode(record *walRecord) (runtime.Object, error) {
+	obj := w.options.NewFunc()
+	if err := json.Unmarshal(record.Object, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// recoverFromWal restore latest snapshot and replay wal segments after it in revision order,
+// then open a new wal segment for following changes
+func (ms *MemoryStore) recoverFromWal() error {
+	header, snapshotRecords, err := ms.wal.readSnapshot()
+	if err != nil {
+		return err
+	}
+	startSegment := ""
+	if header != nil {
+		startSegment = header.WalSegment
+		ms.compactedRev = header.CompactedRev
+		// snapshot records are saved in list order, they are already sorted by revision
+		for _, v := range snapshotRecords {
+			if err := ms.restoreRecord(v); err != nil {
+				return err
+			}
+		}
+	}
+
+	segments, err := ms.wal.segments()
+	if err != nil {
+		return err
+	}
+	walRecords := []*walRecord{}
+	for i, v := range segments {
+		if v < startSegment {
+			continue
+		}
+		if header == nil && i == 0 {
+			// no snapshot was taken, history starts from first segment
+			if rev, err := walSegmentRev(v); err == nil {
+				ms.compactedRev = rev
+			}
+		}
+		records, err := ms.wal.readSegment(v, i == len(segments)-1)
+		if err != nil {
+			return err
+		}
+		walRecords = append(walRecords, records...)
+	}
+	// concurrent changes may append records out of order, changes of same key always have increasing revision
+	sort.SliceStable(walRecords, func(i, j int) bool {
+		return walRecords[i].Rev < walRecords[j].Rev
+	})
+	for _, v := range walRecords {
+		if err := ms.restoreRecord(v); err != nil {
+			return err
+		}
+	}
+
+	if _, err := ms.wal.rotate(atomic.LoadUint64(&_MemoryRev)); err != nil {
+		return err
+	}
+	klog.InfoS("Restored memory store from wal", "resource", ms.groupResource, "snapshotObjects", len(snapshotRecords), "walRecords", len(walRecords), "compactedRev", ms.compactedRev)
+	return nil
+}
+
+func (ms *MemoryStore) restoreRecord(record *walRecord) error {
+	obj, err := ms.wal.decode(record)
+	if err != nil {
+		return fmt.Errorf("Failed to decode object %s in wal, cause %v", record.Key, err)
+	}
+	return ms.restoreObject(record.Key, obj, record.Deleted)
+}
+
+// logEvent append a object event into wal before change is applied into memory,
+// if it failed, change is not applied and error is returned to client
+func (ms *MemoryStore) logEvent(event *objEvent) error {
+	if ms.wal == nil {
+		return nil
+	}
+	if err := ms.wal.append(event); err != nil {
+		klog.ErrorS(err, "Failed to append object event into wal", "key", event.key, "rev", event.rev)
+		return err
+	}
+	return nil
+}
+
+// snapshot save revision sorted object list including deleted objects which are not shrunk, and start a new wal segment,
+// wal segments before new segment are removed after snapshot is saved
+func (ms *MemoryStore) snapshot() error {
+	ms.revSortedObjListMu.Lock()
+	objs := make([]*objWithIndex, 0, atomic.LoadInt64(&ms.kvStoreObjCount))
+	lastObjIndex := atomic.LoadUint64(&ms.revSortedObjList.lastObjIndex)
+	for i := uint64(0); i <= lastObjIndex && i < uint64(ms.revSortedObjList.Len()); i++ {
+		if v := ms.revSortedObjList.objs[i]; v != nil {
+			objs = append(objs, v)
+		}
+	}
+	header := &snapshotHeader{
+		CompactedRev: atomic.LoadUint64(&ms.compactedRev),
+	}
+	segment, err := ms.wal.rotate(atomic.LoadUint64(&_MemoryRev))
+	ms.revSortedObjListMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	header.WalSegment = segment
+	if err := ms.wal.writeSnapshot(header, objs); err != nil {
+		return err
+	}
+	return ms.wal.removeSegmentsBefore(segment)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	"centaurusinfra.io/fornax-serverless/pkg/store"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	apistorage "k8s.io/apiserver/pkg/storage"
+)
+
+const testKeyPrefix = "/fornaxcore/test/applicationsessions"
+
+func newTestWalStore(t *testing.T, ctx context.Context, dir string) *MemoryStore {
+	newFunc := func() runtime.Object { return &fornaxv1.ApplicationSession{} }
+	newListFunc := func() runtime.Object { return &fornaxv1.ApplicationSessionList{} }
+	walOptions := &WalOptions{Dir: dir, SnapshotInterval: time.Hour, NewFunc: newFunc}
+	ms, err := NewMemoryStore(ctx, fornaxv1.ApplicationSessionGrv.GroupResource(), testKeyPrefix, newFunc, newListFunc, walOptions)
+	if err != nil {
+		t.Fatalf("Failed to create memory store with wal, err %v", err)
+	}
+	return ms
+}
+
+func testSessionKey(name string) string {
+	return fmt.Sprintf("%s/test/%s", testKeyPrefix, name)
+}
+
+func createTestSession(t *testing.T, ctx context.Context, ms *MemoryStore, name string) *fornaxv1.ApplicationSession {
+	session := &fornaxv1.ApplicationSession{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"}}
+	out := &fornaxv1.ApplicationSession{}
+	if err := ms.Create(ctx, testSessionKey(name), session, out, 0); err != nil {
+		t.Fatalf("Failed to create session %s, err %v", name, err)
+	}
+	return out
+}
+
+func updateTestSession(t *testing.T, ctx context.Context, ms *MemoryStore, session *fornaxv1.ApplicationSession, label string) *fornaxv1.ApplicationSession {
+	updating := session.DeepCopy()
+	updating.Labels = map[string]string{"label": label}
+	out := &fornaxv1.ApplicationSession{}
+	if err := ms.GuaranteedUpdate(ctx, testSessionKey(session.Name), out, false, nil, store.GetTryUpdateFunc(updating), nil); err != nil {
+		t.Fatalf("Failed to update session %s, err %v", session.Name, err)
+	}
+	return out
+}
+
+func assertSession(t *testing.T, ctx context.Context, ms *MemoryStore, expected *fornaxv1.ApplicationSession) {
+	out := &fornaxv1.ApplicationSession{}
+	if err := ms.Get(ctx, testSessionKey(expected.Name), apistorage.GetOptions{}, out); err != nil {
+		t.Fatalf("Failed to get restored session %s, err %v", expected.Name, err)
+	}
+	if out.ResourceVersion != expected.ResourceVersion || out.Labels["label"] != expected.Labels["label"] {
+		t.Errorf("Restored session %s has resource version %s labels %v, expected %s %v", expected.Name, out.ResourceVersion, out.Labels, expected.ResourceVersion, expected.Labels)
+	}
+}
+
+func assertSessionNotFound(t *testing.T, ctx context.Context, ms *MemoryStore, name string) {
+	if err := ms.Get(ctx, testSessionKey(name), apistorage.GetOptions{}, &fornaxv1.ApplicationSession{}); !apistorage.IsNotFound(err) {
+		t.Errorf("Deleted session %s is restored, err %v", name, err)
+	}
+}
+
+func TestRecoverFromWal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+
+	ms := newTestWalStore(t, ctx, dir)
+	session1 := createTestSession(t, ctx, ms, "session1")
+	session2 := createTestSession(t, ctx, ms, "session2")
+	session1 = updateTestSession(t, ctx, ms, session1, "updated")
+	if err := ms.Delete(ctx, testSessionKey("session2"), &fornaxv1.ApplicationSession{}, nil, nil, nil); err != nil {
+		t.Fatalf("Failed to delete session, err %v", err)
+	}
+	ms.Stop()
+
+	ms = newTestWalStore(t, ctx, dir)
+	defer ms.Stop()
+	assertSession(t, ctx, ms, session1)
+	assertSessionNotFound(t, ctx, ms, "session2")
+
+	// deleted object is kept in restored history, a watch from a revision in wal still get it
+	watcher, err := ms.Watch(ctx, testKeyPrefix, apistorage.ListOptions{ResourceVersion: session2.ResourceVersion, Recursive: true})
+	if err != nil {
+		t.Fatalf("Failed to watch from a revision in wal, err %v", err)
+	}
+	defer watcher.Stop()
+	events := map[string]watch.EventType{}
+	for len(events) < 2 {
+		select {
+		case event := <-watcher.ResultChan():
+			events[event.Object.(*fornaxv1.ApplicationSession).Name] = event.Type
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Watcher did not receive restored events, got %v", events)
+		}
+	}
+	if events["session1"] != watch.Added || events["session2"] != watch.Deleted {
+		t.Errorf("Watcher received unexpected restored events %v", events)
+	}
+
+	// revision before first wal segment is older than history in wal
+	if _, err := ms.Watch(ctx, testKeyPrefix, apistorage.ListOptions{ResourceVersion: "2", Recursive: true}); !apierrors.IsResourceExpired(err) {
+		t.Errorf("Watch from a revision before wal is not expired, err %v", err)
+	}
+}
+
+func TestRecoverFromSnapshotAndWal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+
+	ms := newTestWalStore(t, ctx, dir)
+	session1 := createTestSession(t, ctx, ms, "session1")
+	session2 := createTestSession(t, ctx, ms, "session2")
+	if err := ms.snapshot(); err != nil {
+		t.Fatalf("Failed to take snapshot, err %v", err)
+	}
+	segments, err := ms.wal.segments()
+	if err != nil {
+		t.Fatalf("Failed to list wal segments, err %v", err)
+	}
+	if len(segments) != 1 {
+		t.Errorf("Wal segments before snapshot are not removed, got %v", segments)
+	}
+	session1 = updateTestSession(t, ctx, ms, session1, "updated")
+	session3 := createTestSession(t, ctx, ms, "session3")
+	ms.Stop()
+
+	// a crash when a record is being written leaves a broken record at end of last segment
+	file, err := os.OpenFile(filepath.Join(dir, segments[len(segments)-1]), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("Failed to open wal segment, err %v", err)
+	}
+	file.WriteString(`{"key":"` + testSessionKey("session4"))
+	file.Close()
+
+	ms = newTestWalStore(t, ctx, dir)
+	defer ms.Stop()
+	assertSession(t, ctx, ms, session1)
+	assertSession(t, ctx, ms, session2)
+	assertSession(t, ctx, ms, session3)
+	assertSessionNotFound(t, ctx, ms, "session4")
+
+	created := createTestSession(t, ctx, ms, "session5")
+	rev, _ := store.GetObjectResourceVersion(created)
+	lastRev, _ := store.GetObjectResourceVersion(session3)
+	if rev <= lastRev {
+		t.Errorf("New session has resource version %d, expected a revision newer than restored %d", rev, lastRev)
+	}
+}
+
+func TestRecoverFromCorruptedWal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+
+	ms := newTestWalStore(t, ctx, dir)
+	createTestSession(t, ctx, ms, "session1")
+	ms.Stop()
+
+	segments, err := (&memoryStoreWal{options: &WalOptions{Dir: dir}}).segments()
+	if err != nil || len(segments) == 0 {
+		t.Fatalf("Failed to list wal segments, segments %v, err %v", segments, err)
+	}
+	// broken record followed by other records is not left by a crash, wal is corrupted
+	file, err := os.OpenFile(filepath.Join(dir, segments[0]), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("Failed to open wal segment, err %v", err)
+	}
+	file.WriteString("{broken\n{broken\n")
+	file.Close()
+
+	newFunc := func() runtime.Object { return &fornaxv1.ApplicationSession{} }
+	walOptions := &WalOptions{Dir: dir, SnapshotInterval: time.Hour, NewFunc: newFunc}
+	if _, err := NewMemoryStore(ctx, fornaxv1.ApplicationSessionGrv.GroupResource(), testKeyPrefix, newFunc, nil, walOptions); err == nil {
+		t.Errorf("Memory store is created from corrupted wal")
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inmemory
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+var (
+	storeOperationDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Namespace:      "fornaxcore",
+			Subsystem:      "memory_store",
+			Name:           "operation_duration_seconds",
+			Help:           "Latency of memory store operations in seconds by resource and operation",
+			Buckets:        metrics.ExponentialBuckets(0.00001, 2, 16),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"resource", "operation"},
+	)
+
+	registerMetrics sync.Once
+)
+
+func registerStoreMetrics() {
+	registerMetrics.Do(func() {
+		legacyregistry.MustRegister(storeOperationDuration)
+	})
+}
+
+func (ms *MemoryStore) observeOperationDuration(operation string, st time.Time) {
+	storeOperationDuration.WithLabelValues(ms.groupResource.String(), operation).Observe(time.Since(st).Seconds())
+}
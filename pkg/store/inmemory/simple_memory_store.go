@@ -40,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/klog/v2"
 )
 
 type MemoryStore struct {
@@ -53,6 +54,11 @@ type MemoryStore struct {
 	groupResource      schema.GroupResource
 	grvKeyPrefix       string
 	watchers           []*memoryStoreWatcher
+	wal                *memoryStoreWal
+	// commitFunc is called before a change is applied, change is dropped if it return error
+	commitFunc func(key string, obj runtime.Object, deleted bool) error
+	// watch from a revision older than compactedRev can not get complete events, deleted objects before it are shrunk or lost
+	compactedRev uint64
 
 	keyFunc      func(obj runtime.Object) (string, error)
 	newFunc      func() runtime.Object
@@ -75,8 +81,10 @@ const (
 	DefaultHouseKeepingInterval = 60 * time.Second
 )
 
-// NewMemoryStore return a singleton storage.Interface for a groupResource
-func NewMemoryStore(ctx context.Context, groupResource schema.GroupResource, grvKeyPrefix string, newFunc func() runtime.Object, newListFunc func() runtime.Object) *MemoryStore {
+// NewMemoryStore return a singleton storage.Interface for a groupResource,
+// if walOptions is provided, objects are restored from snapshot and wal before return, and following changes are appended into wal
+func NewMemoryStore(ctx context.Context, groupResource schema.GroupResource, grvKeyPrefix string, newFunc func() runtime.Object, newListFunc func() runtime.Object, walOptions *WalOptions) (*MemoryStore, error) {
+	registerStoreMetrics()
 	si := &MemoryStore{
 		versioner:   store.APIObjectVersioner{},
 		revmu:       sync.RWMutex{},
@@ -92,12 +100,39 @@ func NewMemoryStore(ctx context.Context, groupResource schema.GroupResource, grv
 		grvKeyPrefix:       grvKeyPrefix, // resource key prefix, every key should start with it
 		groupResource:      groupResource,
 		watchers:           []*memoryStoreWatcher{},
+		compactedRev:       atomic.LoadUint64(&_MemoryRev),
 	}
+
+	var snapshotTicker *time.Ticker
+	var snapshotC <-chan time.Time
+	if walOptions != nil && len(walOptions.Dir) > 0 {
+		wal, err := newMemoryStoreWal(walOptions)
+		if err != nil {
+			return nil, err
+		}
+		si.wal = wal
+		if err := si.recoverFromWal(); err != nil {
+			wal.close()
+			return nil, err
+		}
+		snapshotTicker = time.NewTicker(wal.snapshotInterval())
+		snapshotC = snapshotTicker.C
+	}
+
 	go func() {
 		pruneTicker := time.NewTicker(60 * time.Second)
+		defer func() {
+			pruneTicker.Stop()
+			if snapshotTicker != nil {
+				snapshotTicker.Stop()
+			}
+		}()
 		for {
 			select {
 			case <-si.stopChannel:
+				if si.wal != nil {
+					si.wal.close()
+				}
 				return
 			case <-ctx.Done():
 				return
@@ -107,15 +142,21 @@ func NewMemoryStore(ctx context.Context, groupResource schema.GroupResource, grv
 					if si.revSortedObjList.lastObjIndex > uint64(c+DefaultObjRevListShrinkSize) {
 						func() {
 							si.revSortedObjListMu.Lock()
-							si.revSortedObjList.shrink(uint64(c + DefaultObjRevListShrinkSize))
+							if rev := si.revSortedObjList.shrink(uint64(c + DefaultObjRevListShrinkSize)); rev > atomic.LoadUint64(&si.compactedRev) {
+								atomic.StoreUint64(&si.compactedRev, rev)
+							}
 							si.revSortedObjListMu.Unlock()
 						}()
 					}
 				}
+			case <-snapshotC:
+				if err := si.snapshot(); err != nil {
+					klog.ErrorS(err, "Failed to take memory store snapshot", "resource", si.groupResource)
+				}
 			}
 		}
 	}()
-	return si
+	return si, nil
 }
 
 // this is ugly, just want to let compatible with k8s api server store initialization
@@ -145,6 +186,19 @@ func (ms *MemoryStore) getKey(obj runtime.Object) (string, error) {
 	}
 }
 
+// SetCommitFunc set a function which is called with created, updated or deleted object before change is applied into memory,
+// if it return error, change is not applied and watchers do not receive event, it's used to write change into durable storage first
+func (ms *MemoryStore) SetCommitFunc(commitFunc func(key string, obj runtime.Object, deleted bool) error) {
+	ms.commitFunc = commitFunc
+}
+
+func (ms *MemoryStore) commit(key string, obj runtime.Object, deleted bool) error {
+	if ms.commitFunc == nil {
+		return nil
+	}
+	return ms.commitFunc(key, obj, deleted)
+}
+
 // Stop cleanup memory
 func (ms *MemoryStore) Stop() error {
 	ms.stopChannel <- "stop"
@@ -159,12 +213,7 @@ func (ms *MemoryStore) Count(key string) (int64, error) {
 
 // Create implements storage.Interface
 func (ms *MemoryStore) Create(ctx context.Context, key string, obj runtime.Object, out runtime.Object, ttl uint64) error {
-	// st := time.Now().UnixMicro()
-	// defer func() {
-	//  et := time.Now().UnixMicro()
-	//  klog.V(5).InfoS("Memory store create object", "key", key, "took-micro", et-st)
-	//  TODO, add metric
-	// }()
+	defer ms.observeOperationDuration("create", time.Now())
 	ms.revSortedObjListMu.RLock()
 	defer ms.revSortedObjListMu.RUnlock()
 	outVal, err := conversion.EnforcePtr(out)
@@ -193,13 +242,9 @@ func (ms *MemoryStore) Create(ctx context.Context, key string, obj runtime.Objec
 			index:   index,
 			deleted: false,
 		}
-		err = ms.kvStore.put(keys, objWi, 0)
-		if err != nil {
+		if err := ms.commit(key, newObj, false); err != nil {
 			return err
 		}
-		ms.revSortedObjList.objs[index] = objWi
-		outVal.Set(reflect.ValueOf(newObj).Elem())
-
 		event := &objEvent{
 			key:       key,
 			obj:       newObj.DeepCopyObject(),
@@ -208,6 +253,15 @@ func (ms *MemoryStore) Create(ctx context.Context, key string, obj runtime.Objec
 			isDeleted: false,
 			isCreated: true,
 		}
+		if err := ms.logEvent(event); err != nil {
+			return err
+		}
+		err = ms.kvStore.put(keys, objWi, 0)
+		if err != nil {
+			return err
+		}
+		ms.revSortedObjList.objs[index] = objWi
+		outVal.Set(reflect.ValueOf(newObj).Elem())
 		ms.sendEvent(event)
 		atomic.AddInt64(&ms.kvStoreObjCount, 1)
 	}
@@ -218,12 +272,7 @@ func (ms *MemoryStore) Create(ctx context.Context, key string, obj runtime.Objec
 // deleted object is removed from old poistion in list but append to end of list just like a updated object,
 // so, it ensure watcher can get this deleted obj event if deleted object just happen after watcher's list call and before watch call
 func (ms *MemoryStore) Delete(ctx context.Context, key string, out runtime.Object, preconditions *apistorage.Preconditions, validateDeletion apistorage.ValidateObjectFunc, cachedExistingObject runtime.Object) error {
-	// st := time.Now().UnixMicro()
-	// defer func() {
-	//  et := time.Now().UnixMicro()
-	//  klog.V(5).InfoS("Memory store delete object", "key", key, "took-micro", et-st)
-	//  TODO, add metric
-	// }()
+	defer ms.observeOperationDuration("delete", time.Now())
 	ms.revSortedObjListMu.RLock()
 	defer ms.revSortedObjListMu.RUnlock()
 	outVal, err := conversion.EnforcePtr(out)
@@ -273,14 +322,9 @@ func (ms *MemoryStore) Delete(ctx context.Context, key string, out runtime.Objec
 			index:   index,
 			deleted: true,
 		}
-		err = ms.kvStore.del(keys)
-		if err != nil {
+		if err := ms.commit(key, deletedObj, true); err != nil {
 			return err
 		}
-		ms.revSortedObjList.objs[existingObj.index] = nil
-		ms.revSortedObjList.objs[index] = deletedObjWi
-		outVal.Set(reflect.ValueOf(currObj).Elem())
-
 		event := &objEvent{
 			key:       key,
 			obj:       nil,
@@ -289,6 +333,16 @@ func (ms *MemoryStore) Delete(ctx context.Context, key string, out runtime.Objec
 			isDeleted: true,
 			isCreated: false,
 		}
+		if err := ms.logEvent(event); err != nil {
+			return err
+		}
+		err = ms.kvStore.del(keys)
+		if err != nil {
+			return err
+		}
+		ms.revSortedObjList.objs[existingObj.index] = nil
+		ms.revSortedObjList.objs[index] = deletedObjWi
+		outVal.Set(reflect.ValueOf(currObj).Elem())
 		ms.sendEvent(event)
 		atomic.AddInt64(&ms.kvStoreObjCount, -1)
 	}
@@ -297,12 +351,7 @@ func (ms *MemoryStore) Delete(ctx context.Context, key string, out runtime.Objec
 
 // Get implements storage.Interface
 func (ms *MemoryStore) Get(ctx context.Context, key string, opts apistorage.GetOptions, out runtime.Object) error {
-	// st := time.Now().UnixMicro()
-	// defer func() {
-	//  et := time.Now().UnixMicro()
-	//  klog.V(5).InfoS("Memory store get object", "key", key, "took-micro", et-st)
-	//  TODO, add metric
-	// }()
+	defer ms.observeOperationDuration("get", time.Now())
 	outVal, err := conversion.EnforcePtr(out)
 	if err != nil {
 		return fmt.Errorf("unable to convert output object to pointer: %v", err)
@@ -336,12 +385,7 @@ func (ms *MemoryStore) Get(ctx context.Context, key string, opts apistorage.GetO
 // if no Continue key provided, use provided ResourceVersion to do a binary search to find find starting positon in revisonedObjList
 // and iterate revisonedObjList from starting position to return a list of object, ignore obj which is marked as deleted.
 func (ms *MemoryStore) GetList(ctx context.Context, key string, opts apistorage.ListOptions, listObj runtime.Object) error {
-	// st := time.Now().UnixMicro()
-	// defer func() {
-	//  et := time.Now().UnixMicro()
-	//  klog.V(5).InfoS("Memory store get a list of object", "key", key, "opts", opts, "took-micro", et-st, "get", listObj)
-	//  TODO, add metric
-	// }()
+	defer ms.observeOperationDuration("list", time.Now())
 	ms.revSortedObjListMu.RLock()
 	defer ms.revSortedObjListMu.RUnlock()
 
@@ -501,12 +545,7 @@ func (ms *MemoryStore) GetList(ctx context.Context, key string, opts apistorage.
 // GuaranteedUpdate implements k8s storage.Interface, updated object will get an new revision,
 // its previous positon in revSortedObjList is set to nil, updated object is appended to end of revSortedObjList
 func (ms *MemoryStore) GuaranteedUpdate(ctx context.Context, key string, out runtime.Object, ignoreNotFound bool, preconditions *apistorage.Preconditions, tryUpdate apistorage.UpdateFunc, cachedExistingObject runtime.Object) error {
-	// st := time.Now().UnixMicro()
-	// defer func() {
-	//  et := time.Now().UnixMicro()
-	//  klog.V(5).InfoS("Memory store update object", "key", key, "took-micro", et-st)
-	//  TODO, add metric
-	// }()
+	defer ms.observeOperationDuration("update", time.Now())
 	ms.revSortedObjListMu.RLock()
 	defer ms.revSortedObjListMu.RUnlock()
 	outVal, err := conversion.EnforcePtr(out)
@@ -566,22 +605,27 @@ func (ms *MemoryStore) GuaranteedUpdate(ctx context.Context, key string, out run
 			index:   index,
 			deleted: false,
 		}
-		err = ms.kvStore.put(keys, newObjWi, currRv)
-		if err != nil {
+		if err := ms.commit(key, ret, false); err != nil {
 			return err
 		}
-		ms.revSortedObjList.objs[curObjWi.index] = nil
-		ms.revSortedObjList.objs[newObjWi.index] = newObjWi
-		outVal.Set(reflect.ValueOf(ret).Elem())
-
 		event := &objEvent{
 			key:       key,
-			obj:       out.DeepCopyObject(),
+			obj:       ret.DeepCopyObject(),
 			oldObj:    currObj,
 			rev:       rev,
 			isDeleted: false,
 			isCreated: false,
 		}
+		if err := ms.logEvent(event); err != nil {
+			return err
+		}
+		err = ms.kvStore.put(keys, newObjWi, currRv)
+		if err != nil {
+			return err
+		}
+		ms.revSortedObjList.objs[curObjWi.index] = nil
+		ms.revSortedObjList.objs[newObjWi.index] = newObjWi
+		outVal.Set(reflect.ValueOf(ret).Elem())
 		ms.sendEvent(event)
 	}
 	return nil
@@ -663,14 +707,9 @@ func (ms *MemoryStore) CreateOrReplace(ctx context.Context, key string, objToCre
 			index:   index,
 			deleted: false,
 		}
-
-		err = ms.kvStore.put(keys, newObjWi, currRv)
-		if err != nil {
+		if err := ms.commit(key, newObj, false); err != nil {
 			return err
 		}
-		ms.revSortedObjList.objs[curObjWi.index] = nil
-		ms.revSortedObjList.objs[index] = newObjWi
-		outVal.Set(reflect.ValueOf(newObj).Elem())
 		event := &objEvent{
 			key:       key,
 			obj:       newObj.DeepCopyObject(),
@@ -679,6 +718,16 @@ func (ms *MemoryStore) CreateOrReplace(ctx context.Context, key string, objToCre
 			isDeleted: false,
 			isCreated: false,
 		}
+		if err := ms.logEvent(event); err != nil {
+			return err
+		}
+		err = ms.kvStore.put(keys, newObjWi, currRv)
+		if err != nil {
+			return err
+		}
+		ms.revSortedObjList.objs[curObjWi.index] = nil
+		ms.revSortedObjList.objs[index] = newObjWi
+		outVal.Set(reflect.ValueOf(newObj).Elem())
 		ms.sendEvent(event)
 	}
 
@@ -720,6 +769,10 @@ func (ms *MemoryStore) watch(ctx context.Context, key string, opts apistorage.Li
 		return nil, err
 	}
 
+	if compactedRev := atomic.LoadUint64(&ms.compactedRev); rev > 1 && rev < compactedRev {
+		return nil, apierrors.NewResourceExpired(fmt.Sprintf("too old resource version: %d (%d)", rev, compactedRev))
+	}
+
 	// start to watch new events
 	watcher := NewMemoryStoreWatcher(ctx, key, opts)
 	ms.watchers = append(ms.watchers, watcher)
@@ -852,6 +905,87 @@ func (ms *MemoryStore) reserveRevAndSlot() (uint64, uint64, error) {
 	return rev, uindex, nil
 }
 
+// reserveSlotForRev reserve a slot for a object which already has a revision, e.g. loaded from disk,
+// global memory revision is moved forward to this revision if it's behind, so new changes always get a larger revision
+func (ms *MemoryStore) reserveSlotForRev(rev uint64) (uint64, error) {
+	ms.revmu.Lock()
+	defer ms.revmu.Unlock()
+	uindex := atomic.LoadUint64(&ms.revSortedObjList.lastObjIndex)
+	if uint64(ms.revSortedObjList.Len()) < uindex+DefaultObjRevListGrowSize {
+		ms.revSortedObjList.grow(DefaultObjRevListInitSize)
+	}
+	if lastObj := ms.revSortedObjList.objs[uindex]; lastObj != nil {
+		if lastRv, _ := store.GetObjectResourceVersion(lastObj.obj); lastRv > rev {
+			return 0, fmt.Errorf("object revision %d is older than last object revision %d in store", rev, lastRv)
+		}
+	}
+	for {
+		memoryRev := atomic.LoadUint64(&_MemoryRev)
+		if memoryRev >= rev || atomic.CompareAndSwapUint64(&_MemoryRev, memoryRev, rev) {
+			break
+		}
+	}
+	uindex = atomic.AddUint64(&ms.revSortedObjList.lastObjIndex, 1)
+	return uindex, nil
+}
+
+// Restore put a object loaded from durable storage back into store and keep its resource version,
+// objects must be restored in resource version order before store is used, no watch event is sent for restored object
+func (ms *MemoryStore) Restore(key string, obj runtime.Object) error {
+	ms.revSortedObjListMu.RLock()
+	defer ms.revSortedObjListMu.RUnlock()
+	keys := strings.Split(key, "/")
+	if o := ms.kvStore.get(keys); o != nil {
+		return apistorage.NewKeyExistsError(key, 0)
+	}
+	return ms.restoreObject(key, obj.DeepCopyObject(), false)
+}
+
+// restoreObject apply a created, updated or deleted object with its revision, deleted object is kept in list as it's deleted by Delete
+func (ms *MemoryStore) restoreObject(key string, obj runtime.Object, deleted bool) error {
+	rev, err := store.GetObjectResourceVersion(obj)
+	if err != nil {
+		return err
+	}
+	index, err := ms.reserveSlotForRev(rev)
+	if err != nil {
+		return err
+	}
+
+	keys := strings.Split(key, "/")
+	objWi := &objWithIndex{
+		key:     key,
+		obj:     obj,
+		index:   index,
+		deleted: deleted,
+	}
+	existingObj := ms.kvStore.get(keys)
+	if deleted {
+		if existingObj != nil {
+			if err := ms.kvStore.del(keys); err != nil {
+				return err
+			}
+			ms.revSortedObjList.objs[existingObj.index] = nil
+			atomic.AddInt64(&ms.kvStoreObjCount, -1)
+		}
+	} else {
+		existingRv := uint64(0)
+		if existingObj != nil {
+			existingRv, _ = store.GetObjectResourceVersion(existingObj.obj)
+		}
+		if err := ms.kvStore.put(keys, objWi, existingRv); err != nil {
+			return err
+		}
+		if existingObj != nil {
+			ms.revSortedObjList.objs[existingObj.index] = nil
+		} else {
+			atomic.AddInt64(&ms.kvStoreObjCount, 1)
+		}
+	}
+	ms.revSortedObjList.objs[index] = objWi
+	return nil
+}
+
 func (ms *MemoryStore) getSingleObjectAsList(ctx context.Context, key string, opts apistorage.ListOptions, listObj runtime.Object) error {
 	resourceVersion := opts.ResourceVersion
 	match := opts.ResourceVersionMatch
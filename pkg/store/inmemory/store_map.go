@@ -43,19 +43,26 @@ func (list *objList) Len() int {
 	return (len(list.objs))
 }
 
-// shrink this list to specified length, by removing nil obj or obj is marked as deleted
-func (list *objList) shrink(length uint64) {
+// shrink this list to specified length, by removing nil obj or obj is marked as deleted,
+// it return largest revision of removed deleted objects, watch from a older revision will miss these deletions
+func (list *objList) shrink(length uint64) uint64 {
 	diff := uint64(list.Len()) - length
 	if diff <= 0 {
-		return
+		return 0
 	}
 	newList := make([]*objWithIndex, length)
 	i := uint64(0)
 	lastIndex := uint64(0)
+	compactedRev := uint64(0)
 	for _, v := range list.objs {
 		if (v == nil || v.deleted) && diff > 0 {
 			// skip one nil or deleted object, reduce 1 from diff
 			diff -= 1
+			if v != nil {
+				if rev, _ := store.GetObjectResourceVersion(v.obj); rev > compactedRev {
+					compactedRev = rev
+				}
+			}
 			continue
 		} else {
 			if i < length {
@@ -73,6 +80,7 @@ func (list *objList) shrink(length uint64) {
 	}
 	list.objs = newList
 	list.lastObjIndex = lastIndex
+	return compactedRev
 }
 
 func (list *objList) grow(length uint64) {
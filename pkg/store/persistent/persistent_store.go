@@ -0,0 +1,208 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/klog/v2"
+
+	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
+	"centaurusinfra.io/fornax-serverless/pkg/store/inmemory"
+	"centaurusinfra.io/fornax-serverless/pkg/store/storage"
+	"centaurusinfra.io/fornax-serverless/pkg/util"
+)
+
+// PersistentStore keep resources in a inmemory.MemoryStore which serve get, list and watch,
+// every change is written into a durable storage.Store using object key as identifier and resource version as revision before it's applied in memory,
+// a change failed to be written is not applied and watchers never see it,
+// when store is created, persisted objects are loaded back into memory with their original resource versions,
+// so, resources survive fornaxcore restart, deleted objects are not persisted, watch from a revision before restart get too old error
+type PersistentStore struct {
+	*inmemory.MemoryStore
+	// writeMu serialize changes, so a change written into backend is always applied in memory without a conflict
+	writeMu      sync.Mutex
+	mu           sync.Mutex
+	backend      storage.Store
+	grvKeyPrefix string
+	// latest revision of each key written into backend, it avoid writing same revision again
+	persistedRevs map[string]uint64
+}
+
+var _ fornaxstore.ApiStorageInterface = &PersistentStore{}
+
+// NewPersistentStore return a storage.Interface for a groupResource, it load all objects in backend into memory before return
+func NewPersistentStore(ctx context.Context, groupResource schema.GroupResource, grvKeyPrefix string, newFunc func() runtime.Object, newListFunc func() runtime.Object, backend storage.Store) (*PersistentStore, error) {
+	ms, err := inmemory.NewMemoryStore(ctx, groupResource, grvKeyPrefix, newFunc, newListFunc, nil)
+	if err != nil {
+		return nil, err
+	}
+	ps := &PersistentStore{
+		MemoryStore:   ms,
+		writeMu:       sync.Mutex{},
+		mu:            sync.Mutex{},
+		backend:       backend,
+		grvKeyPrefix:  grvKeyPrefix,
+		persistedRevs: map[string]uint64{},
+	}
+	if err := ps.load(); err != nil {
+		ps.MemoryStore.Stop()
+		return nil, err
+	}
+	ms.SetCommitFunc(ps.commit)
+	return ps, nil
+}
+
+type objWithRev struct {
+	obj runtime.Object
+	rev uint64
+}
+
+// load restore objects in revision order, so memory store revision sorted list is still sorted after restore
+func (ps *PersistentStore) load() error {
+	objs, err := ps.backend.ListObject()
+	if err != nil {
+		return err
+	}
+
+	revObjs := []objWithRev{}
+	for _, v := range objs {
+		obj, ok := v.(runtime.Object)
+		if !ok {
+			return storage.InvalidObjectType
+		}
+		rev, err := fornaxstore.GetObjectResourceVersion(obj)
+		if err != nil {
+			return err
+		}
+		revObjs = append(revObjs, objWithRev{obj: obj, rev: rev})
+	}
+	sort.Slice(revObjs, func(i, j int) bool {
+		return revObjs[i].rev < revObjs[j].rev
+	})
+
+	for _, v := range revObjs {
+		key := fmt.Sprintf("%s/%s", ps.grvKeyPrefix, util.Name(v.obj))
+		if err := ps.MemoryStore.Restore(key, v.obj); err != nil {
+			return fmt.Errorf("Failed to restore object %s, cause %v", key, err)
+		}
+		ps.persistedRevs[key] = v.rev
+	}
+	klog.InfoS("Loaded persisted objects", "prefix", ps.grvKeyPrefix, "count", len(revObjs))
+	return nil
+}
+
+// commit is called by memory store before a change is applied
+func (ps *PersistentStore) commit(key string, obj runtime.Object, deleted bool) error {
+	if deleted {
+		return ps.unpersist(key)
+	}
+	return ps.persist(key, obj)
+}
+
+// persist write object into backend if its revision is newer than persisted one
+func (ps *PersistentStore) persist(key string, obj runtime.Object) error {
+	rev, err := fornaxstore.GetObjectResourceVersion(obj)
+	if err != nil {
+		return err
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if persistedRev, found := ps.persistedRevs[key]; found && persistedRev >= rev {
+		return nil
+	}
+	if err := ps.backend.PutObject(key, obj.DeepCopyObject(), int64(rev)); err != nil {
+		klog.ErrorS(err, "Failed to persist object", "key", key, "rev", rev)
+		return apistorage.NewInternalError(err.Error())
+	}
+	ps.persistedRevs[key] = rev
+	return nil
+}
+
+func (ps *PersistentStore) unpersist(key string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if err := ps.backend.DelObject(key); err != nil {
+		klog.ErrorS(err, "Failed to delete persisted object", "key", key)
+		return apistorage.NewInternalError(err.Error())
+	}
+	delete(ps.persistedRevs, key)
+	return nil
+}
+
+// Create implements storage.Interface
+func (ps *PersistentStore) Create(ctx context.Context, key string, obj runtime.Object, out runtime.Object, ttl uint64) error {
+	ps.writeMu.Lock()
+	defer ps.writeMu.Unlock()
+	return ps.MemoryStore.Create(ctx, key, obj, out, ttl)
+}
+
+// Delete implements storage.Interface
+func (ps *PersistentStore) Delete(ctx context.Context, key string, out runtime.Object, preconditions *apistorage.Preconditions, validateDeletion apistorage.ValidateObjectFunc, cachedExistingObject runtime.Object) error {
+	ps.writeMu.Lock()
+	defer ps.writeMu.Unlock()
+	return ps.MemoryStore.Delete(ctx, key, out, preconditions, validateDeletion, cachedExistingObject)
+}
+
+// GuaranteedUpdate implements storage.Interface
+func (ps *PersistentStore) GuaranteedUpdate(ctx context.Context, key string, out runtime.Object, ignoreNotFound bool, preconditions *apistorage.Preconditions, tryUpdate apistorage.UpdateFunc, cachedExistingObject runtime.Object) error {
+	ps.writeMu.Lock()
+	defer ps.writeMu.Unlock()
+	return ps.MemoryStore.GuaranteedUpdate(ctx, key, out, ignoreNotFound, preconditions, tryUpdate, cachedExistingObject)
+}
+
+// EnsureUpdateAndDelete implements FornaxStorage, it update object and delete it if object has delete timestamp and empty finalizer
+func (ps *PersistentStore) EnsureUpdateAndDelete(ctx context.Context, key string, ignoreNotFound bool, preconditions *apistorage.Preconditions, updatedObj runtime.Object, output runtime.Object) error {
+	err := ps.GuaranteedUpdate(ctx, key, output, ignoreNotFound, preconditions, fornaxstore.GetTryUpdateFunc(updatedObj), nil)
+	if err != nil {
+		return err
+	}
+
+	if fornaxstore.ShouldDeleteSpec(output) {
+		return ps.Delete(ctx, key, output, preconditions, func(ctx context.Context, obj runtime.Object) error { return nil }, output)
+	}
+
+	return nil
+}
+
+// CreateOrUpdate implements FornaxStorage
+func (ps *PersistentStore) CreateOrUpdate(ctx context.Context, key string, obj runtime.Object, out runtime.Object, mergeFunc func(from runtime.Object, to runtime.Object) error) error {
+	ps.writeMu.Lock()
+	defer ps.writeMu.Unlock()
+	return ps.MemoryStore.CreateOrUpdate(ctx, key, obj, out, mergeFunc)
+}
+
+// GetOrCreate implements FornaxStorage
+func (ps *PersistentStore) GetOrCreate(ctx context.Context, key string, objToCreate runtime.Object, out runtime.Object) error {
+	ps.writeMu.Lock()
+	defer ps.writeMu.Unlock()
+	return ps.MemoryStore.GetOrCreate(ctx, key, objToCreate, out)
+}
+
+// CreateOrReplace implements FornaxStorage
+func (ps *PersistentStore) CreateOrReplace(ctx context.Context, key string, objToCreate runtime.Object, out runtime.Object) error {
+	ps.writeMu.Lock()
+	defer ps.writeMu.Unlock()
+	return ps.MemoryStore.CreateOrReplace(ctx, key, objToCreate, out)
+}
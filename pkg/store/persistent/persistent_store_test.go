@@ -0,0 +1,220 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	fornaxstore "centaurusinfra.io/fornax-serverless/pkg/store"
+	"centaurusinfra.io/fornax-serverless/pkg/store/storage"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apistorage "k8s.io/apiserver/pkg/storage"
+)
+
+const testKeyPrefix = "/fornaxcore/test/applicationsessions"
+
+var errBackendFailure = errors.New("backend failure")
+
+// fakeBackend is a storage.Store keeping objects in a map, writes fail when failWrite is set
+type fakeBackend struct {
+	mu        sync.Mutex
+	objs      map[string]interface{}
+	failWrite bool
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{objs: map[string]interface{}{}}
+}
+
+func (b *fakeBackend) ListObject() ([]interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	objs := []interface{}{}
+	for _, v := range b.objs {
+		objs = append(objs, v)
+	}
+	return objs, nil
+}
+
+func (b *fakeBackend) DelObject(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failWrite {
+		return errBackendFailure
+	}
+	delete(b.objs, key)
+	return nil
+}
+
+func (b *fakeBackend) GetObject(key string) (interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if obj, found := b.objs[key]; found {
+		return obj, nil
+	}
+	return nil, storage.ObjectNotFound
+}
+
+func (b *fakeBackend) PutObject(key string, obj interface{}, revision int64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failWrite {
+		return errBackendFailure
+	}
+	b.objs[key] = obj
+	return nil
+}
+
+func (b *fakeBackend) setFailWrite(fail bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failWrite = fail
+}
+
+func newTestSession(name string, revision uint64) *fornaxv1.ApplicationSession {
+	session := &fornaxv1.ApplicationSession{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+	}
+	if revision > 0 {
+		session.ResourceVersion = fmt.Sprintf("%d", revision)
+	}
+	return session
+}
+
+func testSessionKey(name string) string {
+	return fmt.Sprintf("%s/test/%s", testKeyPrefix, name)
+}
+
+func newTestStore(t *testing.T, ctx context.Context, backend storage.Store) *PersistentStore {
+	ps, err := NewPersistentStore(ctx, fornaxv1.ApplicationSessionGrv.GroupResource(), testKeyPrefix, nil, nil, backend)
+	if err != nil {
+		t.Fatalf("Failed to create persistent store, err %v", err)
+	}
+	return ps
+}
+
+func getResourceVersion(t *testing.T, obj runtime.Object) uint64 {
+	rev, err := fornaxstore.GetObjectResourceVersion(obj)
+	if err != nil {
+		t.Fatalf("Failed to get resource version, err %v", err)
+	}
+	return rev
+}
+
+func TestRestorePersistedObjects(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	backend := newFakeBackend()
+	backend.objs[testSessionKey("session1")] = newTestSession("session1", 200)
+	backend.objs[testSessionKey("session2")] = newTestSession("session2", 100)
+
+	ps := newTestStore(t, ctx, backend)
+	for name, rev := range map[string]uint64{"session1": 200, "session2": 100} {
+		out := &fornaxv1.ApplicationSession{}
+		if err := ps.Get(ctx, testSessionKey(name), apistorage.GetOptions{}, out); err != nil {
+			t.Fatalf("Failed to get restored session %s, err %v", name, err)
+		}
+		if got := getResourceVersion(t, out); got != rev {
+			t.Errorf("Restored session %s has resource version %d, expected %d", name, got, rev)
+		}
+	}
+
+	out := &fornaxv1.ApplicationSession{}
+	if err := ps.Create(ctx, testSessionKey("session3"), newTestSession("session3", 0), out, 0); err != nil {
+		t.Fatalf("Failed to create session, err %v", err)
+	}
+	if got := getResourceVersion(t, out); got <= 200 {
+		t.Errorf("Created session has resource version %d, expected a revision newer than restored sessions", got)
+	}
+	if _, err := backend.GetObject(testSessionKey("session3")); err != nil {
+		t.Errorf("Created session is not persisted, err %v", err)
+	}
+}
+
+func TestWriteFailureIsNotApplied(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	backend := newFakeBackend()
+	ps := newTestStore(t, ctx, backend)
+
+	created := &fornaxv1.ApplicationSession{}
+	if err := ps.Create(ctx, testSessionKey("session1"), newTestSession("session1", 0), created, 0); err != nil {
+		t.Fatalf("Failed to create session, err %v", err)
+	}
+	watcher, err := ps.Watch(ctx, testKeyPrefix, apistorage.ListOptions{ResourceVersion: created.ResourceVersion, Recursive: true})
+	if err != nil {
+		t.Fatalf("Failed to watch sessions, err %v", err)
+	}
+	defer watcher.Stop()
+
+	backend.setFailWrite(true)
+	if err := ps.Create(ctx, testSessionKey("session2"), newTestSession("session2", 0), &fornaxv1.ApplicationSession{}, 0); err == nil {
+		t.Errorf("Create succeeded when backend failed")
+	}
+	if err := ps.Get(ctx, testSessionKey("session2"), apistorage.GetOptions{}, &fornaxv1.ApplicationSession{}); !apistorage.IsNotFound(err) {
+		t.Errorf("Failed create is applied in memory, err %v", err)
+	}
+
+	updating := created.DeepCopy()
+	updating.Labels = map[string]string{"updated": "true"}
+	if err := ps.GuaranteedUpdate(ctx, testSessionKey("session1"), &fornaxv1.ApplicationSession{}, false, nil, fornaxstore.GetTryUpdateFunc(updating), nil); err == nil {
+		t.Errorf("Update succeeded when backend failed")
+	}
+	if err := ps.Delete(ctx, testSessionKey("session1"), &fornaxv1.ApplicationSession{}, nil, nil, nil); err == nil {
+		t.Errorf("Delete succeeded when backend failed")
+	}
+	out := &fornaxv1.ApplicationSession{}
+	if err := ps.Get(ctx, testSessionKey("session1"), apistorage.GetOptions{}, out); err != nil {
+		t.Fatalf("Failed update or delete is applied in memory, err %v", err)
+	}
+	if out.ResourceVersion != created.ResourceVersion || len(out.Labels) != 0 {
+		t.Errorf("Failed update is applied in memory, got resource version %s labels %v", out.ResourceVersion, out.Labels)
+	}
+
+	// first event watcher receive must be the change made after backend recovered
+	backend.setFailWrite(false)
+	if err := ps.GuaranteedUpdate(ctx, testSessionKey("session1"), out, false, nil, fornaxstore.GetTryUpdateFunc(updating), nil); err != nil {
+		t.Fatalf("Failed to update session, err %v", err)
+	}
+	select {
+	case event := <-watcher.ResultChan():
+		session, ok := event.Object.(*fornaxv1.ApplicationSession)
+		if !ok || session.Name != "session1" || session.Labels["updated"] != "true" {
+			t.Errorf("Watcher received unexpected event %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Watcher did not receive update event")
+	}
+
+	persisted, err := backend.GetObject(testSessionKey("session1"))
+	if err != nil {
+		t.Fatalf("Updated session is not persisted, err %v", err)
+	}
+	if got, want := getResourceVersion(t, persisted.(runtime.Object)), getResourceVersion(t, out); got != want {
+		t.Errorf("Persisted session has resource version %d, expected %d", got, want)
+	}
+}
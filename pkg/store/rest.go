@@ -20,15 +20,19 @@ package store
 
 import (
 	"context"
+	"reflect"
 
 	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
+	apistorage "k8s.io/apiserver/pkg/storage"
 	"sigs.k8s.io/apiserver-runtime/pkg/builder/resource"
 	brest "sigs.k8s.io/apiserver-runtime/pkg/builder/rest"
 )
@@ -46,6 +50,43 @@ func FornaxReadonlyResourceHandler(obj resource.Object) brest.ResourceHandlerPro
 	}
 }
 
+// FornaxReadonlySelectableResourceHandler returns a read only request handler for a resource which can be selected by fields besides metadata,
+// attrFunc return labels and fields of object, fieldLabelConversionFunc reject field selectors attrFunc does not support
+func FornaxReadonlySelectableResourceHandler(obj resource.Object, attrFunc apistorage.AttrFunc, fieldLabelConversionFunc runtime.FieldLabelConversionFunc) brest.ResourceHandlerProvider {
+	return func(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter) (rest.Storage, error) {
+		gvr := obj.GetGroupVersionResource()
+		gvk := gvr.GroupVersion().WithKind(reflect.TypeOf(obj.New()).Elem().Name())
+		if err := scheme.AddFieldLabelConversionFunc(gvk, fieldLabelConversionFunc); err != nil {
+			return nil, err
+		}
+		s := &selectableStrategy{
+			DefaultStrategy: &brest.DefaultStrategy{
+				Object:         obj,
+				ObjectTyper:    scheme,
+				TableConvertor: rest.NewDefaultTableConvertor(gvr.GroupResource()),
+			},
+			attrFunc: attrFunc,
+		}
+		return newReadonlyStore(scheme, obj.New, obj.NewList, gvr, s, optsGetter, func(scheme *runtime.Scheme, store *genericregistry.Store, options *generic.StoreOptions) {
+			options.AttrFunc = attrFunc
+		})
+	}
+}
+
+// selectableStrategy match objects using labels and fields returned by attrFunc
+type selectableStrategy struct {
+	*brest.DefaultStrategy
+	attrFunc apistorage.AttrFunc
+}
+
+func (s *selectableStrategy) Match(label labels.Selector, field fields.Selector) apistorage.SelectionPredicate {
+	return apistorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: s.attrFunc,
+	}
+}
+
 // newReadonlyStore returns a RESTStorage object that will work against API services.
 func newReadonlyStore(
 	scheme *runtime.Scheme,
@@ -126,3 +167,88 @@ func (r *fornaxReadOnlyStore) Watch(ctx context.Context, options *metainternalve
 func (r *fornaxReadOnlyStore) New() runtime.Object {
 	return r.backendStore.New()
 }
+
+// FornaxSpecUpdatableResourceHandler returns a request handler for a resource which is owned by fornaxcore,
+// client can read it, update it and delete it, prepareForUpdate decide which changes of updated object are accepted,
+// create is still not allowed
+func FornaxSpecUpdatableResourceHandler(obj resource.Object, prepareForUpdate func(obj, old runtime.Object)) brest.ResourceHandlerProvider {
+	return func(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter) (rest.Storage, error) {
+		gvr := obj.GetGroupVersionResource()
+		s := &specUpdateStrategy{
+			DefaultStrategy: brest.DefaultStrategy{
+				Object:         obj,
+				ObjectTyper:    scheme,
+				TableConvertor: rest.NewDefaultTableConvertor(gvr.GroupResource()),
+			},
+			prepareForUpdate: prepareForUpdate,
+		}
+		rs, err := newReadonlyStore(scheme, obj.New, obj.NewList, gvr, s, optsGetter, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &fornaxSpecUpdatableStore{
+			fornaxReadOnlyStore: rs.(*fornaxReadOnlyStore),
+		}, nil
+	}
+}
+
+type specUpdateStrategy struct {
+	brest.DefaultStrategy
+	prepareForUpdate func(obj, old runtime.Object)
+}
+
+// PrepareForUpdate implements rest.RESTUpdateStrategy
+func (s *specUpdateStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	s.DefaultStrategy.PrepareForUpdate(ctx, obj, old)
+	if s.prepareForUpdate != nil {
+		s.prepareForUpdate(obj, old)
+	}
+}
+
+var _ rest.Updater = &fornaxSpecUpdatableStore{}
+var _ rest.GracefulDeleter = &fornaxSpecUpdatableStore{}
+
+type fornaxSpecUpdatableStore struct {
+	*fornaxReadOnlyStore
+}
+
+// Update implements rest.Updater
+func (r *fornaxSpecUpdatableStore) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// do not allow client create a object using update
+	return r.backendStore.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete implements rest.GracefulDeleter
+func (r *fornaxSpecUpdatableStore) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	return r.backendStore.Delete(ctx, name, deleteValidation, options)
+}
+
+// FornaxPrepareForCreateResourceHandler returns a etcd backed request handler same as default handler of resource,
+// besides prepareForCreate is called with request context before object is created, e.g. to start trace of object
+func FornaxPrepareForCreateResourceHandler(obj resource.Object, prepareForCreate func(ctx context.Context, obj runtime.Object)) brest.ResourceHandlerProvider {
+	return func(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter) (rest.Storage, error) {
+		gvr := obj.GetGroupVersionResource()
+		s := &createPrepareStrategy{
+			DefaultStrategy: brest.DefaultStrategy{
+				Object:         obj,
+				ObjectTyper:    scheme,
+				TableConvertor: rest.NewDefaultTableConvertor(gvr.GroupResource()),
+			},
+			prepareForCreate: prepareForCreate,
+		}
+		return brest.NewWithStrategy(obj, s)(scheme, optsGetter)
+	}
+}
+
+type createPrepareStrategy struct {
+	brest.DefaultStrategy
+	prepareForCreate func(ctx context.Context, obj runtime.Object)
+}
+
+// PrepareForCreate implements rest.RESTCreateStrategy
+func (s *createPrepareStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	s.DefaultStrategy.PrepareForCreate(ctx, obj)
+	if s.prepareForCreate != nil {
+		s.prepareForCreate(ctx, obj)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const EncryptionKeySize = 32
+
+var InvalidCipherText = errors.New("cipher text is too short")
+
+// LoadOrCreateEncryptionKey read a aes-256 key from file, a random key is generated and saved with 0600 mode if file does not exist
+func LoadOrCreateEncryptionKey(file string) ([]byte, error) {
+	key, err := os.ReadFile(file)
+	if err == nil {
+		if len(key) != EncryptionKeySize {
+			return nil, fmt.Errorf("encryption key in %s should be %d bytes, got %d", file, EncryptionKeySize, len(key))
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key = make([]byte, EncryptionKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(file, key, 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// EncryptedTextFromObjectFunc seal text returned by fromFunc using aes-gcm, random nonce is prepended to cipher text,
+// result is base64 encoded, so it can be saved in a text column
+func EncryptedTextFromObjectFunc(key []byte, fromFunc TextFromObjectFunc) (TextFromObjectFunc, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return func(obj interface{}) ([]byte, error) {
+		plain, err := fromFunc(obj)
+		if err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+		sealed := aead.Seal(nonce, nonce, plain, nil)
+		text := make([]byte, base64.StdEncoding.EncodedLen(len(sealed)))
+		base64.StdEncoding.Encode(text, sealed)
+		return text, nil
+	}, nil
+}
+
+// EncryptedTextToObjectFunc open text sealed by EncryptedTextFromObjectFunc and decode plain text using toFunc
+func EncryptedTextToObjectFunc(key []byte, toFunc TextToObjectFunc) (TextToObjectFunc, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return func(text []byte) (interface{}, error) {
+		sealed := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+		n, err := base64.StdEncoding.Decode(sealed, text)
+		if err != nil {
+			return nil, err
+		}
+		sealed = sealed[:n]
+		if len(sealed) < aead.NonceSize() {
+			return nil, InvalidCipherText
+		}
+		plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+		if err != nil {
+			return nil, err
+		}
+		return toFunc(plain)
+	}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/component-base/traces"
+	"k8s.io/klog/v2"
+)
+
+const (
+	TracerName = "centaurusinfra.io/fornax-serverless"
+
+	DefaultSamplingRatePerMillion = 1000000
+
+	// w3c trace context header, it's the key of trace parent in trace context map carried by messages
+	TraceParentKey = "traceparent"
+)
+
+type TracingConfig struct {
+	Endpoint               string // otlp grpc collector address, format is host:port, spans are not exported if it's empty
+	SamplingRatePerMillion int    // how many traces of one million are sampled, sampling decision of a remote parent is always respected
+}
+
+// InitTracing install global tracer provider exporting spans to otlp collector, returned func flush batched spans and stop exporter,
+// if collector endpoint is not set, default noop tracer provider is kept, spans are not recorded,
+// but trace context received from upstream is still propagated to downstream
+func InitTracing(ctx context.Context, serviceName string, config TracingConfig) (shutdown func()) {
+	otel.SetTextMapPropagator(traces.Propagators())
+	if len(config.Endpoint) == 0 {
+		klog.InfoS("Tracing endpoint is not set, spans are not exported", "service", serviceName)
+		return func() {}
+	}
+
+	resourceOpts := []resource.Option{resource.WithAttributes(semconv.ServiceNameKey.String(serviceName))}
+	sampler := sdktrace.TraceIDRatioBased(float64(config.SamplingRatePerMillion) / float64(1000000))
+	tp := traces.NewProvider(ctx, sampler, resourceOpts, otlpgrpc.WithEndpoint(config.Endpoint))
+	otel.SetTracerProvider(tp)
+	klog.InfoS("Export spans to otlp collector", "service", serviceName, "endpoint", config.Endpoint, "samplingRatePerMillion", config.SamplingRatePerMillion)
+
+	return func() {
+		if sdktp, ok := tp.(*sdktrace.TracerProvider); ok {
+			if err := sdktp.Shutdown(context.Background()); err != nil {
+				klog.ErrorS(err, "Failed to flush spans", "service", serviceName)
+			}
+		}
+	}
+}
+
+// StartSpan start a span of fornax serverless tracer as child of span in context
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan end a span and mark it failed if err is not nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectTraceContext return trace context of span in context as a string map, it's carried by grpc and actor messages,
+// nil is returned if there is no valid span in context
+func InjectTraceContext(ctx context.Context) map[string]string {
+	carrier := mapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// ExtractTraceContext return a context with remote span context in trace context map as parent,
+// context is returned as it is if trace context map is empty
+func ExtractTraceContext(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, mapCarrier(traceContext))
+}
+
+// TraceID return hex trace id of span in context, empty string if there is no valid span
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+// TraceParent return w3c traceparent of span in context, it's saved in object annotation to continue trace later
+func TraceParent(ctx context.Context) string {
+	carrier := mapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier[TraceParentKey]
+}
+
+// ContextWithTraceParent return a context with remote span context of a w3c traceparent as parent
+func ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if len(traceParent) == 0 {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, mapCarrier{TraceParentKey: traceParent})
+}
+
+// mapCarrier adapt a string map to propagation.TextMapCarrier
+type mapCarrier map[string]string
+
+var _ propagation.TextMapCarrier = mapCarrier{}
+
+func (c mapCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c mapCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -17,14 +17,29 @@ limitations under the License.
 package util
 
 import (
+	"context"
+	"fmt"
+	"hash/fnv"
 	"time"
 
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+	"centaurusinfra.io/fornax-serverless/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
+	hashutil "k8s.io/kubernetes/pkg/util/hash"
 )
 
 const (
 	DefaultApplicationPodBurst                       = 2
+	DefaultApplicationSessionsPerInstance            = 1
+	DefaultApplicationRollingUpdateMaxUnavailable    = 1
+	DefaultApplicationRollingUpdateMaxSurge          = 1
 	DefaultApplicationSesionDeleteGracePeriodSeconds = int64(5)
+	ApplicationConfigVolumeName                      = "fornax-config"
 )
 
 func ApplicationScalingBurst(app *fornaxv1.Application) int {
@@ -34,6 +49,112 @@ func ApplicationScalingBurst(app *fornaxv1.Application) int {
 	return int(app.Spec.ScalingPolicy.Burst)
 }
 
+func ApplicationSessionsPerInstance(app *fornaxv1.Application) int {
+	if app.Spec.SessionsPerInstance == 0 {
+		return DefaultApplicationSessionsPerInstance
+	}
+	return int(app.Spec.SessionsPerInstance)
+}
+
+func ApplicationRollingUpdateMaxUnavailable(app *fornaxv1.Application) int {
+	if app.Spec.RollingUpdate.MaxUnavailable == 0 {
+		return DefaultApplicationRollingUpdateMaxUnavailable
+	}
+	return int(app.Spec.RollingUpdate.MaxUnavailable)
+}
+
+func ApplicationRollingUpdateMaxSurge(app *fornaxv1.Application) int {
+	if app.Spec.RollingUpdate.MaxSurge == 0 {
+		return DefaultApplicationRollingUpdateMaxSurge
+	}
+	return int(app.Spec.RollingUpdate.MaxSurge)
+}
+
+// ApplicationRevision return a hash of application spec which is used to build application pod,
+// scaling and rolling update policy are not included, as changing them does not require replacing pods,
+// config data is pushed to running pods, only adding or removing all config data change pod spec
+func ApplicationRevision(app *fornaxv1.Application) string {
+	spec := app.Spec.DeepCopy()
+	spec.ScalingPolicy = fornaxv1.ScalingPolicy{}
+	spec.RollingUpdate = fornaxv1.RollingUpdatePolicy{}
+	spec.SessionsPerInstance = 0
+	if len(spec.ConfigData) > 0 {
+		spec.ConfigData = map[string]string{}
+	} else {
+		spec.ConfigData = nil
+	}
+	hasher := fnv.New32a()
+	hashutil.DeepHashObject(hasher, *spec)
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
+
+// ApplicationConfigRevision return a hash of application config data
+func ApplicationConfigRevision(app *fornaxv1.Application) string {
+	hasher := fnv.New32a()
+	hashutil.DeepHashObject(hasher, app.Spec.ConfigData)
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
+
+// ApplicationConfigProjection return how config data is exposed to containers, default is mounting it in DefaultConfigMountPath and exposing it as environment variables
+func ApplicationConfigProjection(app *fornaxv1.Application) fornaxv1.ConfigProjection {
+	if app.Spec.ConfigProjection == nil {
+		return fornaxv1.ConfigProjection{
+			MountPath: fornaxv1.DefaultConfigMountPath,
+			Env:       true,
+		}
+	}
+	return *app.Spec.ConfigProjection
+}
+
+// ApplicationConfigMap build a config map from application config data, it has same name and namespace as application,
+// config revision is set in annotation, so node agent know which revision it's projecting into pod, return nil if application has no config data
+func ApplicationConfigMap(app *fornaxv1.Application) *v1.ConfigMap {
+	if len(app.Spec.ConfigData) == 0 {
+		return nil
+	}
+	data := map[string]string{}
+	for k, v := range app.Spec.ConfigData {
+		data[k] = v
+	}
+	return &v1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+			Annotations: map[string]string{
+				fornaxv1.AnnotationFornaxCoreConfigRevision: ApplicationConfigRevision(app),
+			},
+		},
+		Data: data,
+	}
+}
+
+// PrepareSessionForCreate start trace of session open when api server accept a session,
+// trace id and trace parent are saved in annotations, fornaxcore and node agent continue this trace when they open session
+func PrepareSessionForCreate(ctx context.Context, obj runtime.Object) {
+	session, ok := obj.(*fornaxv1.ApplicationSession)
+	if !ok {
+		return
+	}
+	ctx, span := tracing.StartSpan(ctx, "ApiServer.CreateApplicationSession",
+		attribute.String("namespace", session.Namespace),
+		attribute.String("application", session.Spec.ApplicationName))
+	defer span.End()
+
+	traceId := tracing.TraceID(ctx)
+	if len(traceId) == 0 {
+		return
+	}
+	if session.Annotations == nil {
+		session.Annotations = map[string]string{}
+	}
+	session.Annotations[fornaxv1.AnnotationFornaxCoreTraceId] = traceId
+	session.Annotations[fornaxv1.AnnotationFornaxCoreTraceParent] = tracing.TraceParent(ctx)
+}
+
 func SessionIsOpen(session *fornaxv1.ApplicationSession) bool {
 	return session.Status.SessionStatus != fornaxv1.SessionStatusUnspecified &&
 		session.Status.SessionStatus != fornaxv1.SessionStatusPending &&
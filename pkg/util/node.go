@@ -17,13 +17,43 @@ limitations under the License.
 package util
 
 import (
+	"sort"
+	"strings"
+
+	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
+
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// MergeNodeStatus merge node status and labels reported by node agent into node saved in store,
+// keys of labels reported by node agent are saved in annotation AnnotationFornaxCoreNodeAgentLabels,
+// a label node agent reported before but does not report any more is removed, labels added by client are kept
 func MergeNodeStatus(oldcopy *v1.Node, newnode *v1.Node) {
 	// keep existing node spec, and use new node status from node agent
 	// set node in unschedulable state since node start registration, wait for node
 	oldcopy.Status = *newnode.Status.DeepCopy()
+
+	// node labels are reported by node agent, they are used by pod node selector and affinity
+	if oldcopy.Labels == nil {
+		oldcopy.Labels = map[string]string{}
+	}
+	if oldcopy.Annotations == nil {
+		oldcopy.Annotations = map[string]string{}
+	}
+	for _, k := range strings.Split(oldcopy.Annotations[fornaxv1.AnnotationFornaxCoreNodeAgentLabels], ",") {
+		if _, found := newnode.Labels[k]; !found {
+			delete(oldcopy.Labels, k)
+		}
+	}
+	agentLabels := []string{}
+	for k, v := range newnode.Labels {
+		oldcopy.Labels[k] = v
+		agentLabels = append(agentLabels, k)
+	}
+	sort.Strings(agentLabels)
+	oldcopy.Annotations[fornaxv1.AnnotationFornaxCoreNodeAgentLabels] = strings.Join(agentLabels, ",")
 }
 
 func IsNodeCondtionReady(v1node *v1.Node) bool {
@@ -35,6 +65,71 @@ func IsNodeCondtionReady(v1node *v1.Node) bool {
 	return false
 }
 
+// SetNodeConditionNotReady set node ready condition as unknown when node stop heartbeating,
+// node is put back into pending phase, so scheduler do not use it until node report again
+func SetNodeConditionNotReady(v1node *v1.Node, reason, message string) {
+	v1node.Status.Phase = v1.NodePending
+	for i, v := range v1node.Status.Conditions {
+		if v.Type == v1.NodeReady {
+			if v.Status != v1.ConditionUnknown {
+				v1node.Status.Conditions[i].LastTransitionTime = metav1.Now()
+			}
+			v1node.Status.Conditions[i].Status = v1.ConditionUnknown
+			v1node.Status.Conditions[i].Reason = reason
+			v1node.Status.Conditions[i].Message = message
+			return
+		}
+	}
+	v1node.Status.Conditions = append(v1node.Status.Conditions, v1.NodeCondition{
+		Type:               v1.NodeReady,
+		Status:             v1.ConditionUnknown,
+		LastHeartbeatTime:  metav1.Now(),
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
 func IsNodeRunning(v1node *v1.Node) bool {
 	return v1node.Status.Phase == v1.NodeRunning
 }
+
+// IsNodeDrainRequested check if client asked to drain node using node annotation
+func IsNodeDrainRequested(v1node *v1.Node) bool {
+	return v1node.Annotations[fornaxv1.AnnotationFornaxCoreNodeDrain] == "true"
+}
+
+func GetNodeDrainState(v1node *v1.Node) string {
+	return v1node.Annotations[fornaxv1.AnnotationFornaxCoreNodeDrainState]
+}
+
+// PrepareNodeForUpdate is used when client update a node, client can change node labels, annotations, unschedulable and taints,
+// node status and other spec are reported by node agent or assigned by fornaxcore, they are kept as it is,
+// drain state and agent label keys are also kept since only fornaxcore know drain progress and which labels node agent reported
+func PrepareNodeForUpdate(obj, old runtime.Object) {
+	newNode, ok := obj.(*v1.Node)
+	if !ok {
+		return
+	}
+	oldNode, ok := old.(*v1.Node)
+	if !ok {
+		return
+	}
+
+	spec := oldNode.Spec.DeepCopy()
+	spec.Unschedulable = newNode.Spec.Unschedulable
+	spec.Taints = newNode.Spec.Taints
+	newNode.Spec = *spec
+	newNode.Status = *oldNode.Status.DeepCopy()
+
+	if newNode.Annotations == nil {
+		newNode.Annotations = map[string]string{}
+	}
+	for _, k := range []string{fornaxv1.AnnotationFornaxCoreNodeDrainState, fornaxv1.AnnotationFornaxCoreNodeAgentLabels} {
+		if v, found := oldNode.Annotations[k]; found {
+			newNode.Annotations[k] = v
+		} else {
+			delete(newNode.Annotations, k)
+		}
+	}
+}
@@ -25,10 +25,28 @@ import (
 	fornaxv1 "centaurusinfra.io/fornax-serverless/pkg/apis/core/v1"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 )
 
+const (
+	// PodReasonNodeLost is set as pod status reason when pod's node stop heartbeating and pod is failed over
+	PodReasonNodeLost = "NodeLost"
+
+	// PodReasonImagePullError is set as pod status reason by node when it failed to pull a container image
+	PodReasonImagePullError = "ErrImagePull"
+
+	// PodReasonImagePullAuthError is set as pod status reason by node when registry rejected image pull credentials
+	PodReasonImagePullAuthError = "ImagePullAuthError"
+
+	// PodReasonInitContainerError is set as pod status reason by node when a init container failed to start or exited abnormally
+	PodReasonInitContainerError = "InitContainerError"
+
+	// PodReasonEvicted is set as pod and session status reason by node when pod is evicted under memory or disk pressure
+	PodReasonEvicted = "Evicted"
+)
+
 func BuildADummyTerminatedPod(metaNamespaceName string) *v1.Pod {
 	namespace, name, err := cache.SplitMetaNamespaceKey(metaNamespaceName)
 	if err != nil {
@@ -167,9 +185,80 @@ func GetPodResourceList(v1pod *v1.Pod) *v1.ResourceList {
 		}
 	}
 
+	// init containers run one by one before application containers, pod need the larger of any init container and all application containers
+	for _, v := range v1pod.Spec.InitContainers {
+		requests := map[v1.ResourceName]*resource.Quantity{
+			v1.ResourceCPU:     v.Resources.Requests.Cpu(),
+			v1.ResourceMemory:  v.Resources.Requests.Memory(),
+			v1.ResourceStorage: v.Resources.Requests.StorageEphemeral(),
+		}
+		if v.Resources.Requests.Storage().Cmp(*requests[v1.ResourceStorage]) > 0 {
+			requests[v1.ResourceStorage] = v.Resources.Requests.Storage()
+		}
+		for name, quantity := range requests {
+			if current, found := resourceList[name]; quantity.Sign() > 0 && (!found || quantity.Cmp(current) > 0) {
+				resourceList[name] = *quantity
+			}
+		}
+	}
+
+	if cpus := GetPodExclusiveCPUs(v1pod); cpus > 0 {
+		resourceList[fornaxv1.ResourceFornaxCoreExclusiveCPU] = ResourceQuantity(cpus, fornaxv1.ResourceFornaxCoreExclusiveCPU)
+	}
+
 	return &resourceList
 }
 
+// PodIsGuaranteed return true if every container of pod set cpu and memory limits, and requests equal to limits,
+// requests missing in spec default to limits like kubernetes guaranteed qos
+func PodIsGuaranteed(v1pod *v1.Pod) bool {
+	containers := append([]v1.Container{}, v1pod.Spec.InitContainers...)
+	containers = append(containers, v1pod.Spec.Containers...)
+	if len(containers) == 0 {
+		return false
+	}
+	for _, v := range containers {
+		for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			limit, found := v.Resources.Limits[name]
+			if !found || limit.Sign() <= 0 {
+				return false
+			}
+			if request, found := v.Resources.Requests[name]; found && request.Cmp(limit) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// GetContainerExclusiveCPUs return how many cpus container should be pinned to exclusively,
+// only container of guaranteed pod with integer cpu limit get exclusive cpus, others use shared cpus
+func GetContainerExclusiveCPUs(v1pod *v1.Pod, container *v1.Container) int64 {
+	if !PodIsGuaranteed(v1pod) {
+		return 0
+	}
+	cpu := container.Resources.Limits.Cpu()
+	if cpu.MilliValue()%1000 != 0 {
+		return 0
+	}
+	return cpu.Value()
+}
+
+// GetPodExclusiveCPUs return number of exclusive cpus of a pod, application containers use disjoint cpus,
+// init containers run one by one before them, so they reuse cpus of application containers
+func GetPodExclusiveCPUs(v1pod *v1.Pod) int64 {
+	cpus := int64(0)
+	for i := range v1pod.Spec.Containers {
+		cpus += GetContainerExclusiveCPUs(v1pod, &v1pod.Spec.Containers[i])
+	}
+	for i := range v1pod.Spec.InitContainers {
+		if c := GetContainerExclusiveCPUs(v1pod, &v1pod.Spec.InitContainers[i]); c > cpus {
+			cpus = c
+		}
+	}
+	return cpus
+}
+
 func MergePod(fromPod, toPod *v1.Pod) {
 	MergeObjectMeta(&fromPod.ObjectMeta, &toPod.ObjectMeta)
 
@@ -202,6 +291,14 @@ func PodNotTerminated(pod *v1.Pod) bool {
 	return !PodIsTerminated(pod)
 }
 
+func PodIsLost(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodFailed && pod.Status.Reason == PodReasonNodeLost
+}
+
+func PodIsEvicted(pod *v1.Pod) bool {
+	return pod.Status.Reason == PodReasonEvicted
+}
+
 func PodIsTerminated(pod *v1.Pod) bool {
 	return (pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed)
 }
@@ -244,9 +341,60 @@ func GetPodSessionAnnotation(pod *v1.Pod) []string {
 	return []string{}
 }
 
+func GetPodApplicationRevision(pod *v1.Pod) string {
+	if label, found := pod.GetLabels()[fornaxv1.LabelFornaxCoreApplicationRevision]; found {
+		return label
+	}
+	return ""
+}
+
+// GetPodConfigRevision return config revision which node agent projected into pod
+func GetPodConfigRevision(pod *v1.Pod) string {
+	if label, found := pod.GetAnnotations()[fornaxv1.AnnotationFornaxCoreConfigRevision]; found {
+		return label
+	}
+	return ""
+}
+
 func GetPodFornaxNodeIdAnnotation(pod *v1.Pod) string {
 	if label, found := pod.GetAnnotations()[fornaxv1.AnnotationFornaxCoreNode]; found {
 		return label
 	}
 	return ""
 }
+
+// GetPodSecretNames return names of secrets referenced by pod volumes, image pull secrets and container environment variables,
+// value is true if all references of a secret are optional, image pull secrets are optional, image is pulled without them if they are not found
+func GetPodSecretNames(pod *v1.Pod) map[string]bool {
+	names := map[string]bool{}
+	addSecret := func(name string, optional *bool) {
+		isOptional := optional != nil && *optional
+		if prev, found := names[name]; found {
+			names[name] = prev && isOptional
+		} else {
+			names[name] = isOptional
+		}
+	}
+	optional := true
+	for _, ref := range pod.Spec.ImagePullSecrets {
+		addSecret(ref.Name, &optional)
+	}
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Secret != nil {
+			addSecret(vol.Secret.SecretName, vol.Secret.Optional)
+		}
+	}
+	for _, cont := range append(append([]v1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...) {
+		for _, env := range cont.EnvFrom {
+			if env.SecretRef != nil {
+				addSecret(env.SecretRef.Name, env.SecretRef.Optional)
+			}
+		}
+		for _, env := range cont.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				addSecret(env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Optional)
+			}
+		}
+	}
+	return names
+}